@@ -6,15 +6,56 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 func NewPostgreSQLDatabase() (*sql.DB, error) {
+	psqlInfo, err := postgreSQLInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("postgres", psqlInfo)
+
+	if err != nil {
+		return nil, fmt.Errorf("connection to database failed: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		defer func(db *sql.DB) {
+			if err := db.Close(); err != nil {
+				log.Fatalf("failed to close the connection: %s\n", err.Error())
+			}
+		}(db)
+		return nil, fmt.Errorf("can't sent ping to database: %w", err)
+	}
+
+	return db, nil
+}
+
+// NewPostgreSQLListener opens a dedicated connection to receive the LISTEN/NOTIFY notifications, it reconnects on failure.
+func NewPostgreSQLListener() (*pq.Listener, error) {
+	psqlInfo, err := postgreSQLInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	listener := pq.NewListener(psqlInfo, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("listener connection event %d: %s\n", event, err.Error())
+		}
+	})
+
+	return listener, nil
+}
+
+func postgreSQLInfo() (string, error) {
 	host := os.Getenv("DB_HOST")
 	port, err := strconv.Atoi(os.Getenv("DB_PORT"))
 	if err != nil {
-		return nil, fmt.Errorf("string conversion failed: %w", err)
+		return "", fmt.Errorf("string conversion failed: %w", err)
 	}
 
 	user := os.Getenv("DB_USER")
@@ -34,20 +75,5 @@ func NewPostgreSQLDatabase() (*sql.DB, error) {
 		)
 	}
 
-	db, err := sql.Open("postgres", psqlInfo)
-
-	if err != nil {
-		return nil, fmt.Errorf("connection to database failed: %w", err)
-	}
-
-	if err := db.Ping(); err != nil {
-		defer func(db *sql.DB) {
-			if err := db.Close(); err != nil {
-				log.Fatalf("failed to close the connection: %s\n", err.Error())
-			}
-		}(db)
-		return nil, fmt.Errorf("can't sent ping to database: %w", err)
-	}
-
-	return db, nil
+	return psqlInfo, nil
 }
@@ -1,31 +1,47 @@
 package controller
 
 import (
+	"fmt"
+	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/admin"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
 	"github.com/labstack/echo/v4"
 )
 
 type adminController struct {
 	service        admin.AdminService
+	auditService   audit.AuditService
 	tokenGenerator generator.TokenGenerator
 }
 
 func NewAdminController(
 	service admin.AdminService,
+	auditService audit.AuditService,
 	tokenGenerator generator.TokenGenerator,
 ) *adminController {
-	return &adminController{service: service, tokenGenerator: tokenGenerator}
+	return &adminController{service: service, auditService: auditService, tokenGenerator: tokenGenerator}
 }
 
 func (i *adminController) Route(g *echo.Group) {
-	group := g.Group("/admin/dashboard")
-	group.GET("", i.getInfo, middleware.JWTMiddleware())
+	group := g.Group("/admin")
+	group.GET("/dashboard", i.getInfo, middleware.JWTMiddleware())
+	group.GET("/stats", i.getStats, middleware.JWTMiddleware())
+	group.GET("/export/:resource", i.getExport, middleware.JWTMiddleware())
+	group.GET("/lockouts", i.getLockouts, middleware.JWTMiddleware())
+	group.DELETE("/lockouts/:kind/:value", i.deleteLockout, middleware.JWTMiddleware())
+	group.GET("/roles", i.getRoles, middleware.JWTMiddleware())
+	group.PUT("/users/:username/role", i.putUserRole, middleware.JWTMiddleware())
+	group.DELETE("/users/:username/role", i.deleteUserRole, middleware.JWTMiddleware())
+	group.GET("/audit", i.getAuditLogs, middleware.JWTMiddleware())
 }
 
 // getInfo     godoc
@@ -51,9 +67,362 @@ func (i *adminController) getInfo(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// getStats      godoc
+// @Summary      Get Stats
+// @Description  This endpoint is used to get the growth of the forum over a period for admin dashboard charts, with the top categories, the top threads and the most active users of the period
+// @Tags         admin
+// @Produce      json
+// @Param        from      query  string  false  "start of the period, format 2006-01-02, default 30 days before to"
+// @Param        to        query  string  false  "end of the period, inclusive, format 2006-01-02, default today"
+// @Param        interval  query  string  false  "bucket size, available options: day, week, month, default day"
+// @Param        limit     query  int     false  "length of the top lists, default 5, max 50"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  statsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/stats [get]
+func (i *adminController) getStats(c echo.Context) error {
+	limitStr := c.QueryParam("limit")
+
+	limit, convErr := strconv.Atoi(limitStr)
+	if convErr != nil || limit < 0 {
+		limit = 0
+	}
+
+	filter := payload.StatsFilter{
+		From:     c.QueryParam("from"),
+		To:       c.QueryParam("to"),
+		Interval: c.QueryParam("interval"),
+		Limit:    uint(limit),
+	}
+
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	statsResponse, err := i.service.GetStats(c.Request().Context(), tp.Role, filter)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get stats successful.", statsResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// getExport     godoc
+// @Summary      Export Data
+// @Description  This endpoint is used to download all the users, threads, comments or reports as CSV or NDJSON, the rows are streamed oldest first
+// @Tags         admin
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Param        resource       path   string  true   "available options: users, threads, comments, reports"
+// @Param        format         query  string  false  "available options: csv, ndjson, default csv"
+// @Param        createdAfter   query  string  false  "only rows created on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only rows created before the date, format 2006-01-02"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {file}    file
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/export/{resource} [get]
+func (i *adminController) getExport(c echo.Context) error {
+	resource := c.Param("resource")
+
+	filter := payload.ExportFilter{
+		Format:        c.QueryParam("format"),
+		CreatedAfter:  c.QueryParam("createdAfter"),
+		CreatedBefore: c.QueryParam("createdBefore"),
+	}
+
+	format := filter.Format
+	if format == "" {
+		format = admin.CSVExportFormat
+	}
+
+	w := &exportResponseWriter{response: c.Response(), filename: fmt.Sprintf("%s.%s", resource, format)}
+	if format == admin.NDJSONExportFormat {
+		w.contentType = "application/x-ndjson"
+	} else {
+		w.contentType = "text/csv; charset=UTF-8"
+	}
+
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	if err := i.service.Export(c.Request().Context(), tp.Role, resource, filter, w); err != nil {
+		if c.Response().Committed {
+			// The client already received a part of the rows, the export ends truncated.
+			log.Println(err)
+			return nil
+		}
+		return newErrorResponse(err)
+	}
+
+	if !c.Response().Committed {
+		w.writeHeader()
+	}
+
+	return nil
+}
+
+// getLockouts   godoc
+// @Summary      Get All Lockouts
+// @Description  This endpoint is used to get the usernames and the IP addresses currently locked out of the login after too many failed attempts
+// @Tags         admin
+// @Produce      json
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  lockoutsResponse
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/lockouts [get]
+func (i *adminController) getLockouts(c echo.Context) error {
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	lockoutsResponse, err := i.service.GetAllLockout(c.Request().Context(), tp.Role)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get lockouts successful.", lockoutsResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// deleteLockout godoc
+// @Summary      Clear Lockout
+// @Description  This endpoint is used to clear the failed login attempts and the lockout of a username or an IP address
+// @Tags         admin
+// @Produce      json
+// @Param        kind   path  string  true  "lockout kind, available options: username, ip"
+// @Param        value  path  string  true  "username or IP address"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/lockouts/{kind}/{value} [delete]
+func (i *adminController) deleteLockout(c echo.Context) error {
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	if err := i.service.ClearLockout(
+		c.Request().Context(),
+		tp.ID,
+		tp.Role,
+		c.Param("kind"),
+		c.Param("value"),
+	); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getRoles      godoc
+// @Summary      Get All Roles
+// @Description  This endpoint is used to get the available roles with their permissions
+// @Tags         admin
+// @Produce      json
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  rolesResponse
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/roles [get]
+func (i *adminController) getRoles(c echo.Context) error {
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	rolesResponse, err := i.service.GetAllRole(c.Request().Context(), tp.Role)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get roles successful.", rolesResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// putUserRole   godoc
+// @Summary      Grant Role
+// @Description  This endpoint is used to grant a role to the user, the user has to sign in again to use it
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        username  path  string             true  "username"
+// @Param        default   body  payload.GrantRole  true  "role to grant"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/users/{username}/role [put]
+func (i *adminController) putUserRole(c echo.Context) error {
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	p := new(payload.GrantRole)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := i.service.GrantRole(
+		c.Request().Context(),
+		tp.ID,
+		tp.Role,
+		c.Param("username"),
+		*p,
+	); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteUserRole godoc
+// @Summary      Revoke Role
+// @Description  This endpoint is used to revoke the role of the user back to user, the user has to sign in again
+// @Tags         admin
+// @Produce      json
+// @Param        username  path  string  true  "username"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/users/{username}/role [delete]
+func (i *adminController) deleteUserRole(c echo.Context) error {
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	if err := i.service.RevokeRole(
+		c.Request().Context(),
+		tp.ID,
+		tp.Role,
+		c.Param("username"),
+	); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getAuditLogs  godoc
+// @Summary      Get Audit Logs
+// @Description  This endpoint is used to get the audit log of the administrative actions, newest first
+// @Tags         admin
+// @Produce      json
+// @Param        page           query  int     false  "page, default 1"
+// @Param        limit          query  int     false  "limit, default 10"
+// @Param        actor          query  string  false  "only actions taken by the username"
+// @Param        action         query  string  false  "e.g. thread.delete, comment.delete, thread.moderator.add, thread.moderator.remove, category.create, category.update, category.delete, user.ban, user.unban, user.role.grant, user.role.revoke, report.status.update"
+// @Param        targetType     query  string  false  "options: thread, comment, category, user, report, lockout"
+// @Param        targetID       query  string  false  "target ID"
+// @Param        createdAfter   query  string  false  "only actions taken on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only actions taken before the date, format 2006-01-02"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  auditLogsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /admin/audit [get]
+func (i *adminController) getAuditLogs(c echo.Context) error {
+	pageStr := c.QueryParam("page")
+	limitStr := c.QueryParam("limit")
+
+	page, convErr := strconv.Atoi(pageStr)
+	if convErr != nil || page < 0 {
+		page = 0
+	}
+
+	limit, convErr := strconv.Atoi(limitStr)
+	if convErr != nil || limit < 0 {
+		limit = 0
+	}
+
+	filter := payload.AuditFilter{
+		ActorUsername: c.QueryParam("actor"),
+		Action:        c.QueryParam("action"),
+		TargetType:    c.QueryParam("targetType"),
+		TargetID:      c.QueryParam("targetID"),
+		CreatedAfter:  c.QueryParam("createdAfter"),
+		CreatedBefore: c.QueryParam("createdBefore"),
+	}
+
+	tp := i.tokenGenerator.ExtractToken(c)
+
+	auditLogsResponse, err := i.auditService.GetAll(c.Request().Context(), tp.Role, uint(page), uint(limit), filter)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get audit logs successful.", auditLogsResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// exportResponseWriter sends the headers of an export with its first row, so an error returned before any row is
+// written is still answered with a JSON error.
+type exportResponseWriter struct {
+	response    *echo.Response
+	filename    string
+	contentType string
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.response.Committed {
+		e.writeHeader()
+	}
+	return e.response.Write(p)
+}
+
+func (e *exportResponseWriter) writeHeader() {
+	e.response.Header().Set(echo.HeaderContentType, e.contentType)
+	e.response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.response.Header().Set("X-Accel-Buffering", "no")
+	e.response.WriteHeader(http.StatusOK)
+}
+
 // profileResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type infoResponse struct {
 	Status  string                 `json:"status" extensions:"x-order=0"`
 	Message string                 `json:"message" extensions:"x-order=1"`
 	Data    response.DashboardInfo `json:"data" extensions:"x-order=2"`
 }
+
+// statsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type statsResponse struct {
+	Status  string         `json:"status" extensions:"x-order=0"`
+	Message string         `json:"message" extensions:"x-order=1"`
+	Data    response.Stats `json:"data" extensions:"x-order=2"`
+}
+
+// lockoutsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type lockoutsResponse struct {
+	Status  string             `json:"status" extensions:"x-order=0"`
+	Message string             `json:"message" extensions:"x-order=1"`
+	Data    []response.Lockout `json:"data" extensions:"x-order=2"`
+}
+
+// rolesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type rolesResponse struct {
+	Status  string          `json:"status" extensions:"x-order=0"`
+	Message string          `json:"message" extensions:"x-order=1"`
+	Data    []response.Role `json:"data" extensions:"x-order=2"`
+}
+
+// auditLogsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type auditLogsResponse struct {
+	Status  string               `json:"status" extensions:"x-order=0"`
+	Message string               `json:"message" extensions:"x-order=1"`
+	Data    auditLogsInfoWrapper `json:"data" extensions:"x-order=2"`
+}
+
+type auditLogsInfoWrapper struct {
+	AuditLogs []response.AuditLog `json:"list" extensions:"x-order=0"`
+	PageInfo  pageInfoData        `json:"pageInfo" extensions:"x-order=1"`
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
 	mas "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/admin/mocks"
+	mads "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
 	mtg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
 	"github.com/labstack/echo/v4"
@@ -22,7 +26,7 @@ import (
 func TestRouteAdmin(t *testing.T) {
 	mockAdminService := &mas.AdminService{}
 	mockTokenGen := &mtg.TokenGenerator{}
-	controller := NewAdminController(mockAdminService, mockTokenGen)
+	controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
 	g := echo.New().Group("/api/v1")
 	controller.Route(g)
 	assert.NotNil(t, controller)
@@ -70,7 +74,7 @@ func TestGetInfo(t *testing.T) {
 		).Once()
 
 		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewAdminController(mockAdminService, mockTokenGen)
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/dashboard", nil)
@@ -136,7 +140,7 @@ func TestGetInfo(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviours()
 
-				controller := NewAdminController(mockAdminService, mockTokenGen)
+				controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/dashboard", nil)
@@ -154,3 +158,846 @@ func TestGetInfo(t *testing.T) {
 		}
 	})
 }
+
+func TestGetStats(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyStats := response.Stats{
+			From:     "2022-06-01",
+			To:       "2022-06-30",
+			Interval: "week",
+			Series: []response.StatsBucket{
+				{Start: "2022-05-30", NewUser: 12, NewThread: 5, NewComment: 40, NewLike: 61, NewReport: 2},
+			},
+			TopCategories: []response.CategoryStats{
+				{ID: "c-abc", Name: "Golang", NewThread: 3},
+			},
+			TopThreads:      []response.ThreadStats{},
+			MostActiveUsers: []response.UserStats{},
+		}
+
+		dummyResp := model.NewResponse("success", "Get stats successful.", dummyStats)
+
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GetStats",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+			payload.StatsFilter{From: "2022-06-01", To: "2022-06-30", Interval: "week", Limit: 3},
+		).Return(
+			func(ctx context.Context, accessorRole string, f payload.StatsFilter) response.Stats {
+				return dummyStats
+			},
+			func(ctx context.Context, accessorRole string, f payload.StatsFilter) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats?from=2022-06-01&to=2022-06-30&interval=week&limit=3", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getStats(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", response.Stats{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResp.Data, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GetStats",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+			payload.StatsFilter{Interval: "year"},
+		).Return(
+			func(ctx context.Context, accessorRole string, f payload.StatsFilter) response.Stats {
+				return response.Stats{}
+			},
+			func(ctx context.Context, accessorRole string, f payload.StatsFilter) error {
+				return service.ErrInvalidPayload
+			},
+		).Once()
+
+		t.Run("it should return 400 status code, when the interval is unknown", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats?interval=year", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getStats(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+					assert.Equal(t, "Invalid payload. Please check the payload schema in the API Documentation.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetExport(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyExport := "ID,username\nu-abcdef,erikrios\n"
+
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"Export",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+			"users",
+			payload.ExportFilter{CreatedAfter: "2022-06-01"},
+			mock.Anything,
+		).Run(func(args mock.Arguments) {
+			_, _ = io.WriteString(args.Get(4).(io.Writer), dummyExport)
+		}).Return(nil).Once()
+
+		t.Run("it should return 200 status code with the csv file, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/export/users?createdAfter=2022-06-01", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("resource")
+			c.SetParamValues("users")
+
+			if assert.NoError(t, controller.getExport(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "text/csv; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, `attachment; filename="users.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, dummyExport, rec.Body.String())
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"Export",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+			"sessions",
+			payload.ExportFilter{Format: "ndjson"},
+			mock.Anything,
+		).Return(service.ErrInvalidPayload).Once()
+
+		t.Run("it should return 400 status code, when the resource is unknown", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/export/sessions?format=ndjson", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("resource")
+			c.SetParamValues("sessions")
+
+			gotErr := controller.getExport(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+					assert.Equal(t, "Invalid payload. Please check the payload schema in the API Documentation.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetLockouts(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyLockouts := []response.Lockout{
+			{
+				Kind:           "username",
+				Value:          "erikrios",
+				FailedAttempts: 6,
+				LastFailedOn:   "17 Oct 22 10:04 UTC",
+				LockedUntil:    "17 Oct 22 10:06 UTC",
+			},
+		}
+
+		dummyResp := model.NewResponse("success", "Get lockouts successful.", dummyLockouts)
+
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GetAllLockout",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+		).Return(
+			func(ctx context.Context, accessorRole string) []response.Lockout {
+				return dummyLockouts
+			},
+			func(ctx context.Context, accessorRole string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/lockouts", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getLockouts(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", []response.Lockout{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResp.Data, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{ID: "u-abcdef", Username: "erikrios", Role: "user", IsActive: true}
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GetAllLockout",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"user",
+		).Return(
+			func(ctx context.Context, accessorRole string) []response.Lockout {
+				return nil
+			},
+			func(ctx context.Context, accessorRole string) error {
+				return service.ErrAccessForbidden
+			},
+		).Once()
+
+		t.Run("it should return 403 status code, when the accessor is not an admin", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/lockouts", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getLockouts(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusForbidden, echoHTTPError.Code)
+					assert.Equal(t, "Access to this resource is forbidden for current role.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestDeleteLockout(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"ClearLockout",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"a-abcd",
+			"admin",
+			"ip",
+			"10.0.0.1",
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, kind string, value string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/admin/lockouts/:kind/:value")
+			c.SetParamNames("kind", "value")
+			c.SetParamValues("ip", "10.0.0.1")
+
+			if assert.NoError(t, controller.deleteLockout(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"ClearLockout",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, kind string, value string) error {
+				return service.ErrDataNotFound
+			},
+		).Once()
+
+		t.Run("it should return 404 status code, when there is no lockout", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/admin/lockouts/:kind/:value")
+			c.SetParamNames("kind", "value")
+			c.SetParamValues("username", "erikrios")
+
+			gotErr := controller.deleteLockout(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusNotFound, echoHTTPError.Code)
+					assert.Equal(t, "Resource with given ID not found.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetRoles(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyRoles := []response.Role{
+			{Name: "global_moderator", Permissions: []string{"reports:manage", "users:ban"}},
+			{Name: "user", Permissions: []string{}},
+		}
+
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GetAllRole",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+		).Return(
+			func(ctx context.Context, accessorRole string) []response.Role {
+				return dummyRoles
+			},
+			func(ctx context.Context, accessorRole string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/roles", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getRoles(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := model.NewResponse("", "", []response.Role{})
+
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyRoles, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{ID: "u-abcdef", Username: "erikrios", Role: "global_moderator", IsActive: true}
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GetAllRole",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"global_moderator",
+		).Return(
+			func(ctx context.Context, accessorRole string) []response.Role {
+				return nil
+			},
+			func(ctx context.Context, accessorRole string) error {
+				return service.ErrAccessForbidden
+			},
+		).Once()
+
+		t.Run("it should return 403 status code, when the accessor can't manage the roles", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/roles", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getRoles(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusForbidden, echoHTTPError.Code)
+				}
+			}
+		})
+	})
+}
+
+func TestPutUserRole(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GrantRole",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"a-abcd",
+			"admin",
+			"erikrios",
+			payload.GrantRole{Role: "global_moderator"},
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.GrantRole) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"role": "global_moderator"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/admin/users/:username/role")
+			c.SetParamNames("username")
+			c.SetParamValues("erikrios")
+
+			if assert.NoError(t, controller.putUserRole(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"GrantRole",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.GrantRole{})),
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.GrantRole) error {
+				return service.ErrInvalidPayload
+			},
+		).Once()
+
+		t.Run("it should return 400 status code, when the role is unknown", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"role": "superuser"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/admin/users/:username/role")
+			c.SetParamNames("username")
+			c.SetParamValues("erikrios")
+
+			gotErr := controller.putUserRole(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+					assert.Equal(t, "Invalid payload. Please check the payload schema in the API Documentation.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestDeleteUserRole(t *testing.T) {
+	mockAdminService := &mas.AdminService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"RevokeRole",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"a-abcd",
+			"admin",
+			"erikrios",
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/admin/users/:username/role")
+			c.SetParamNames("username")
+			c.SetParamValues("erikrios")
+
+			if assert.NoError(t, controller.deleteUserRole(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockAdminService.On(
+			"RevokeRole",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string) error {
+				return service.ErrUsernameNotFound
+			},
+		).Once()
+
+		t.Run("it should return 404 status code, when the user doesn't exist", func(t *testing.T) {
+			controller := NewAdminController(mockAdminService, &mads.AuditService{}, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/admin/users/:username/role")
+			c.SetParamNames("username")
+			c.SetParamValues("unknown")
+
+			gotErr := controller.deleteUserRole(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusNotFound, echoHTTPError.Code)
+					assert.Equal(t, "User with given username not found.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetAuditLogs(t *testing.T) {
+	mockAuditService := &mads.AuditService{}
+	mockTokenGen := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGen.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "admin",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockAuditService.On(
+			"GetAll",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"admin",
+			uint(2),
+			uint(0),
+			payload.AuditFilter{ActorUsername: "naruto", Action: "user.ban", CreatedAfter: "2022-07-01"},
+		).Return(
+			func(ctx context.Context, accessorRole string, page, limit uint, f payload.AuditFilter) response.Pagination[response.AuditLog] {
+				return response.Pagination[response.AuditLog]{
+					List: []response.AuditLog{{ID: "a-aBcDeFg", ActorUsername: "naruto", Action: "user.ban"}},
+				}
+			},
+			func(ctx context.Context, accessorRole string, page, limit uint, f payload.AuditFilter) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewAdminController(&mas.AdminService{}, mockAuditService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?page=2&actor=naruto&action=user.ban&createdAfter=2022-07-01", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/admin/audit")
+
+			if assert.NoError(t, controller.getAuditLogs(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := make(map[string]any)
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, "Get audit logs successful.", gotResponse["message"])
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputError           error
+			expectedStatusCode   int
+			expectedErrorMessage string
+		}{
+			{
+				name:                 "it should return 403 status code, when the role is not admin",
+				inputError:           service.ErrAccessForbidden,
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+			},
+			{
+				name:                 "it should return 400 status code, when the date filter is invalid",
+				inputError:           service.ErrInvalidPayload,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputError:           service.ErrRepository,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockTokenGen.On(
+					"ExtractToken",
+					mock.AnythingOfType("*echo.context"),
+				).Return(
+					func(c echo.Context) generator.TokenPayload {
+						return generator.TokenPayload{
+							ID:       "u-abcdefg",
+							Username: "erikrios",
+							Role:     "user",
+							IsActive: true,
+						}
+					},
+				).Once()
+
+				mockAuditService.On(
+					"GetAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.AuditFilter{})),
+				).Return(
+					func(ctx context.Context, accessorRole string, page, limit uint, f payload.AuditFilter) response.Pagination[response.AuditLog] {
+						return response.Pagination[response.AuditLog]{}
+					},
+					func(ctx context.Context, accessorRole string, page, limit uint, f payload.AuditFilter) error {
+						return testCase.inputError
+					},
+				).Once()
+
+				controller := NewAdminController(&mas.AdminService{}, mockAuditService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/admin/audit")
+
+				gotErr := controller.getAuditLogs(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
@@ -63,7 +63,7 @@ func (c *categoriesController) postCreateCategory(e echo.Context) error {
 		return newErrorResponse(service.ErrInvalidPayload)
 	}
 
-	id, err := c.categoryService.Create(e.Request().Context(), tp.Role, *p)
+	id, err := c.categoryService.Create(e.Request().Context(), tp.ID, tp.Role, *p)
 	if err != nil {
 		return newErrorResponse(err)
 	}
@@ -122,7 +122,7 @@ func (c *categoriesController) putUpdateCategory(e echo.Context) error {
 		return newErrorResponse(service.ErrInvalidPayload)
 	}
 
-	if err := c.categoryService.Update(e.Request().Context(), tp.Role, id, *p); err != nil {
+	if err := c.categoryService.Update(e.Request().Context(), tp.ID, tp.Role, id, *p); err != nil {
 		return newErrorResponse(err)
 	}
 
@@ -147,7 +147,7 @@ func (c *categoriesController) deleteCategory(e echo.Context) error {
 
 	tp := c.tokenGenerator.ExtractToken(e)
 
-	if err := c.categoryService.Delete(e.Request().Context(), tp.Role, id); err != nil {
+	if err := c.categoryService.Delete(e.Request().Context(), tp.ID, tp.Role, id); err != nil {
 		return newErrorResponse(err)
 	}
 
@@ -159,12 +159,19 @@ func (c *categoriesController) deleteCategory(e echo.Context) error {
 // @Description  This endpoint is used to get the threads of particular category
 // @Tags         categories
 // @Produce      json
-// @Param        id     path   string  true   "category ID"
-// @Param        page   query  int     false  "page, default 1"
-// @Param        limit  query  int     false  "limit, default 10"
+// @Param        id             path   string  true   "category ID"
+// @Param        page           query  int     false  "page, default 1"
+// @Param        limit          query  int     false  "limit, default 10"
+// @Param        cursor         query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        sort           query  string  false  "options: newest, liked, viewed, commented, trending, default newest"
+// @Param        unanswered     query  bool    false  "only threads without comments, default false"
+// @Param        followed       query  bool    false  "only threads followed by current user, default false"
+// @Param        createdAfter   query  string  false  "only threads created on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only threads created before the date, format 2006-01-02"
 // @Security     ApiKey
 // @Security     ApiKeyAuth
 // @Success      200  {object}  threadsResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /categories/{id}/threads [get]
@@ -172,6 +179,9 @@ func (c *categoriesController) getCategoryThreads(e echo.Context) error {
 	id := e.Param("id")
 	pageStr := e.QueryParam("page")
 	limitStr := e.QueryParam("limit")
+	cursor := e.QueryParam("cursor")
+	sort := e.QueryParam("sort")
+	filter := newThreadFilter(e)
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -185,7 +195,7 @@ func (c *categoriesController) getCategoryThreads(e echo.Context) error {
 
 	tp := c.tokenGenerator.ExtractToken(e)
 
-	threadsResponse, err := c.categoryService.GetAllByCategory(e.Request().Context(), tp.ID, id, uint(page), uint(limit))
+	threadsResponse, err := c.categoryService.GetAllByCategory(e.Request().Context(), tp.ID, id, uint(page), uint(limit), cursor, sort, filter)
 	if err != nil {
 		return newErrorResponse(err)
 	}
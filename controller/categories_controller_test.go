@@ -63,12 +63,13 @@ func TestPostCreateCategory(t *testing.T) {
 			"Create",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateCategory{})),
 		).Return(
-			func(ctx context.Context, accessorRole string, p payload.CreateCategory) string {
+			func(ctx context.Context, accessorUserID, accessorRole string, p payload.CreateCategory) string {
 				return dummyID
 			},
-			func(ctx context.Context, accessorRole string, p payload.CreateCategory) error {
+			func(ctx context.Context, accessorUserID, accessorRole string, p payload.CreateCategory) error {
 				return nil
 			},
 		).Once()
@@ -136,12 +137,13 @@ func TestPostCreateCategory(t *testing.T) {
 						"Create",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateCategory{})),
 					).Return(
-						func(ctx context.Context, accessorRole string, p payload.CreateCategory) string {
+						func(ctx context.Context, accessorUserID, accessorRole string, p payload.CreateCategory) string {
 							return ""
 						},
-						func(ctx context.Context, accessorRole string, p payload.CreateCategory) error {
+						func(ctx context.Context, accessorUserID, accessorRole string, p payload.CreateCategory) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -345,9 +347,10 @@ func TestPutUpdateCategory(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateCategory{})),
 		).Return(
-			func(ctx context.Context, accessorRole string, id string, p payload.UpdateCategory) error {
+			func(ctx context.Context, accessorUserID, accessorRole string, id string, p payload.UpdateCategory) error {
 				return nil
 			},
 		).Once()
@@ -410,9 +413,10 @@ func TestPutUpdateCategory(t *testing.T) {
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateCategory{})),
 					).Return(
-						func(ctx context.Context, accessorRole string, id string, p payload.UpdateCategory) error {
+						func(ctx context.Context, accessorUserID, accessorRole string, id string, p payload.UpdateCategory) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -473,8 +477,9 @@ func TestDeleteCategory(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 		).Return(
-			func(ctx context.Context, accessorRole string, id string) error {
+			func(ctx context.Context, accessorUserID, accessorRole string, id string) error {
 				return nil
 			},
 		).Once()
@@ -527,8 +532,9 @@ func TestDeleteCategory(t *testing.T) {
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					).Return(
-						func(ctx context.Context, accessorRole string, id string) error {
+						func(ctx context.Context, accessorUserID, accessorRole string, id string) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -619,6 +625,9 @@ func TestGetCategoryThreads(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 		).Return(
 			func(
 				ctx context.Context,
@@ -626,6 +635,9 @@ func TestGetCategoryThreads(t *testing.T) {
 				categoryID string,
 				page uint,
 				limit uint,
+				cursor string,
+				sort string,
+				f payload.ThreadFilter,
 			) response.Pagination[response.ManyThread] {
 				return dummyPagination
 			},
@@ -635,6 +647,9 @@ func TestGetCategoryThreads(t *testing.T) {
 				categoryID string,
 				page uint,
 				limit uint,
+				cursor string,
+				sort string,
+				f payload.ThreadFilter,
 			) error {
 				return nil
 			},
@@ -699,6 +714,9 @@ func TestGetCategoryThreads(t *testing.T) {
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 					).Return(
 						func(
 							ctx context.Context,
@@ -706,6 +724,9 @@ func TestGetCategoryThreads(t *testing.T) {
 							categoryID string,
 							page uint,
 							limit uint,
+							cursor string,
+							sort string,
+							f payload.ThreadFilter,
 						) response.Pagination[response.ManyThread] {
 							return response.Pagination[response.ManyThread]{}
 						},
@@ -715,6 +736,9 @@ func TestGetCategoryThreads(t *testing.T) {
 							categoryID string,
 							page uint,
 							limit uint,
+							cursor string,
+							sort string,
+							f payload.ThreadFilter,
 						) error {
 							return service.ErrRepository
 						},
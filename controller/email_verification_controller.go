@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user"
+	"github.com/labstack/echo/v4"
+)
+
+type emailVerificationController struct {
+	userService user.UserService
+}
+
+func NewEmailVerificationController(userService user.UserService) *emailVerificationController {
+	return &emailVerificationController{userService: userService}
+}
+
+func (e *emailVerificationController) Route(g *echo.Group) {
+	group := g.Group("/email-verification")
+	group.POST("", e.postEmailVerification)
+	group.POST("/confirm", e.postEmailVerificationConfirm)
+}
+
+// postEmailVerification godoc
+// @Summary      Request Email Verification
+// @Description  This endpoint is used to send the verification link to the given email, the link expires in 24 hours and can only be used once.
+// @Description  The response is the same whether the email is registered or not.
+// @Tags         email-verification
+// @Accept       json
+// @Produce      json
+// @Security     ApiKey
+// @Param        default  body  payload.EmailRequest  true  "email payload"
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /email-verification [post]
+func (e *emailVerificationController) postEmailVerification(c echo.Context) error {
+	p := new(payload.EmailRequest)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := e.userService.RequestEmailVerification(c.Request().Context(), *p); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postEmailVerificationConfirm godoc
+// @Summary      Verify Email
+// @Description  This endpoint is used to verify the email with the token from the verification link.
+// @Tags         email-verification
+// @Accept       json
+// @Produce      json
+// @Security     ApiKey
+// @Param        default  body  payload.VerifyEmail  true  "verification token"
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /email-verification/confirm [post]
+func (e *emailVerificationController) postEmailVerificationConfirm(c echo.Context) error {
+	p := new(payload.VerifyEmail)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := e.userService.VerifyEmail(c.Request().Context(), *p); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
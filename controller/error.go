@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
 	"github.com/labstack/echo/v4"
@@ -31,6 +32,24 @@ func newErrorResponse(err error) *echo.HTTPError {
 	} else if errors.Is(err, service.ErrAccessForbidden) {
 		statusCode = http.StatusForbidden
 		message = "Access to this resource is forbidden for current role."
+	} else if errors.Is(err, service.ErrInvalidToken) {
+		statusCode = http.StatusUnauthorized
+		message = "Invalid or expired token."
+	} else if errors.Is(err, service.ErrEmailNotVerified) {
+		statusCode = http.StatusForbidden
+		message = "Email address is not verified. Please open the link sent to your email."
+	} else if errors.Is(err, service.ErrTooManyAttempts) {
+		statusCode = http.StatusTooManyRequests
+		message = "Too many failed login attempts. Please try again later."
+	} else if errors.Is(err, service.ErrUserBanned) {
+		statusCode = http.StatusForbidden
+		message = bannedMessage(err)
+	} else if errors.Is(err, service.ErrThreadLocked) {
+		statusCode = http.StatusForbidden
+		message = "Thread is locked."
+	} else if errors.Is(err, service.ErrUserMuted) {
+		statusCode = http.StatusForbidden
+		message = "You are muted in this thread."
 	} else if errors.Is(err, service.ErrRepository) {
 		statusCode = http.StatusInternalServerError
 		message = "Something went wrong."
@@ -42,3 +61,19 @@ func newErrorResponse(err error) *echo.HTTPError {
 
 	return echo.NewHTTPError(statusCode, message)
 }
+
+func bannedMessage(err error) string {
+	message := "You are banned permanently"
+
+	var bannedErr *service.BannedError
+	if errors.As(err, &bannedErr) {
+		if !bannedErr.ExpiresAt.IsZero() {
+			message = "You are banned until " + bannedErr.ExpiresAt.Format(time.RFC822)
+		}
+		if bannedErr.Reason != "" {
+			message += " because " + bannedErr.Reason
+		}
+	}
+
+	return message + "."
+}
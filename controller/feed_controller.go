@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/feed"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/labstack/echo/v4"
+)
+
+type feedController struct {
+	feedService    feed.FeedService
+	tokenGenerator generator.TokenGenerator
+}
+
+func NewFeedController(
+	feedService feed.FeedService,
+	tokenGenerator generator.TokenGenerator,
+) *feedController {
+	return &feedController{
+		feedService:    feedService,
+		tokenGenerator: tokenGenerator,
+	}
+}
+
+func (f *feedController) Route(g *echo.Group) {
+	group := g.Group("/feed")
+	group.GET("", f.getFeed, middleware.JWTMiddleware())
+}
+
+// getFeed       godoc
+// @Summary      Get Feed
+// @Description  This endpoint is used to get the newest threads of followed users, comments on followed threads and threads in the categories the current user posts in
+// @Tags         feed
+// @Produce      json
+// @Param        cursor  query  string  false  "nextCursor of the previous page, default empty string (newest activity)"
+// @Param        limit   query  int     false  "limit, default 10"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  feedResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /feed [get]
+func (f *feedController) getFeed(c echo.Context) error {
+	cursor := c.QueryParam("cursor")
+	limitStr := c.QueryParam("limit")
+
+	limit, convErr := strconv.Atoi(limitStr)
+	if convErr != nil || limit < 0 {
+		limit = 0
+	}
+
+	tp := f.tokenGenerator.ExtractToken(c)
+
+	feedResponse, err := f.feedService.GetFeed(c.Request().Context(), tp.ID, cursor, uint(limit))
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get feed successful.", feedResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// feedResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type feedResponse struct {
+	Status  string        `json:"status" extensions:"x-order=0"`
+	Message string        `json:"message" extensions:"x-order=1"`
+	Data    response.Feed `json:"data" extensions:"x-order=2"`
+}
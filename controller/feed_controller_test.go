@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mfs "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/feed/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	mtg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteFeed(t *testing.T) {
+	mockFeedService := &mfs.FeedService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+	controller := NewFeedController(mockFeedService, mockTokenGenerator)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestGetFeed(t *testing.T) {
+	mockFeedService := &mfs.FeedService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyFeed := response.Feed{
+			List: []response.FeedItem{
+				{
+					Type:        "followed_user_thread",
+					ID:          "t-abcdefg",
+					PublishedOn: "26 Jun 22 08:15 UTC",
+					Thread: response.FeedThread{
+						ID:              "t-abcdefg",
+						Title:           "Concurrency",
+						CategoryID:      "g-abc",
+						CategoryName:    "Tech",
+						CreatorID:       "u-ghijkl",
+						CreatorUsername: "naruto",
+						CreatorName:     "Naruto Uzumaki",
+						PublishedOn:     "26 Jun 22 08:15 UTC",
+					},
+				},
+			},
+			NextCursor: "MTY1NjIzMTMzMjEyMzQ1Njp0LWFiY2RlZmc",
+		}
+
+		dummyResp := model.NewResponse("success", "Get feed successful.", dummyFeed)
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockFeedService.On(
+			"GetFeed",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+			"",
+			uint(1),
+		).Return(
+			func(ctx context.Context, accessorUserID string, cursor string, limit uint) response.Feed {
+				return dummyFeed
+			},
+			func(ctx context.Context, accessorUserID string, cursor string, limit uint) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewFeedController(mockFeedService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/feed?limit=1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getFeed(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", response.Feed{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResp.Data, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 400 status code, when the cursor is invalid",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return dummyTokenPayload
+						},
+					).Once()
+
+					mockFeedService.On(
+						"GetFeed",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					).Return(
+						func(ctx context.Context, accessorUserID string, cursor string, limit uint) response.Feed {
+							return response.Feed{}
+						},
+						func(ctx context.Context, accessorUserID string, cursor string, limit uint) error {
+							return service.ErrInvalidPayload
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return dummyTokenPayload
+						},
+					).Once()
+
+					mockFeedService.On(
+						"GetFeed",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					).Return(
+						func(ctx context.Context, accessorUserID string, cursor string, limit uint) response.Feed {
+							return response.Feed{}
+						},
+						func(ctx context.Context, accessorUserID string, cursor string, limit uint) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewFeedController(mockFeedService, mockTokenGenerator)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/feed?cursor=abc", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotErr := controller.getFeed(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
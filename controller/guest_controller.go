@@ -37,17 +37,28 @@ func (gc *guestController) Route(g *echo.Group) {
 // @Description  This endpoint is used to get all threads
 // @Tags         guest
 // @Produce      json
-// @Param        page    query  int     false  "page, default 1"
-// @Param        limit   query  int     false  "limit, default 10"
-// @Param        search  query  string  false  "search by keyword, default empty string"
+// @Param        page           query  int     false  "page, default 1"
+// @Param        limit          query  int     false  "limit, default 10"
+// @Param        cursor         query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        search         query  string  false  "full-text search on title, description and comments, supports phrases in double quotes and -exclusion, default empty string"
+// @Param        sort           query  string  false  "options: newest, liked, viewed, commented, trending, default newest, or relevance when searching"
+// @Param        unanswered     query  bool    false  "only threads without comments, default false"
+// @Param        createdAfter   query  string  false  "only threads created on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only threads created before the date, format 2006-01-02"
+// @Param        category       query  string  false  "category ID"
 // @Security     ApiKey
 // @Success      200  {object}  threadsResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /guest/threads [get]
 func (g *guestController) getThreads(c echo.Context) error {
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
 	search := c.QueryParam("search")
+	sort := c.QueryParam("sort")
+	filter := newThreadFilter(c)
+	filter.FollowedOnly = false
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -59,7 +70,7 @@ func (g *guestController) getThreads(c echo.Context) error {
 		limit = 0
 	}
 
-	threadsResponse, err := g.threadService.GetAll(c.Request().Context(), "", uint(page), uint(limit), search)
+	threadsResponse, err := g.threadService.GetAll(c.Request().Context(), "", uint(page), uint(limit), cursor, search, sort, filter)
 	if err != nil {
 		return newErrorResponse(err)
 	}
@@ -97,9 +108,12 @@ func (g *guestController) getThread(c echo.Context) error {
 // @Description  This endpoint is used to get the thread comments
 // @Tags         guest
 // @Produce      json
-// @Param        id     path   string  true   "thread ID"
-// @Param        page   query  int     false  "page, default 1"
-// @Param        limit  query  int     false  "limit, default 20"
+// @Param        id      path   string  true   "thread ID"
+// @Param        page    query  int     false  "page, default 1"
+// @Param        limit   query  int     false  "limit, default 20"
+// @Param        cursor  query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        view    query  string  false  "options: flat, tree, slice, default flat"
+// @Param        depth   query  int     false  "maximum reply depth for tree and slice view, default 0 (unlimited)"
 // @Security     ApiKey
 // @Success      200  {object}  commentsResponse
 // @Failure      404  {object}  echo.HTTPError
@@ -109,6 +123,9 @@ func (g *guestController) getThreadComments(c echo.Context) error {
 	id := c.Param("id")
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
+	view := c.QueryParam("view")
+	depthStr := c.QueryParam("depth")
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -120,7 +137,12 @@ func (g *guestController) getThreadComments(c echo.Context) error {
 		limit = 0
 	}
 
-	commentsResponse, err := g.threadService.GetComments(c.Request().Context(), id, uint(page), uint(limit))
+	depth, convErr := strconv.Atoi(depthStr)
+	if convErr != nil || depth < 0 {
+		depth = 0
+	}
+
+	commentsResponse, err := g.threadService.GetComments(c.Request().Context(), id, uint(page), uint(limit), cursor, view, uint(depth))
 	if err != nil {
 		return newErrorResponse(err)
 	}
@@ -136,6 +158,7 @@ func (g *guestController) getThreadComments(c echo.Context) error {
 // @Produce      json
 // @Param        page      query  int     false  "page, default 1"
 // @Param        limit     query  int     false  "limit, default 20"
+// @Param        cursor    query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
 // @Param        order_by  query  string  false  "options: registered_date, ranking, default registered_date"
 // @Param        status    query  string  false  "options: active, banned, default active"
 // @Param        keyword   query  string  false  "search by keyword, default empty string"
@@ -146,6 +169,7 @@ func (g *guestController) getThreadComments(c echo.Context) error {
 func (g *guestController) getUsers(c echo.Context) error {
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
 	orderBy := c.QueryParam("order_by")
 	status := c.QueryParam("status")
 	keyword := c.QueryParam("keyword")
@@ -167,6 +191,7 @@ func (g *guestController) getUsers(c echo.Context) error {
 		status,
 		uint(page),
 		uint(limit),
+		cursor,
 		keyword,
 	)
 
@@ -208,11 +233,18 @@ func (g *guestController) getUserByUsername(c echo.Context) error {
 // @Description  This endpoint is used to get the user threads
 // @Tags         guest
 // @Produce      json
-// @Param        username  path   string  true   "username"
-// @Param        page      query  int     false  "page, default 1"
-// @Param        limit     query  int     false  "limit, default 10"
+// @Param        username       path   string  true   "username"
+// @Param        page           query  int     false  "page, default 1"
+// @Param        limit          query  int     false  "limit, default 10"
+// @Param        cursor         query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        sort           query  string  false  "options: newest, liked, viewed, commented, trending, default newest"
+// @Param        unanswered     query  bool    false  "only threads without comments, default false"
+// @Param        createdAfter   query  string  false  "only threads created on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only threads created before the date, format 2006-01-02"
+// @Param        category       query  string  false  "category ID"
 // @Security     ApiKey
 // @Success      200  {object}  threadsResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /guest/users/{username}/threads [get]
@@ -221,6 +253,10 @@ func (g *guestController) getUserThreads(c echo.Context) error {
 
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
+	sort := c.QueryParam("sort")
+	filter := newThreadFilter(c)
+	filter.FollowedOnly = false
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -238,6 +274,9 @@ func (g *guestController) getUserThreads(c echo.Context) error {
 		username,
 		uint(page),
 		uint(limit),
+		cursor,
+		sort,
+		filter,
 	)
 
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
 	mts "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/thread/mocks"
@@ -71,13 +72,19 @@ func TestGuestGetThreads(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 		).Return(
 			func(
 				ctx context.Context,
 				accessorUserID string,
 				page uint,
 				limit uint,
+				cursor string,
 				query string,
+				sort string,
+				f payload.ThreadFilter,
 			) response.Pagination[response.ManyThread] {
 				return dummyPagination
 			},
@@ -86,7 +93,10 @@ func TestGuestGetThreads(t *testing.T) {
 				accessorUserID string,
 				page uint,
 				limit uint,
+				cursor string,
 				query string,
+				sort string,
+				f payload.ThreadFilter,
 			) error {
 				return nil
 			},
@@ -134,13 +144,19 @@ func TestGuestGetThreads(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 				).Return(
 					func(
 						ctx context.Context,
 						accessorUserID string,
 						page uint,
 						limit uint,
+						cursor string,
 						query string,
+						sort string,
+						f payload.ThreadFilter,
 					) response.Pagination[response.ManyThread] {
 						return response.Pagination[response.ManyThread]{}
 					},
@@ -149,7 +165,10 @@ func TestGuestGetThreads(t *testing.T) {
 						accessorUserID string,
 						page uint,
 						limit uint,
+						cursor string,
 						query string,
+						sort string,
+						f payload.ThreadFilter,
 					) error {
 						return service.ErrRepository
 					},
@@ -357,12 +376,18 @@ func TestGuestGetThreadComments(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 		).Return(
 			func(
 				ctx context.Context,
 				threadID string,
 				page uint,
 				limit uint,
+				cursor string,
+				view string,
+				depth uint,
 			) response.Pagination[response.Comment] {
 				return dummyPagination
 			},
@@ -371,6 +396,9 @@ func TestGuestGetThreadComments(t *testing.T) {
 				threadID string,
 				page uint,
 				limit uint,
+				cursor string,
+				view string,
+				depth uint,
 			) error {
 				return nil
 			},
@@ -420,12 +448,18 @@ func TestGuestGetThreadComments(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 				).Return(
 					func(
 						ctx context.Context,
 						threadID string,
 						page uint,
 						limit uint,
+						cursor string,
+						view string,
+						depth uint,
 					) response.Pagination[response.Comment] {
 						return response.Pagination[response.Comment]{}
 					},
@@ -434,6 +468,9 @@ func TestGuestGetThreadComments(t *testing.T) {
 						threadID string,
 						page uint,
 						limit uint,
+						cursor string,
+						view string,
+						depth uint,
 					) error {
 						return service.ErrRepository
 					},
@@ -508,6 +545,7 @@ func TestGuestGetUsers(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 		).Return(
 			func(ctx context.Context,
 				accessorUserID string,
@@ -515,6 +553,7 @@ func TestGuestGetUsers(t *testing.T) {
 				status string,
 				page uint,
 				limit uint,
+				cursor string,
 				keyword string,
 			) response.Pagination[response.User] {
 				return dummyPagination
@@ -525,6 +564,7 @@ func TestGuestGetUsers(t *testing.T) {
 				status string,
 				page uint,
 				limit uint,
+				cursor string,
 				keyword string,
 			) error {
 				return nil
@@ -575,6 +615,7 @@ func TestGuestGetUsers(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
@@ -582,6 +623,7 @@ func TestGuestGetUsers(t *testing.T) {
 						status string,
 						page uint,
 						limit uint,
+						cursor string,
 						keyword string,
 					) response.Pagination[response.User] {
 						return response.Pagination[response.User]{}
@@ -592,6 +634,7 @@ func TestGuestGetUsers(t *testing.T) {
 						status string,
 						page uint,
 						limit uint,
+						cursor string,
 						keyword string,
 					) error {
 						return service.ErrRepository
@@ -773,12 +816,18 @@ func TestGuestGetUserThreads(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 		).Return(
 			func(ctx context.Context,
 				accessorUserID string,
 				username string,
 				page uint,
 				limit uint,
+				cursor string,
+				sort string,
+				f payload.ThreadFilter,
 			) response.Pagination[response.ManyThread] {
 				return dummyPagination
 			},
@@ -787,6 +836,9 @@ func TestGuestGetUserThreads(t *testing.T) {
 				username string,
 				page uint,
 				limit uint,
+				cursor string,
+				sort string,
+				f payload.ThreadFilter,
 			) error {
 				return nil
 			},
@@ -834,12 +886,18 @@ func TestGuestGetUserThreads(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
 						username string,
 						page uint,
 						limit uint,
+						cursor string,
+						sort string,
+						f payload.ThreadFilter,
 					) response.Pagination[response.ManyThread] {
 						return response.Pagination[response.ManyThread]{}
 					},
@@ -848,6 +906,9 @@ func TestGuestGetUserThreads(t *testing.T) {
 						username string,
 						page uint,
 						limit uint,
+						cursor string,
+						sort string,
+						f payload.ThreadFilter,
 					) error {
 						return service.ErrRepository
 					},
@@ -1,7 +1,10 @@
 package controller
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
@@ -22,11 +25,12 @@ func NewLoginController(userService user.UserService) *loginController {
 func (l *loginController) Route(g *echo.Group) {
 	group := g.Group("/login")
 	group.POST("", l.postLogin)
+	group.POST("/refresh", l.postRefresh)
 }
 
 // PostLogin     godoc
 // @Summary      User Login
-// @Description  This endpoint is used for user login
+// @Description  This endpoint is used for user login, a banned user gets 403 with the ban expiry and reason
 // @Tags         login
 // @Accept       json
 // @Produce      json
@@ -35,7 +39,9 @@ func (l *loginController) Route(g *echo.Group) {
 // @Success      200      {object}  loginResponse
 // @Failure      400      {object}  echo.HTTPError
 // @Failure      401      {object}  echo.HTTPError
+// @Failure      403      {object}  echo.HTTPError
 // @Failure      404      {object}  echo.HTTPError
+// @Failure      429      {object}  echo.HTTPError
 // @Failure      500      {object}  echo.HTTPError
 // @Router       /login [post]
 func (l *loginController) postLogin(c echo.Context) error {
@@ -44,8 +50,19 @@ func (l *loginController) postLogin(c echo.Context) error {
 		return newErrorResponse(service.ErrInvalidPayload)
 	}
 
+	if credential.Device == "" {
+		credential.Device = c.Request().UserAgent()
+	}
+
+	credential.IPAddress = c.RealIP()
+
 	tokenResponse, err := l.userService.Login(c.Request().Context(), *credential)
 	if err != nil {
+		var tooManyAttemptsErr *service.TooManyAttemptsError
+		if errors.As(err, &tooManyAttemptsErr) {
+			retryAfter := int(math.Ceil(tooManyAttemptsErr.RetryAfter.Seconds()))
+			c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+		}
 		return newErrorResponse(err)
 	}
 
@@ -53,6 +70,34 @@ func (l *loginController) postLogin(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// postRefresh   godoc
+// @Summary      Refresh Token
+// @Description  This endpoint is used to get a new access token, the given refresh token is rotated and can't be used again
+// @Tags         login
+// @Accept       json
+// @Produce      json
+// @Security     ApiKey
+// @Param        default  body      payload.RefreshToken  true  "refresh token"
+// @Success      200      {object}  loginResponse
+// @Failure      400      {object}  echo.HTTPError
+// @Failure      401      {object}  echo.HTTPError
+// @Failure      500      {object}  echo.HTTPError
+// @Router       /login/refresh [post]
+func (l *loginController) postRefresh(c echo.Context) error {
+	p := new(payload.RefreshToken)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	tokenResponse, err := l.userService.Refresh(c.Request().Context(), *p)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Refresh token successful.", tokenResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
 // loginResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type loginResponse struct {
 	Status  string         `json:"status" extensions:"x-order=0"`
@@ -8,7 +8,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
@@ -98,6 +100,49 @@ func TestPostLogin(t *testing.T) {
 			expectedErrorMessage string
 			mockBehaviour        func()
 		}{
+			{
+				name:                 "it should return 403 status code with the reason, when the user is banned permanently",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "You are banned permanently because Spamming.",
+				mockBehaviour: func() {
+					mockUserService.On(
+						"Login",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.Login{})),
+					).Return(
+						func(ctx context.Context, p payload.Login) response.Login {
+							return response.Login{}
+						},
+						func(ctx context.Context, p payload.Login) error {
+							return &service.BannedError{Reason: "Spamming"}
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 403 status code with the expiry and the reason, when the user is banned temporarily",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "You are banned until 07 Jul 22 10:00 UTC because Harrashment.",
+				mockBehaviour: func() {
+					mockUserService.On(
+						"Login",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.Login{})),
+					).Return(
+						func(ctx context.Context, p payload.Login) response.Login {
+							return response.Login{}
+						},
+						func(ctx context.Context, p payload.Login) error {
+							return &service.BannedError{
+								Reason:    "Harrashment",
+								ExpiresAt: time.Date(2022, time.July, 7, 10, 0, 0, 0, time.UTC),
+							}
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				inputPayload:         dummyReq,
@@ -143,5 +188,229 @@ func TestPostLogin(t *testing.T) {
 				}
 			})
 		}
+		t.Run("it should return 429 status code with the Retry-After header, when the login is locked out", func(t *testing.T) {
+			mockUserService.On(
+				"Login",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				mock.MatchedBy(func(p payload.Login) bool {
+					return p.IPAddress == "192.0.2.1"
+				}),
+			).Return(
+				func(ctx context.Context, p payload.Login) response.Login {
+					return response.Login{}
+				},
+				func(ctx context.Context, p payload.Login) error {
+					return &service.TooManyAttemptsError{RetryAfter: time.Second * 90}
+				},
+			).Once()
+
+			controller := NewLoginController(mockUserService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/login", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.postLogin(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusTooManyRequests, echoHTTPError.Code)
+					assert.Equal(t, "Too many failed login attempts. Please try again later.", echoHTTPError.Message)
+				}
+				assert.Equal(t, "90", rec.Header().Get(echo.HeaderRetryAfter))
+			}
+		})
+
+		t.Run("it should keep returning 429 status code, when the X-Forwarded-For header is forged", func(t *testing.T) {
+			mockUserService.On(
+				"Login",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				mock.MatchedBy(func(p payload.Login) bool {
+					return p.IPAddress == "192.0.2.1"
+				}),
+			).Return(
+				func(ctx context.Context, p payload.Login) response.Login {
+					return response.Login{}
+				},
+				func(ctx context.Context, p payload.Login) error {
+					return &service.TooManyAttemptsError{RetryAfter: time.Second * 90}
+				},
+			).Twice()
+
+			controller := NewLoginController(mockUserService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			e.IPExtractor, err = middleware.NewIPExtractor("")
+			assert.NoError(t, err)
+
+			for _, forwardedFor := range []string{"203.0.113.1", "203.0.113.2"} {
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/login", strings.NewReader(string(requestBody)))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+				req.Header.Set(echo.HeaderXRealIP, forwardedFor)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotErr := controller.postLogin(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusTooManyRequests, echoHTTPError.Code)
+					}
+				}
+			}
+		})
+	})
+
+	t.Run("it should use the X-Forwarded-For header, when the request comes through a trusted proxy", func(t *testing.T) {
+		mockUserService.On(
+			"Login",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.MatchedBy(func(p payload.Login) bool {
+				return p.IPAddress == "203.0.113.1"
+			}),
+		).Return(
+			func(ctx context.Context, p payload.Login) response.Login {
+				return response.Login{Token: "generatedtoken", Role: "user"}
+			},
+			func(ctx context.Context, p payload.Login) error {
+				return nil
+			},
+		).Once()
+
+		controller := NewLoginController(mockUserService)
+		requestBody, err := json.Marshal(payload.Login{Username: "erikrios", Password: "erikriosetiawan"})
+		assert.NoError(t, err)
+
+		e := echo.New()
+		e.IPExtractor, err = middleware.NewIPExtractor("192.0.2.0/24")
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", strings.NewReader(string(requestBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.1")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, controller.postLogin(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+}
+
+func TestPostRefresh(t *testing.T) {
+	mockUserService := &mocks.UserService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyReq := payload.RefreshToken{
+			RefreshToken: "refreshtoken",
+		}
+
+		mockUserService.On(
+			"Refresh",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.RefreshToken{})),
+		).Return(
+			func(ctx context.Context, p payload.RefreshToken) response.Login {
+				return response.Login{
+					Token:        "generatedtoken",
+					RefreshToken: "newrefreshtoken",
+					Role:         "user",
+				}
+			},
+			func(ctx context.Context, p payload.RefreshToken) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewLoginController(mockUserService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/login/refresh", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.postRefresh(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := make(map[string]any)
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					token := gotResponse["data"].(map[string]any)["token"].(string)
+					refreshToken := gotResponse["data"].(map[string]any)["refreshToken"].(string)
+					assert.Equal(t, "generatedtoken", token)
+					assert.Equal(t, "newrefreshtoken", refreshToken)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		dummyReq := payload.RefreshToken{
+			RefreshToken: "refreshtoken",
+		}
+
+		testCases := []struct {
+			name                 string
+			inputPayload         payload.RefreshToken
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 401 status code, when the refresh token is invalid",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusUnauthorized,
+				expectedErrorMessage: "Invalid or expired token.",
+				mockBehaviour: func() {
+					mockUserService.On(
+						"Refresh",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.RefreshToken{})),
+					).Return(
+						func(ctx context.Context, p payload.RefreshToken) response.Login {
+							return response.Login{}
+						},
+						func(ctx context.Context, p payload.RefreshToken) error {
+							return service.ErrInvalidToken
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewLoginController(mockUserService)
+				requestBody, err := json.Marshal(testCase.inputPayload)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/login/refresh", strings.NewReader(string(requestBody)))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotErr := controller.postRefresh(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
 	})
 }
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/labstack/echo/v4"
+)
+
+type logoutController struct {
+	userService    user.UserService
+	tokenGenerator generator.TokenGenerator
+}
+
+func NewLogoutController(
+	userService user.UserService,
+	tokenGenerator generator.TokenGenerator,
+) *logoutController {
+	return &logoutController{
+		userService:    userService,
+		tokenGenerator: tokenGenerator,
+	}
+}
+
+func (l *logoutController) Route(g *echo.Group) {
+	group := g.Group("/logout")
+	group.POST("", l.postLogout, middleware.JWTMiddleware())
+}
+
+// postLogout    godoc
+// @Summary      User Logout
+// @Description  This endpoint is used for user logout, the session of the current token is revoked
+// @Tags         logout
+// @Produce      json
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /logout [post]
+func (l *logoutController) postLogout(c echo.Context) error {
+	tp := l.tokenGenerator.ExtractToken(c)
+
+	if err := l.userService.Logout(c.Request().Context(), tp.SessionID); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
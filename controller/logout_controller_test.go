@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mus "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	mtg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteLogout(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+	controller := NewLogoutController(mockUserService, mockTokenGenerator)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostLogout(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:        "u-abcdef",
+					Username:  "erikrios",
+					Role:      "user",
+					IsActive:  true,
+					SessionID: "s-abcdefg",
+				}
+			},
+		).Once()
+
+		mockUserService.On(
+			"Logout",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"s-abcdefg",
+		).Return(
+			func(ctx context.Context, accessorSessionID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewLogoutController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logout", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.postLogout(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 404 status code, when the session is already revoked",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:        "u-abcdef",
+								Username:  "erikrios",
+								Role:      "user",
+								IsActive:  true,
+								SessionID: "s-abcdefg",
+							}
+						},
+					).Once()
+
+					mockUserService.On(
+						"Logout",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, accessorSessionID string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewLogoutController(mockUserService, mockTokenGenerator)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/logout", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotErr := controller.postLogout(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
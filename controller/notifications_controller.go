@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/notification"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/labstack/echo/v4"
+)
+
+type notificationsController struct {
+	notificationService notification.NotificationService
+	tokenGenerator      generator.TokenGenerator
+}
+
+func NewNotificationsController(
+	notificationService notification.NotificationService,
+	tokenGenerator generator.TokenGenerator,
+) *notificationsController {
+	return &notificationsController{
+		notificationService: notificationService,
+		tokenGenerator:      tokenGenerator,
+	}
+}
+
+func (n *notificationsController) Route(g *echo.Group) {
+	group := g.Group("/notifications")
+	group.GET("", n.getNotifications, middleware.JWTMiddleware())
+	group.GET("/unread", n.getUnreadNotification, middleware.JWTMiddleware())
+	group.PUT("/read", n.putReadAllNotifications, middleware.JWTMiddleware())
+	group.PUT("/:id/read", n.putReadNotification, middleware.JWTMiddleware())
+}
+
+// getNotifications godoc
+// @Summary      Get Notifications
+// @Description  This endpoint is used to get the notifications of the current user, the newest first
+// @Tags         notifications
+// @Produce      json
+// @Param        page   query  int  false  "page, default 1"
+// @Param        limit  query  int  false  "limit, default 10"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  notificationsResponse
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /notifications [get]
+func (n *notificationsController) getNotifications(c echo.Context) error {
+	pageStr := c.QueryParam("page")
+	limitStr := c.QueryParam("limit")
+
+	page, convErr := strconv.Atoi(pageStr)
+	if convErr != nil || page < 0 {
+		page = 0
+	}
+
+	limit, convErr := strconv.Atoi(limitStr)
+	if convErr != nil || limit < 0 {
+		limit = 0
+	}
+
+	tp := n.tokenGenerator.ExtractToken(c)
+
+	notificationsResponse, err := n.notificationService.GetAll(c.Request().Context(), tp.ID, uint(page), uint(limit))
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get notifications successful.", notificationsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// getUnreadNotification godoc
+// @Summary      Get Unread Notification Total
+// @Description  This endpoint is used to get the total of unread notifications of the current user
+// @Tags         notifications
+// @Produce      json
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  unreadNotificationResponse
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /notifications/unread [get]
+func (n *notificationsController) getUnreadNotification(c echo.Context) error {
+	tp := n.tokenGenerator.ExtractToken(c)
+
+	unreadResponse, err := n.notificationService.GetUnreadTotal(c.Request().Context(), tp.ID)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get unread notification total successful.", unreadResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// putReadAllNotifications godoc
+// @Summary      Mark All Notifications as Read
+// @Description  This endpoint is used to mark all notifications of the current user as read
+// @Tags         notifications
+// @Produce      json
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /notifications/read [put]
+func (n *notificationsController) putReadAllNotifications(c echo.Context) error {
+	tp := n.tokenGenerator.ExtractToken(c)
+
+	if err := n.notificationService.MarkAllAsRead(c.Request().Context(), tp.ID); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// putReadNotification godoc
+// @Summary      Mark a Notification as Read
+// @Description  This endpoint is used to mark a notification of the current user as read
+// @Tags         notifications
+// @Produce      json
+// @Param        id  path  string  true  "notification ID"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /notifications/{id}/read [put]
+func (n *notificationsController) putReadNotification(c echo.Context) error {
+	id := c.Param("id")
+
+	tp := n.tokenGenerator.ExtractToken(c)
+
+	if err := n.notificationService.MarkAsRead(c.Request().Context(), tp.ID, id); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// notificationsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type notificationsResponse struct {
+	Status  string                   `json:"status" extensions:"x-order=0"`
+	Message string                   `json:"message" extensions:"x-order=1"`
+	Data    notificationsInfoWrapper `json:"data" extensions:"x-order=2"`
+}
+
+type notificationsInfoWrapper struct {
+	Notifications []response.Notification `json:"list" extensions:"x-order=0"`
+	PageInfo      pageInfoData            `json:"pageInfo" extensions:"x-order=1"`
+}
+
+// unreadNotificationResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type unreadNotificationResponse struct {
+	Status  string                      `json:"status" extensions:"x-order=0"`
+	Message string                      `json:"message" extensions:"x-order=1"`
+	Data    response.UnreadNotification `json:"data" extensions:"x-order=2"`
+}
@@ -0,0 +1,475 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mns "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/notification/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	mtg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteNotifications(t *testing.T) {
+	mockNotificationService := &mns.NotificationService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+	controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestGetNotifications(t *testing.T) {
+	mockNotificationService := &mns.NotificationService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyNotifications := response.Pagination[response.Notification]{
+			List: []response.Notification{
+				{
+					ID:            "n-abcdefg",
+					Type:          "like",
+					ActorID:       "u-ghijkl",
+					ActorUsername: "naruto",
+					ActorName:     "Naruto Uzumaki",
+					ThreadID:      "t-abcdefg",
+					ThreadTitle:   "Concurrency",
+					IsRead:        false,
+					CreatedOn:     "27 Jun 22 09:25 UTC",
+				},
+			},
+			PageInfo: response.PageInfo{
+				Limit:     10,
+				Page:      1,
+				PageTotal: 1,
+				Total:     1,
+			},
+		}
+
+		dummyResp := model.NewResponse("success", "Get notifications successful.", dummyNotifications)
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"GetAll",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+			uint(1),
+			uint(10),
+		).Return(
+			func(ctx context.Context, accessorUserID string, page uint, limit uint) response.Pagination[response.Notification] {
+				return dummyNotifications
+			},
+			func(ctx context.Context, accessorUserID string, page uint, limit uint) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/notifications?page=1&limit=10", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getNotifications(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", response.Pagination[response.Notification]{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResp.Data, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"GetAll",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+		).Return(
+			func(ctx context.Context, accessorUserID string, page uint, limit uint) response.Pagination[response.Notification] {
+				return response.Pagination[response.Notification]{}
+			},
+			func(ctx context.Context, accessorUserID string, page uint, limit uint) error {
+				return service.ErrRepository
+			},
+		).Once()
+
+		t.Run("it should return 500 status code, when error happened", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/notifications", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getNotifications(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+					assert.Equal(t, "Something went wrong.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetUnreadNotification(t *testing.T) {
+	mockNotificationService := &mns.NotificationService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyUnread := response.UnreadNotification{Total: 3}
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"GetUnreadTotal",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+		).Return(
+			func(ctx context.Context, accessorUserID string) response.UnreadNotification {
+				return dummyUnread
+			},
+			func(ctx context.Context, accessorUserID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/notifications/unread", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getUnreadNotification(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", response.UnreadNotification{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyUnread, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"GetUnreadTotal",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, accessorUserID string) response.UnreadNotification {
+				return response.UnreadNotification{}
+			},
+			func(ctx context.Context, accessorUserID string) error {
+				return service.ErrRepository
+			},
+		).Once()
+
+		t.Run("it should return 500 status code, when error happened", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/notifications/unread", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getUnreadNotification(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+					assert.Equal(t, "Something went wrong.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPutReadAllNotifications(t *testing.T) {
+	mockNotificationService := &mns.NotificationService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"MarkAllAsRead",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+		).Return(
+			func(ctx context.Context, accessorUserID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/notifications/read", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.putReadAllNotifications(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"MarkAllAsRead",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, accessorUserID string) error {
+				return service.ErrRepository
+			},
+		).Once()
+
+		t.Run("it should return 500 status code, when error happened", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/notifications/read", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.putReadAllNotifications(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+					assert.Equal(t, "Something went wrong.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPutReadNotification(t *testing.T) {
+	mockNotificationService := &mns.NotificationService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockNotificationService.On(
+			"MarkAsRead",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+			"n-abcdefg",
+		).Return(
+			func(ctx context.Context, accessorUserID string, ID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/notifications/:id/read")
+			c.SetParamNames("id")
+			c.SetParamValues("n-abcdefg")
+
+			if assert.NoError(t, controller.putReadNotification(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 404 status code, when notification not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return dummyTokenPayload
+						},
+					).Once()
+
+					mockNotificationService.On(
+						"MarkAsRead",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, accessorUserID string, ID string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return dummyTokenPayload
+						},
+					).Once()
+
+					mockNotificationService.On(
+						"MarkAsRead",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, accessorUserID string, ID string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewNotificationsController(mockNotificationService, mockTokenGenerator)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/api/v1/notifications/:id/read")
+				c.SetParamNames("id")
+				c.SetParamValues("n-abcdefg")
+
+				gotErr := controller.putReadNotification(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user"
+	"github.com/labstack/echo/v4"
+)
+
+type passwordResetController struct {
+	userService user.UserService
+}
+
+func NewPasswordResetController(userService user.UserService) *passwordResetController {
+	return &passwordResetController{userService: userService}
+}
+
+func (p *passwordResetController) Route(g *echo.Group) {
+	group := g.Group("/password-reset")
+	group.POST("", p.postPasswordReset)
+	group.POST("/confirm", p.postPasswordResetConfirm)
+}
+
+// postPasswordReset godoc
+// @Summary      Request Password Reset
+// @Description  This endpoint is used to send the password reset link to the given email, the link expires in 1 hour and can only be used once.
+// @Description  The response is the same whether the email is registered or not.
+// @Tags         password-reset
+// @Accept       json
+// @Produce      json
+// @Security     ApiKey
+// @Param        default  body  payload.EmailRequest  true  "email payload"
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /password-reset [post]
+func (p *passwordResetController) postPasswordReset(c echo.Context) error {
+	emailRequest := new(payload.EmailRequest)
+	if err := c.Bind(emailRequest); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := p.userService.RequestPasswordReset(c.Request().Context(), *emailRequest); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postPasswordResetConfirm godoc
+// @Summary      Reset Password
+// @Description  This endpoint is used to set a new password with the token from the password reset link, every session of the user is signed out.
+// @Tags         password-reset
+// @Accept       json
+// @Produce      json
+// @Security     ApiKey
+// @Param        default  body  payload.ResetPassword  true  "reset password payload"
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /password-reset/confirm [post]
+func (p *passwordResetController) postPasswordResetConfirm(c echo.Context) error {
+	resetPassword := new(payload.ResetPassword)
+	if err := c.Bind(resetPassword); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := p.userService.ResetPassword(c.Request().Context(), *resetPassword); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
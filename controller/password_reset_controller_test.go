@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRoutePasswordReset(t *testing.T) {
+	mockUserService := &mocks.UserService{}
+	controller := NewPasswordResetController(mockUserService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostPasswordReset(t *testing.T) {
+	mockUserService := &mocks.UserService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockUserService.On(
+			"RequestPasswordReset",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+		).Return(
+			func(ctx context.Context, p payload.EmailRequest) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewPasswordResetController(mockUserService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/password-reset", strings.NewReader(`{"email": "erikriosetiawan15@gmail.com"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.postPasswordReset(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockUserService.On(
+			"RequestPasswordReset",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.EmailRequest{})),
+		).Return(
+			func(ctx context.Context, p payload.EmailRequest) error {
+				return service.ErrInvalidPayload
+			},
+		).Once()
+
+		t.Run("it should return 400 status code, when the payload is invalid", func(t *testing.T) {
+			controller := NewPasswordResetController(mockUserService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/password-reset", strings.NewReader(`{"email": ""}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.postPasswordReset(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+					assert.Equal(t, "Invalid payload. Please check the payload schema in the API Documentation.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPostPasswordResetConfirm(t *testing.T) {
+	mockUserService := &mocks.UserService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockUserService.On(
+			"ResetPassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			payload.ResetPassword{Token: "generatedonetimetoken", NewPassword: "erikriosetiawan"},
+		).Return(
+			func(ctx context.Context, p payload.ResetPassword) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewPasswordResetController(mockUserService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/password-reset/confirm", strings.NewReader(`{"token": "generatedonetimetoken", "newPassword": "erikriosetiawan"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.postPasswordResetConfirm(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockUserService.On(
+			"ResetPassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ResetPassword{})),
+		).Return(
+			func(ctx context.Context, p payload.ResetPassword) error {
+				return service.ErrInvalidToken
+			},
+		).Once()
+
+		t.Run("it should return 401 status code, when the token is used or expired", func(t *testing.T) {
+			controller := NewPasswordResetController(mockUserService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/password-reset/confirm", strings.NewReader(`{"token": "usedtoken", "newPassword": "erikriosetiawan"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.postPasswordResetConfirm(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusUnauthorized, echoHTTPError.Code)
+					assert.Equal(t, "Invalid or expired token.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
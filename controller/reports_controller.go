@@ -30,6 +30,7 @@ func (r *reportsController) Route(g *echo.Group) {
 	group := g.Group("/reports")
 	group.POST("", r.postCreateReport, middleware.JWTMiddleware())
 	group.GET("", r.getReports, middleware.JWTMiddleware())
+	group.PUT("/:id/status", r.putReportStatus, middleware.JWTMiddleware())
 }
 
 // postCreateReport godoc
@@ -70,7 +71,7 @@ func (r *reportsController) postCreateReport(c echo.Context) error {
 // @Description  This endpoint is used to get all report
 // @Tags         reports
 // @Produce      json
-// @Param        status  query  string  false  "options: review, accepted, default review"
+// @Param        status  query  string  false  "options: review, accepted, rejected, default review"
 // @Param        page    query  int     false  "page, default 1"
 // @Param        limit   query  int     false  "limit, default 20"
 // @Security     ApiKey
@@ -104,6 +105,40 @@ func (r *reportsController) getReports(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// putReportStatus godoc
+// @Summary      Accept/Reject a Report
+// @Description  This endpoint is used to accept or reject a report, accepting a report will banned the reported user
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                      true  "report ID"
+// @Param        default  body  payload.UpdateReportStatus  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /reports/{id}/status [put]
+func (r *reportsController) putReportStatus(c echo.Context) error {
+	id := c.Param("id")
+
+	p := new(payload.UpdateReportStatus)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	tp := r.tokenGenerator.ExtractToken(c)
+
+	if err := r.service.UpdateStatus(c.Request().Context(), tp.ID, tp.Role, id, *p); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 // reportsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type reportsResponse struct {
 	Status  string      `json:"status" extensions:"x-order=0"`
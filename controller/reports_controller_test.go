@@ -346,3 +346,160 @@ func TestGetReports(t *testing.T) {
 		}
 	}
 }
+
+func TestPutReportStatus(t *testing.T) {
+	mockReportService := &mrs.ReportService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyReq := payload.UpdateReportStatus{Status: "accepted"}
+
+	testCases := []struct {
+		name                 string
+		inputPayload         payload.UpdateReportStatus
+		expectedStatusCode   int
+		expectedErrorMessage string
+		mockBehaviours       func()
+	}{
+		{
+			name:                 "it should return 403 status code, when accessor is not an admin",
+			inputPayload:         dummyReq,
+			expectedStatusCode:   http.StatusForbidden,
+			expectedErrorMessage: "Access to this resource is forbidden for current role.",
+			mockBehaviours: func() {
+				mockTokenGenerator.On(
+					"ExtractToken",
+					mock.AnythingOfType("*echo.context"),
+				).Return(
+					func(c echo.Context) generator.TokenPayload {
+						return generator.TokenPayload{
+							ID:       "u-abcd",
+							Username: "sarifaturr",
+							Role:     "user",
+							IsActive: true,
+						}
+					},
+				).Once()
+
+				mockReportService.On(
+					"UpdateStatus",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateReportStatus{})),
+				).Return(
+					func(ctx context.Context, accessorUserID string, accessorRole string, ID string, p payload.UpdateReportStatus) error {
+						return service.ErrAccessForbidden
+					},
+				).Once()
+			},
+		},
+		{
+			name:                 "it should return 404 status code, when the report is not found",
+			inputPayload:         dummyReq,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+			mockBehaviours: func() {
+				mockTokenGenerator.On(
+					"ExtractToken",
+					mock.AnythingOfType("*echo.context"),
+				).Return(
+					func(c echo.Context) generator.TokenPayload {
+						return generator.TokenPayload{
+							ID:       "a-abcd",
+							Username: "admin",
+							Role:     "admin",
+							IsActive: true,
+						}
+					},
+				).Once()
+
+				mockReportService.On(
+					"UpdateStatus",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateReportStatus{})),
+				).Return(
+					func(ctx context.Context, accessorUserID string, accessorRole string, ID string, p payload.UpdateReportStatus) error {
+						return service.ErrDataNotFound
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			controller := NewReportsController(mockReportService, mockTokenGenerator)
+			requestBody, err := json.Marshal(testCase.inputPayload)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/reports", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/status")
+			c.SetParamNames("id")
+			c.SetParamValues("r-ErLN4lS")
+
+			gotErr := controller.putReportStatus(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+
+	t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "a-abcd",
+					Username: "admin",
+					Role:     "admin",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockReportService.On(
+			"UpdateStatus",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"a-abcd",
+			"admin",
+			"r-ErLN4lS",
+			dummyReq,
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, ID string, p payload.UpdateReportStatus) error {
+				return nil
+			},
+		).Once()
+
+		controller := NewReportsController(mockReportService, mockTokenGenerator)
+		requestBody, err := json.Marshal(dummyReq)
+		assert.NoError(t, err)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/reports", strings.NewReader(string(requestBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues("r-ErLN4lS")
+
+		if assert.NoError(t, controller.putReportStatus(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+}
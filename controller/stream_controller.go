@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	maxStreamTopics         = 50
+	streamHeartbeatInterval = 30 * time.Second
+)
+
+type streamController struct {
+	hub            realtime.Hub
+	tokenGenerator generator.TokenGenerator
+}
+
+func NewStreamController(
+	hub realtime.Hub,
+	tokenGenerator generator.TokenGenerator,
+) *streamController {
+	return &streamController{
+		hub:            hub,
+		tokenGenerator: tokenGenerator,
+	}
+}
+
+func (s *streamController) Route(g *echo.Group) {
+	g.GET("/stream", s.getStream, middleware.JWTMiddleware())
+}
+
+// getStream godoc
+// @Summary      Stream Realtime Events
+// @Description  This endpoint is used to receive the realtime events as Server-Sent Events. The notifications of the current user are always streamed.
+// @Description  Event types: comment.created and thread.counter for the subscribed threads, user.counter for the subscribed users, notification.created for the current user.
+// @Description  Every event data is a JSON object with topic, type and data fields.
+// @Tags         stream
+// @Produce      text/event-stream
+// @Param        threads  query  string  false  "comma separated thread IDs to subscribe to"
+// @Param        users    query  string  false  "comma separated user IDs to subscribe to"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200
+// @Failure      400  {object}  echo.HTTPError
+// @Router       /stream [get]
+func (s *streamController) getStream(c echo.Context) error {
+	threadIDs := splitQueryParam(c.QueryParam("threads"))
+	userIDs := splitQueryParam(c.QueryParam("users"))
+
+	if len(threadIDs)+len(userIDs) > maxStreamTopics {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	tp := s.tokenGenerator.ExtractToken(c)
+
+	// The notifications are private, so only the topic of the current user is subscribed to.
+	topics := []string{realtime.NotificationTopic(tp.ID)}
+	for _, threadID := range threadIDs {
+		topics = append(topics, realtime.ThreadTopic(threadID))
+	}
+	for _, userID := range userIDs {
+		topics = append(topics, realtime.UserTopic(userID))
+	}
+
+	events, unsubscribe := s.hub.Subscribe(topics...)
+	defer unsubscribe()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	// The comment lines are ignored by the clients, they confirm the subscription and keep the connection alive.
+	if _, err := fmt.Fprint(res, ": connected\n\n"); err != nil {
+		return nil
+	}
+	res.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": ping\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+func splitQueryParam(value string) (values []string) {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return
+}
@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	mtg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteStream(t *testing.T) {
+	mockTokenGenerator := &mtg.TokenGenerator{}
+	controller := NewStreamController(realtime.NewMemoryHub(), mockTokenGenerator)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestGetStream(t *testing.T) {
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		hub := realtime.NewMemoryHub()
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		t.Run("it should stream the events of the subscribed topics, when there is no error", func(t *testing.T) {
+			controller := NewStreamController(hub, mockTokenGenerator)
+
+			e := echo.New()
+			e.GET("/api/v1/stream", controller.getStream)
+
+			server := httptest.NewServer(e)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/stream?threads=t-abcdefg", nil)
+			res, err := http.DefaultClient.Do(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer res.Body.Close()
+
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+			assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+			reader := bufio.NewReader(res.Body)
+
+			line, err := reader.ReadString('\n')
+			if assert.NoError(t, err) {
+				assert.Equal(t, ": connected\n", line)
+			}
+			_, _ = reader.ReadString('\n')
+
+			_ = hub.Publish(context.Background(), realtime.Event{
+				Topic: realtime.ThreadTopic("t-hijklmn"),
+				Type:  realtime.ThreadCounterChanged,
+				Data:  response.ThreadCounter{ThreadID: "t-hijklmn", TotalLike: 1},
+			})
+			_ = hub.Publish(context.Background(), realtime.Event{
+				Topic: realtime.ThreadTopic("t-abcdefg"),
+				Type:  realtime.ThreadCounterChanged,
+				Data:  response.ThreadCounter{ThreadID: "t-abcdefg", TotalLike: 2},
+			})
+
+			line, err = reader.ReadString('\n')
+			if assert.NoError(t, err) {
+				assert.Equal(t, "event: thread.counter\n", line)
+			}
+
+			line, err = reader.ReadString('\n')
+			if assert.NoError(t, err) {
+				assert.True(t, strings.HasPrefix(line, "data: "))
+				assert.Contains(t, line, `"topic":"thread:t-abcdefg"`)
+				assert.Contains(t, line, `"totalLike":2`)
+			}
+		})
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		t.Run("it should not stream the notifications of another user, when the user is subscribed", func(t *testing.T) {
+			controller := NewStreamController(hub, mockTokenGenerator)
+
+			e := echo.New()
+			e.GET("/api/v1/stream", controller.getStream)
+
+			server := httptest.NewServer(e)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/stream?users=u-ghijkl", nil)
+			res, err := http.DefaultClient.Do(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer res.Body.Close()
+
+			reader := bufio.NewReader(res.Body)
+			_, _ = reader.ReadString('\n')
+			_, _ = reader.ReadString('\n')
+
+			_ = hub.Publish(context.Background(), realtime.Event{
+				Topic: realtime.NotificationTopic("u-ghijkl"),
+				Type:  realtime.NotificationCreated,
+				Data:  response.Notification{ID: "n-abcdefg"},
+			})
+			_ = hub.Publish(context.Background(), realtime.Event{
+				Topic: realtime.UserTopic("u-ghijkl"),
+				Type:  realtime.UserCounterChanged,
+				Data:  response.UserCounter{UserID: "u-ghijkl", TotalFollower: 1},
+			})
+
+			line, err := reader.ReadString('\n')
+			if assert.NoError(t, err) {
+				assert.Equal(t, "event: user.counter\n", line)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		t.Run("it should return 400 status code, when too many topics are subscribed", func(t *testing.T) {
+			controller := NewStreamController(realtime.NewMemoryHub(), mockTokenGenerator)
+
+			threadIDs := make([]string, maxStreamTopics+1)
+			for i := range threadIDs {
+				threadIDs[i] = "t-abcdefg"
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/stream?threads="+strings.Join(threadIDs, ","), nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getStream(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+					assert.Equal(t, "Invalid payload. Please check the payload schema in the API Documentation.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
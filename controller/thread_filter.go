@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/labstack/echo/v4"
+)
+
+// newThreadFilter reads the thread listing filters from the query params, invalid booleans are treated as false.
+func newThreadFilter(c echo.Context) payload.ThreadFilter {
+	unanswered, _ := strconv.ParseBool(c.QueryParam("unanswered"))
+	followed, _ := strconv.ParseBool(c.QueryParam("followed"))
+
+	return payload.ThreadFilter{
+		Unanswered:    unanswered,
+		FollowedOnly:  followed,
+		CreatedAfter:  c.QueryParam("createdAfter"),
+		CreatedBefore: c.QueryParam("createdBefore"),
+		CategoryID:    c.QueryParam("category"),
+	}
+}
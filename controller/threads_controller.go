@@ -38,10 +38,20 @@ func (t *threadsController) Route(g *echo.Group) {
 	group.DELETE("/:id", t.deleteThread, middleware.JWTMiddleware())
 	group.GET("/:id/comments", t.getThreadComments, middleware.JWTMiddleware())
 	group.POST("/:id/comments", t.postCreateThreadComments, middleware.JWTMiddleware())
+	group.PUT("/:id/comments/:commentID", t.putUpdateComment, middleware.JWTMiddleware())
+	group.DELETE("/:id/comments/:commentID", t.deleteComment, middleware.JWTMiddleware())
+	group.POST("/:id/comments/:commentID/replies", t.postCreateCommentReplies, middleware.JWTMiddleware())
 	group.PUT("/:id/like", t.putThreadLike, middleware.JWTMiddleware())
 	group.PUT("/:id/follow", t.putThreadFollow, middleware.JWTMiddleware())
 	group.PUT("/:id/moderators/add", t.putThreadAddModerator, middleware.JWTMiddleware())
 	group.PUT("/:id/moderators/remove", t.putThreadRemoveModerator, middleware.JWTMiddleware())
+	group.PUT("/:id/lock", t.putThreadLock, middleware.JWTMiddleware())
+	group.DELETE("/:id/lock", t.deleteThreadLock, middleware.JWTMiddleware())
+	group.PUT("/:id/comments/:commentID/pin", t.putCommentPin, middleware.JWTMiddleware())
+	group.DELETE("/:id/comments/:commentID/pin", t.deleteCommentPin, middleware.JWTMiddleware())
+	group.PUT("/:id/mutes", t.putThreadMute, middleware.JWTMiddleware())
+	group.DELETE("/:id/mutes/:username", t.deleteThreadMute, middleware.JWTMiddleware())
+	group.GET("/:id/moderation-logs", t.getThreadModerationLogs, middleware.JWTMiddleware())
 }
 
 // getThreads     godoc
@@ -49,18 +59,29 @@ func (t *threadsController) Route(g *echo.Group) {
 // @Description  This endpoint is used to get all threads
 // @Tags         threads
 // @Produce      json
-// @Param        page    query  int     false  "page, default 1"
-// @Param        limit   query  int     false  "limit, default 10"
-// @Param        search  query  string  false  "search by keyword, default empty string"
+// @Param        page           query  int     false  "page, default 1"
+// @Param        limit          query  int     false  "limit, default 10"
+// @Param        cursor         query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        search         query  string  false  "full-text search on title, description and comments, supports phrases in double quotes and -exclusion, default empty string"
+// @Param        sort           query  string  false  "options: newest, liked, viewed, commented, trending, default newest, or relevance when searching"
+// @Param        unanswered     query  bool    false  "only threads without comments, default false"
+// @Param        followed       query  bool    false  "only threads followed by current user, default false"
+// @Param        createdAfter   query  string  false  "only threads created on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only threads created before the date, format 2006-01-02"
+// @Param        category       query  string  false  "category ID"
 // @Security     ApiKey
 // @Security     ApiKeyAuth
 // @Success      200  {object}  threadsResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /threads [get]
 func (t *threadsController) getThreads(c echo.Context) error {
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
 	search := c.QueryParam("search")
+	sort := c.QueryParam("sort")
+	filter := newThreadFilter(c)
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -74,7 +95,7 @@ func (t *threadsController) getThreads(c echo.Context) error {
 
 	tp := t.tokenGenerator.ExtractToken(c)
 
-	threadsResponse, err := t.threadService.GetAll(c.Request().Context(), tp.ID, uint(page), uint(limit), search)
+	threadsResponse, err := t.threadService.GetAll(c.Request().Context(), tp.ID, uint(page), uint(limit), cursor, search, sort, filter)
 	if err != nil {
 		return newErrorResponse(err)
 	}
@@ -206,9 +227,12 @@ func (t *threadsController) deleteThread(c echo.Context) error {
 // @Description  This endpoint is used to get the thread comments
 // @Tags         threads
 // @Produce      json
-// @Param        id       path  string                 true  "thread ID"
-// @Param        page   query  int     false  "page, default 1"
-// @Param        limit  query  int     false  "limit, default 20"
+// @Param        id      path   string  true   "thread ID"
+// @Param        page    query  int     false  "page, default 1"
+// @Param        limit   query  int     false  "limit, default 20"
+// @Param        cursor  query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        view    query  string  false  "options: flat, tree, slice, default flat"
+// @Param        depth   query  int     false  "maximum reply depth for tree and slice view, default 0 (unlimited)"
 // @Security     ApiKey
 // @Security     ApiKeyAuth
 // @Success      200  {object}  commentsResponse
@@ -219,6 +243,9 @@ func (t *threadsController) getThreadComments(c echo.Context) error {
 	id := c.Param("id")
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
+	view := c.QueryParam("view")
+	depthStr := c.QueryParam("depth")
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -230,7 +257,12 @@ func (t *threadsController) getThreadComments(c echo.Context) error {
 		limit = 0
 	}
 
-	commentsResponse, err := t.threadService.GetComments(c.Request().Context(), id, uint(page), uint(limit))
+	depth, convErr := strconv.Atoi(depthStr)
+	if convErr != nil || depth < 0 {
+		depth = 0
+	}
+
+	commentsResponse, err := t.threadService.GetComments(c.Request().Context(), id, uint(page), uint(limit), cursor, view, uint(depth))
 	if err != nil {
 		return newErrorResponse(err)
 	}
@@ -275,6 +307,108 @@ func (t *threadsController) postCreateThreadComments(c echo.Context) error {
 	return c.JSON(http.StatusCreated, response)
 }
 
+// putUpdateComment godoc
+// @Summary      Update a Comment
+// @Description  This endpoint is used to update a comment of a thread, only the author can update the comment
+// @Tags         threads
+// @Accept       json
+// @Produce      json
+// @Param        id         path  string                 true  "thread ID"
+// @Param        commentID  path  string                 true  "comment ID"
+// @Param        default    body  payload.UpdateComment  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/comments/{commentID} [put]
+func (t *threadsController) putUpdateComment(c echo.Context) error {
+	threadID := c.Param("id")
+	commentID := c.Param("commentID")
+
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	p := new(payload.UpdateComment)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := t.threadService.UpdateComment(c.Request().Context(), threadID, commentID, tp.ID, *p); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteComment godoc
+// @Summary      Delete a Comment
+// @Description  This endpoint is used to delete a comment of a thread, the comment can be deleted by the author, the thread moderators, and the admin
+// @Tags         threads
+// @Produce      json
+// @Param        id         path  string  true  "thread ID"
+// @Param        commentID  path  string  true  "comment ID"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/comments/{commentID} [delete]
+func (t *threadsController) deleteComment(c echo.Context) error {
+	threadID := c.Param("id")
+	commentID := c.Param("commentID")
+
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	if err := t.threadService.DeleteComment(c.Request().Context(), threadID, commentID, tp.ID, tp.Role); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postCreateCommentReplies godoc
+// @Summary      Reply a Comment
+// @Description  This endpoint is used to reply a comment of a thread
+// @Tags         threads
+// @Accept       json
+// @Produce      json
+// @Param        id         path  string                 true  "thread ID"
+// @Param        commentID  path  string                 true  "comment ID"
+// @Param        default    body  payload.CreateComment  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createThreadResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/comments/{commentID}/replies [post]
+func (t *threadsController) postCreateCommentReplies(c echo.Context) error {
+	threadID := c.Param("id")
+	commentID := c.Param("commentID")
+
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	p := new(payload.CreateComment)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	id, err := t.threadService.CreateReply(c.Request().Context(), threadID, commentID, tp.ID, *p)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"ID": id}
+	response := model.NewResponse("success", "Create reply successful.", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
 // putThreadLike godoc
 // @Summary      Like/Unlike a Thread
 // @Description  This endpoint is used to like/unlike a thread
@@ -391,6 +525,222 @@ func (t *threadsController) putThreadRemoveModerator(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// putThreadLock godoc
+// @Summary      Lock a Thread
+// @Description  This endpoint is used to lock a thread, no one can comment on a locked thread. Only the thread creator, the thread moderators, and the staff can lock the thread
+// @Tags         threads
+// @Produce      json
+// @Param        id  path  string  true  "thread ID"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/lock [put]
+func (t *threadsController) putThreadLock(c echo.Context) error {
+	threadID := c.Param("id")
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	if err := t.threadService.LockThread(c.Request().Context(), threadID, tp.ID, tp.Role); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteThreadLock godoc
+// @Summary      Unlock a Thread
+// @Description  This endpoint is used to unlock a thread. Only the thread creator, the thread moderators, and the staff can unlock the thread
+// @Tags         threads
+// @Produce      json
+// @Param        id  path  string  true  "thread ID"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/lock [delete]
+func (t *threadsController) deleteThreadLock(c echo.Context) error {
+	threadID := c.Param("id")
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	if err := t.threadService.UnlockThread(c.Request().Context(), threadID, tp.ID, tp.Role); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// putCommentPin godoc
+// @Summary      Pin a Comment
+// @Description  This endpoint is used to pin a root comment to the top of the thread comments, it replaces the previously pinned comment. Only the thread creator, the thread moderators, and the staff can pin a comment
+// @Tags         threads
+// @Produce      json
+// @Param        id         path  string  true  "thread ID"
+// @Param        commentID  path  string  true  "comment ID"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/comments/{commentID}/pin [put]
+func (t *threadsController) putCommentPin(c echo.Context) error {
+	threadID := c.Param("id")
+	commentID := c.Param("commentID")
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	if err := t.threadService.PinComment(c.Request().Context(), threadID, commentID, tp.ID, tp.Role); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteCommentPin godoc
+// @Summary      Unpin a Comment
+// @Description  This endpoint is used to unpin the pinned comment of a thread. Only the thread creator, the thread moderators, and the staff can unpin a comment
+// @Tags         threads
+// @Produce      json
+// @Param        id         path  string  true  "thread ID"
+// @Param        commentID  path  string  true  "comment ID"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/comments/{commentID}/pin [delete]
+func (t *threadsController) deleteCommentPin(c echo.Context) error {
+	threadID := c.Param("id")
+	commentID := c.Param("commentID")
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	if err := t.threadService.UnpinComment(c.Request().Context(), threadID, commentID, tp.ID, tp.Role); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// putThreadMute godoc
+// @Summary      Mute a User in Thread
+// @Description  This endpoint is used to mute a user in a thread for the given hours, a muted user can't comment on the thread. Only the thread creator, the thread moderators, and the staff can mute a user, they can't be muted themselves
+// @Tags         threads
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string            true  "thread ID"
+// @Param        default  body  payload.MuteUser  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/mutes [put]
+func (t *threadsController) putThreadMute(c echo.Context) error {
+	threadID := c.Param("id")
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	p := new(payload.MuteUser)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := t.threadService.MuteUser(c.Request().Context(), threadID, tp.ID, tp.Role, *p); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteThreadMute godoc
+// @Summary      Unmute a User in Thread
+// @Description  This endpoint is used to lift the active mute of a user in a thread. Only the thread creator, the thread moderators, and the staff can unmute a user
+// @Tags         threads
+// @Produce      json
+// @Param        id        path  string  true  "thread ID"
+// @Param        username  path  string  true  "username"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/mutes/{username} [delete]
+func (t *threadsController) deleteThreadMute(c echo.Context) error {
+	threadID := c.Param("id")
+	username := c.Param("username")
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	if err := t.threadService.UnmuteUser(c.Request().Context(), threadID, username, tp.ID, tp.Role); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getThreadModerationLogs godoc
+// @Summary      Get Thread Moderation Logs
+// @Description  This endpoint is used to get the lock, pin and mute actions taken in a thread, newest first. Only the thread creator, the thread moderators, and the staff can see the logs
+// @Tags         threads
+// @Produce      json
+// @Param        id     path   string  true   "thread ID"
+// @Param        page   query  int     false  "page, default 1"
+// @Param        limit  query  int     false  "limit, default 10"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  moderationLogsResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /threads/{id}/moderation-logs [get]
+func (t *threadsController) getThreadModerationLogs(c echo.Context) error {
+	threadID := c.Param("id")
+	pageStr := c.QueryParam("page")
+	limitStr := c.QueryParam("limit")
+
+	page, convErr := strconv.Atoi(pageStr)
+	if convErr != nil || page < 0 {
+		page = 0
+	}
+
+	limit, convErr := strconv.Atoi(limitStr)
+	if convErr != nil || limit < 0 {
+		limit = 0
+	}
+
+	tp := t.tokenGenerator.ExtractToken(c)
+
+	moderationLogsResponse, err := t.threadService.GetModerationLogs(
+		c.Request().Context(),
+		threadID,
+		tp.ID,
+		tp.Role,
+		uint(page),
+		uint(limit),
+	)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get moderation logs successful.", moderationLogsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
 // createThreadResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type createThreadResponse struct {
 	Status  string `json:"status" extensions:"x-order=0"`
@@ -420,3 +770,15 @@ type commentsInfoWrapper struct {
 	Threads  []response.Comment `json:"list" extensions:"x-order=0"`
 	PageInfo pageInfoData       `json:"pageInfo" extensions:"x-order=1"`
 }
+
+// moderationLogsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type moderationLogsResponse struct {
+	Status  string                    `json:"status" extensions:"x-order=0"`
+	Message string                    `json:"message" extensions:"x-order=1"`
+	Data    moderationLogsInfoWrapper `json:"data" extensions:"x-order=2"`
+}
+
+type moderationLogsInfoWrapper struct {
+	ModerationLogs []response.ModerationLog `json:"list" extensions:"x-order=0"`
+	PageInfo       pageInfoData             `json:"pageInfo" extensions:"x-order=1"`
+}
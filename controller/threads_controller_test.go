@@ -88,13 +88,19 @@ func TestGetThreads(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 		).Return(
 			func(
 				ctx context.Context,
 				accessorUserID string,
 				page uint,
 				limit uint,
+				cursor string,
 				query string,
+				sort string,
+				f payload.ThreadFilter,
 			) response.Pagination[response.ManyThread] {
 				return dummyPagination
 			},
@@ -103,7 +109,10 @@ func TestGetThreads(t *testing.T) {
 				accessorUserID string,
 				page uint,
 				limit uint,
+				cursor string,
 				query string,
+				sort string,
+				f payload.ThreadFilter,
 			) error {
 				return nil
 			},
@@ -165,13 +174,19 @@ func TestGetThreads(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 				).Return(
 					func(
 						ctx context.Context,
 						accessorUserID string,
 						page uint,
 						limit uint,
+						cursor string,
 						query string,
+						sort string,
+						f payload.ThreadFilter,
 					) response.Pagination[response.ManyThread] {
 						return response.Pagination[response.ManyThread]{}
 					},
@@ -180,7 +195,10 @@ func TestGetThreads(t *testing.T) {
 						accessorUserID string,
 						page uint,
 						limit uint,
+						cursor string,
 						query string,
+						sort string,
+						f payload.ThreadFilter,
 					) error {
 						return service.ErrRepository
 					},
@@ -813,12 +831,18 @@ func TestGetThreadComments(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 		).Return(
 			func(
 				ctx context.Context,
 				threadID string,
 				page uint,
 				limit uint,
+				cursor string,
+				view string,
+				depth uint,
 			) response.Pagination[response.Comment] {
 				return dummyPagination
 			},
@@ -827,6 +851,9 @@ func TestGetThreadComments(t *testing.T) {
 				threadID string,
 				page uint,
 				limit uint,
+				cursor string,
+				view string,
+				depth uint,
 			) error {
 				return nil
 			},
@@ -876,12 +903,18 @@ func TestGetThreadComments(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 				).Return(
 					func(
 						ctx context.Context,
 						threadID string,
 						page uint,
 						limit uint,
+						cursor string,
+						view string,
+						depth uint,
 					) response.Pagination[response.Comment] {
 						return response.Pagination[response.Comment]{}
 					},
@@ -890,6 +923,9 @@ func TestGetThreadComments(t *testing.T) {
 						threadID string,
 						page uint,
 						limit uint,
+						cursor string,
+						view string,
+						depth uint,
 					) error {
 						return service.ErrRepository
 					},
@@ -1073,11 +1109,15 @@ func TestPostCreateThreadComments(t *testing.T) {
 	})
 }
 
-func TestPutThreadLike(t *testing.T) {
+func TestPutUpdateComment(t *testing.T) {
 	mockThreadService := &mts.ThreadService{}
 	mockTokenGenerator := &mtg.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
+		dummyReq := payload.UpdateComment{
+			Comment: "I totally agree with you.",
+		}
+
 		mockTokenGenerator.On(
 			"ExtractToken",
 			mock.AnythingOfType("*echo.context"),
@@ -1093,45 +1133,55 @@ func TestPutThreadLike(t *testing.T) {
 		).Once()
 
 		mockThreadService.On(
-			"ChangeLikeState",
+			"UpdateComment",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"t-XyzAbc",
+			"c-XyzAbce",
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateComment{})),
 		).Return(
-			func(ctx context.Context, threadID, accessorUserID string) error {
+			func(ctx context.Context, threadID, commentID, accessorUserID string, p payload.UpdateComment) error {
 				return nil
 			},
 		).Once()
 
 		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
 			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id/like")
-			c.SetParamNames("id")
-			c.SetParamValues("c-xyz")
+			c.SetPath("/:id/comments/:commentID")
+			c.SetParamNames("id", "commentID")
+			c.SetParamValues("t-XyzAbc", "c-XyzAbce")
 
-			if assert.NoError(t, controller.putThreadLike(c)) {
+			if assert.NoError(t, controller.putUpdateComment(c)) {
 				assert.Equal(t, http.StatusNoContent, rec.Code)
 			}
 		})
 	})
 
 	t.Run("failed scenario", func(t *testing.T) {
+		dummyReq := payload.UpdateComment{
+			Comment: "I totally agree with you.",
+		}
+
 		testCases := []struct {
 			name                 string
+			inputPayload         payload.UpdateComment
 			expectedStatusCode   int
 			expectedErrorMessage string
 			mockBehaviours       func()
 		}{
 			{
-				name:                 "it should return 500 status code, when error happened",
-				expectedStatusCode:   http.StatusInternalServerError,
-				expectedErrorMessage: "Something went wrong.",
+				name:                 "it should return 403 status code, when the accessor is not the author",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
 				mockBehaviours: func() {
 					mockTokenGenerator.On(
 						"ExtractToken",
@@ -1148,13 +1198,15 @@ func TestPutThreadLike(t *testing.T) {
 					).Once()
 
 					mockThreadService.On(
-						"ChangeLikeState",
+						"UpdateComment",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateComment{})),
 					).Return(
-						func(ctx context.Context, threadID, accessorUserID string) error {
-							return service.ErrRepository
+						func(ctx context.Context, threadID, commentID, accessorUserID string, p payload.UpdateComment) error {
+							return service.ErrAccessForbidden
 						},
 					).Once()
 				},
@@ -1166,17 +1218,19 @@ func TestPutThreadLike(t *testing.T) {
 				testCase.mockBehaviours()
 
 				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				requestBody, err := json.Marshal(testCase.inputPayload)
+				assert.NoError(t, err)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id/like")
-				c.SetParamNames("id")
-				c.SetParamValues("c-xyz")
+				c.SetPath("/:id/comments/:commentID")
+				c.SetParamNames("id", "commentID")
+				c.SetParamValues("t-XyzAbc", "c-XyzAbce")
 
-				gotErr := controller.putThreadLike(c)
+				gotErr := controller.putUpdateComment(c)
 				if assert.Error(t, gotErr) {
 					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -1188,7 +1242,7 @@ func TestPutThreadLike(t *testing.T) {
 	})
 }
 
-func TestPutThreadFollow(t *testing.T) {
+func TestDeleteComment(t *testing.T) {
 	mockThreadService := &mts.ThreadService{}
 	mockTokenGenerator := &mtg.TokenGenerator{}
 
@@ -1208,12 +1262,14 @@ func TestPutThreadFollow(t *testing.T) {
 		).Once()
 
 		mockThreadService.On(
-			"ChangeFollowingState",
+			"DeleteComment",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"t-XyzAbc",
+			"c-XyzAbce",
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 		).Return(
-			func(ctx context.Context, threadID, accessorUserID string) error {
+			func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
 				return nil
 			},
 		).Once()
@@ -1222,15 +1278,14 @@ func TestPutThreadFollow(t *testing.T) {
 			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id/follow")
-			c.SetParamNames("id")
-			c.SetParamValues("t-XyzAbc")
+			c.SetPath("/:id/comments/:commentID")
+			c.SetParamNames("id", "commentID")
+			c.SetParamValues("t-XyzAbc", "c-XyzAbce")
 
-			if assert.NoError(t, controller.putThreadFollow(c)) {
+			if assert.NoError(t, controller.deleteComment(c)) {
 				assert.Equal(t, http.StatusNoContent, rec.Code)
 			}
 		})
@@ -1263,12 +1318,14 @@ func TestPutThreadFollow(t *testing.T) {
 					).Once()
 
 					mockThreadService.On(
-						"ChangeFollowingState",
+						"DeleteComment",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					).Return(
-						func(ctx context.Context, threadID, accessorUserID string) error {
+						func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -1283,15 +1340,14 @@ func TestPutThreadFollow(t *testing.T) {
 				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
-				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id/follow")
-				c.SetParamNames("id")
-				c.SetParamValues("t-XyzAbc")
+				c.SetPath("/:id/comments/:commentID")
+				c.SetParamNames("id", "commentID")
+				c.SetParamValues("t-XyzAbc", "c-XyzAbce")
 
-				gotErr := controller.putThreadFollow(c)
+				gotErr := controller.deleteComment(c)
 				if assert.Error(t, gotErr) {
 					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -1303,15 +1359,19 @@ func TestPutThreadFollow(t *testing.T) {
 	})
 }
 
-func TestPutThreadAddModerator(t *testing.T) {
+func TestPostCreateCommentReplies(t *testing.T) {
 	mockThreadService := &mts.ThreadService{}
 	mockTokenGenerator := &mtg.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
-		dummyReq := payload.AddRemoveModerator{
-			Username: "naruto",
+		dummyReq := payload.CreateComment{
+			Comment: "I agree with you.",
 		}
 
+		dummyID := "c-AbcXyze"
+		dummyIDResponse := map[string]any{"ID": dummyID}
+		dummyResp := model.NewResponse("success", "Create reply successful.", dummyIDResponse)
+
 		mockTokenGenerator.On(
 			"ExtractToken",
 			mock.AnythingOfType("*echo.context"),
@@ -1327,56 +1387,67 @@ func TestPutThreadAddModerator(t *testing.T) {
 		).Once()
 
 		mockThreadService.On(
-			"AddModerator",
+			"CreateReply",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			"t-XyzAbc",
+			"c-XyzAbce",
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateComment{})),
 		).Return(
-			func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+			func(ctx context.Context, threadID, commentID, accessorUserID string, p payload.CreateComment) string {
+				return dummyID
+			},
+			func(ctx context.Context, threadID, commentID, accessorUserID string, p payload.CreateComment) error {
 				return nil
 			},
 		).Once()
 
-		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+		t.Run("it should return 201 status code with valid response, when there is no error", func(t *testing.T) {
 			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
 			requestBody, err := json.Marshal(dummyReq)
 			assert.NoError(t, err)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/threads", strings.NewReader(string(requestBody)))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id/moderators/add")
-			c.SetParamNames("id")
-			c.SetParamValues("t-XyzAbc")
+			c.SetPath("/:id/comments/:commentID/replies")
+			c.SetParamNames("id", "commentID")
+			c.SetParamValues("t-XyzAbc", "c-XyzAbce")
 
-			if assert.NoError(t, controller.putThreadAddModerator(c)) {
-				assert.Equal(t, http.StatusNoContent, rec.Code)
+			if assert.NoError(t, controller.postCreateCommentReplies(c)) {
+				assert.Equal(t, http.StatusCreated, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := make(map[string]any)
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					gotID := gotResponse["data"].(map[string]any)["ID"].(string)
+					assert.Equal(t, dummyResp.Data["ID"], gotID)
+				}
 			}
 		})
 	})
 
 	t.Run("failed scenario", func(t *testing.T) {
-		dummyReq := payload.UpdateThread{
-			Title:       "Go Programming Going Hype",
-			CategoryID:  "c-xyz",
-			Description: "Currently Go Programming going hype because it's popularity",
+		dummyReq := payload.CreateComment{
+			Comment: "I agree with you.",
 		}
 
 		testCases := []struct {
 			name                 string
-			inputPayload         payload.UpdateThread
+			inputPayload         payload.CreateComment
 			expectedStatusCode   int
 			expectedErrorMessage string
 			mockBehaviours       func()
 		}{
 			{
-				name:                 "it should return 500 status code, when error happened",
+				name:                 "it should return 404 status code, when the comment is not found",
 				inputPayload:         dummyReq,
-				expectedStatusCode:   http.StatusInternalServerError,
-				expectedErrorMessage: "Something went wrong.",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
 				mockBehaviours: func() {
 					mockTokenGenerator.On(
 						"ExtractToken",
@@ -1393,14 +1464,18 @@ func TestPutThreadAddModerator(t *testing.T) {
 					).Once()
 
 					mockThreadService.On(
-						"AddModerator",
+						"CreateReply",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-						mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateComment{})),
 					).Return(
-						func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
-							return service.ErrRepository
+						func(ctx context.Context, threadID, commentID, accessorUserID string, p payload.CreateComment) string {
+							return ""
+						},
+						func(ctx context.Context, threadID, commentID, accessorUserID string, p payload.CreateComment) error {
+							return service.ErrDataNotFound
 						},
 					).Once()
 				},
@@ -1412,19 +1487,19 @@ func TestPutThreadAddModerator(t *testing.T) {
 				testCase.mockBehaviours()
 
 				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
-				requestBody, err := json.Marshal(dummyReq)
+				requestBody, err := json.Marshal(testCase.inputPayload)
 				assert.NoError(t, err)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/threads", strings.NewReader(string(requestBody)))
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id/moderators/add")
-				c.SetParamNames("id")
-				c.SetParamValues("t-XyzAbc")
+				c.SetPath("/:id/comments/:commentID/replies")
+				c.SetParamNames("id", "commentID")
+				c.SetParamValues("t-XyzAbc", "c-XyzAbce")
 
-				gotErr := controller.putThreadAddModerator(c)
+				gotErr := controller.postCreateCommentReplies(c)
 				if assert.Error(t, gotErr) {
 					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -1436,15 +1511,11 @@ func TestPutThreadAddModerator(t *testing.T) {
 	})
 }
 
-func TestPutThreadRemoveModerator(t *testing.T) {
+func TestPutThreadLike(t *testing.T) {
 	mockThreadService := &mts.ThreadService{}
 	mockTokenGenerator := &mtg.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
-		dummyReq := payload.AddRemoveModerator{
-			Username: "naruto",
-		}
-
 		mockTokenGenerator.On(
 			"ExtractToken",
 			mock.AnythingOfType("*echo.context"),
@@ -1460,54 +1531,43 @@ func TestPutThreadRemoveModerator(t *testing.T) {
 		).Once()
 
 		mockThreadService.On(
-			"RemoveModerator",
+			"ChangeLikeState",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 		).Return(
-			func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+			func(ctx context.Context, threadID, accessorUserID string) error {
 				return nil
 			},
 		).Once()
 
 		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
 			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
-			requestBody, err := json.Marshal(dummyReq)
-			assert.NoError(t, err)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id/moderators/remove")
+			c.SetPath("/:id/like")
 			c.SetParamNames("id")
-			c.SetParamValues("t-XyzAbc")
+			c.SetParamValues("c-xyz")
 
-			if assert.NoError(t, controller.putThreadRemoveModerator(c)) {
+			if assert.NoError(t, controller.putThreadLike(c)) {
 				assert.Equal(t, http.StatusNoContent, rec.Code)
 			}
 		})
 	})
 
 	t.Run("failed scenario", func(t *testing.T) {
-		dummyReq := payload.UpdateThread{
-			Title:       "Go Programming Going Hype",
-			CategoryID:  "c-xyz",
-			Description: "Currently Go Programming going hype because it's popularity",
-		}
-
 		testCases := []struct {
 			name                 string
-			inputPayload         payload.UpdateThread
 			expectedStatusCode   int
 			expectedErrorMessage string
 			mockBehaviours       func()
 		}{
 			{
 				name:                 "it should return 500 status code, when error happened",
-				inputPayload:         dummyReq,
 				expectedStatusCode:   http.StatusInternalServerError,
 				expectedErrorMessage: "Something went wrong.",
 				mockBehaviours: func() {
@@ -1526,13 +1586,12 @@ func TestPutThreadRemoveModerator(t *testing.T) {
 					).Once()
 
 					mockThreadService.On(
-						"RemoveModerator",
+						"ChangeLikeState",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-						mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					).Return(
-						func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+						func(ctx context.Context, threadID, accessorUserID string) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -1545,19 +1604,17 @@ func TestPutThreadRemoveModerator(t *testing.T) {
 				testCase.mockBehaviours()
 
 				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
-				requestBody, err := json.Marshal(dummyReq)
-				assert.NoError(t, err)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id/moderators/remove")
+				c.SetPath("/:id/like")
 				c.SetParamNames("id")
-				c.SetParamValues("t-XyzAbc")
+				c.SetParamValues("c-xyz")
 
-				gotErr := controller.putThreadRemoveModerator(c)
+				gotErr := controller.putThreadLike(c)
 				if assert.Error(t, gotErr) {
 					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -1568,3 +1625,1735 @@ func TestPutThreadRemoveModerator(t *testing.T) {
 		}
 	})
 }
+
+func TestPutThreadFollow(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"ChangeFollowingState",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, threadID, accessorUserID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/follow")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.putThreadFollow(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"ChangeFollowingState",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/follow")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.putThreadFollow(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutThreadAddModerator(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyReq := payload.AddRemoveModerator{
+			Username: "naruto",
+		}
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"AddModerator",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/moderators/add")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.putThreadAddModerator(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		dummyReq := payload.UpdateThread{
+			Title:       "Go Programming Going Hype",
+			CategoryID:  "c-xyz",
+			Description: "Currently Go Programming going hype because it's popularity",
+		}
+
+		testCases := []struct {
+			name                 string
+			inputPayload         payload.UpdateThread
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"AddModerator",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				requestBody, err := json.Marshal(dummyReq)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/moderators/add")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.putThreadAddModerator(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutThreadRemoveModerator(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyReq := payload.AddRemoveModerator{
+			Username: "naruto",
+		}
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"RemoveModerator",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/moderators/remove")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.putThreadRemoveModerator(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		dummyReq := payload.UpdateThread{
+			Title:       "Go Programming Going Hype",
+			CategoryID:  "c-xyz",
+			Description: "Currently Go Programming going hype because it's popularity",
+		}
+
+		testCases := []struct {
+			name                 string
+			inputPayload         payload.UpdateThread
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"RemoveModerator",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.AddRemoveModerator{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, p payload.AddRemoveModerator, threadID, accessorUserID string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				requestBody, err := json.Marshal(dummyReq)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/moderators/remove")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.putThreadRemoveModerator(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutThreadLock(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"LockThread",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, threadID, accessorUserID, role string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/lock")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.putThreadLock(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 400 status code, when the thread is already locked",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Data already exists.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"LockThread",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string) error {
+							return service.ErrDataAlreadyExists
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"LockThread",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 404 status code, when the thread is not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"LockThread",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"LockThread",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/lock")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.putThreadLock(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestDeleteThreadLock(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"UnlockThread",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, threadID, accessorUserID, role string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/lock")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.deleteThreadLock(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnlockThread",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnlockThread",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/lock")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.deleteThreadLock(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutCommentPin(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"PinComment",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/comments/:commentID/pin")
+			c.SetParamNames("id", "commentID")
+			c.SetParamValues("t-XyzAbc", "c-XyzAbc")
+
+			if assert.NoError(t, controller.putCommentPin(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"PinComment",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 404 status code, when the comment is not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"PinComment",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"PinComment",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/comments/:commentID/pin")
+				c.SetParamNames("id", "commentID")
+				c.SetParamValues("t-XyzAbc", "c-XyzAbc")
+
+				gotErr := controller.putCommentPin(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestDeleteCommentPin(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"UnpinComment",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/comments/:commentID/pin")
+			c.SetParamNames("id", "commentID")
+			c.SetParamValues("t-XyzAbc", "c-XyzAbc")
+
+			if assert.NoError(t, controller.deleteCommentPin(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnpinComment",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnpinComment",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, commentID, accessorUserID, role string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/comments/:commentID/pin")
+				c.SetParamNames("id", "commentID")
+				c.SetParamValues("t-XyzAbc", "c-XyzAbc")
+
+				gotErr := controller.deleteCommentPin(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutThreadMute(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyReq := payload.MuteUser{
+			Username: "naruto",
+			Reason:   "spamming",
+			Hours:    24,
+		}
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"MuteUser",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.MuteUser{})),
+		).Return(
+			func(ctx context.Context, threadID, accessorUserID, role string, p payload.MuteUser) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/mutes")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.putThreadMute(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		dummyReq := payload.MuteUser{
+			Username: "naruto",
+			Reason:   "spamming",
+			Hours:    24,
+		}
+
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 400 status code, when payload is invalid",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"MuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.MuteUser{})),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string, p payload.MuteUser) error {
+							return service.ErrInvalidPayload
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 404 status code, when the user is not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "User with given username not found.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"MuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.MuteUser{})),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string, p payload.MuteUser) error {
+							return service.ErrUsernameNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"MuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.MuteUser{})),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string, p payload.MuteUser) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"MuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.MuteUser{})),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string, p payload.MuteUser) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				requestBody, err := json.Marshal(dummyReq)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/threads", strings.NewReader(string(requestBody)))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/mutes")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.putThreadMute(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestDeleteThreadMute(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"UnmuteUser",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, threadID, username, accessorUserID, role string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/mutes/:username")
+			c.SetParamNames("id", "username")
+			c.SetParamValues("t-XyzAbc", "naruto")
+
+			if assert.NoError(t, controller.deleteThreadMute(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 404 status code, when the user is not muted",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnmuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, username, accessorUserID, role string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnmuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, username, accessorUserID, role string) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"UnmuteUser",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, threadID, username, accessorUserID, role string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodDelete, "/api/v1/threads", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/mutes/:username")
+				c.SetParamNames("id", "username")
+				c.SetParamValues("t-XyzAbc", "naruto")
+
+				gotErr := controller.deleteThreadMute(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetThreadModerationLogs(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "u-abcdefg",
+					Username: "erikrios",
+					Role:     "user",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockThreadService.On(
+			"GetModerationLogs",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+		).Return(
+			func(ctx context.Context, threadID, accessorUserID, role string, page, limit uint) response.Pagination[response.ModerationLog] {
+				return response.Pagination[response.ModerationLog]{
+					List: []response.ModerationLog{{ID: "g-XyzAbc", Action: "lock"}},
+				}
+			},
+			func(ctx context.Context, threadID, accessorUserID, role string, page, limit uint) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/threads", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/moderation-logs")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			if assert.NoError(t, controller.getThreadModerationLogs(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := make(map[string]any)
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, "Get moderation logs successful.", gotResponse["message"])
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 403 status code, when the accessor is not a thread moderator",
+				expectedStatusCode:   http.StatusForbidden,
+				expectedErrorMessage: "Access to this resource is forbidden for current role.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"GetModerationLogs",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string, page, limit uint) response.Pagination[response.ModerationLog] {
+							return response.Pagination[response.ModerationLog]{}
+						},
+						func(ctx context.Context, threadID, accessorUserID, role string, page, limit uint) error {
+							return service.ErrAccessForbidden
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return generator.TokenPayload{
+								ID:       "u-abcdefg",
+								Username: "erikrios",
+								Role:     "user",
+								IsActive: true,
+							}
+						},
+					).Once()
+
+					mockThreadService.On(
+						"GetModerationLogs",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+						mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					).Return(
+						func(ctx context.Context, threadID, accessorUserID, role string, page, limit uint) response.Pagination[response.ModerationLog] {
+							return response.Pagination[response.ModerationLog]{}
+						},
+						func(ctx context.Context, threadID, accessorUserID, role string, page, limit uint) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/threads", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/moderation-logs")
+				c.SetParamNames("id")
+				c.SetParamValues("t-XyzAbc")
+
+				gotErr := controller.getThreadModerationLogs(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPostCreateThreadCommentsModeration(t *testing.T) {
+	mockThreadService := &mts.ThreadService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "it should return 403 status code, when the thread is locked",
+			inputError:           service.ErrThreadLocked,
+			expectedStatusCode:   http.StatusForbidden,
+			expectedErrorMessage: "Thread is locked.",
+		},
+		{
+			name:                 "it should return 403 status code, when the user is muted in the thread",
+			inputError:           service.ErrUserMuted,
+			expectedStatusCode:   http.StatusForbidden,
+			expectedErrorMessage: "You are muted in this thread.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockTokenGenerator.On(
+				"ExtractToken",
+				mock.AnythingOfType("*echo.context"),
+			).Return(
+				func(c echo.Context) generator.TokenPayload {
+					return generator.TokenPayload{
+						ID:       "u-abcdefg",
+						Username: "erikrios",
+						Role:     "user",
+						IsActive: true,
+					}
+				},
+			).Once()
+
+			mockThreadService.On(
+				"CreateComment",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateComment{})),
+			).Return(
+				func(ctx context.Context, threadID, accessorUserID string, p payload.CreateComment) string {
+					return ""
+				},
+				func(ctx context.Context, threadID, accessorUserID string, p payload.CreateComment) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewThreadsController(mockThreadService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/threads", strings.NewReader(`{"comment":"Nice thread, good job."}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/comments")
+			c.SetParamNames("id")
+			c.SetParamValues("t-XyzAbc")
+
+			gotErr := controller.postCreateThreadComments(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package controller
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
 	"github.com/labstack/echo/v4"
@@ -31,10 +34,16 @@ func (u *usersController) Route(g *echo.Group) {
 	group := g.Group("/users")
 	group.GET("", u.getUsers, middleware.JWTMiddleware())
 	group.GET("/me", u.getMe, middleware.JWTMiddleware())
+	group.PUT("/me", u.putMe, middleware.JWTMiddleware())
+	group.DELETE("/me", u.deleteMe, middleware.JWTMiddleware())
+	group.PUT("/me/password", u.putMePassword, middleware.JWTMiddleware())
+	group.GET("/me/export", u.getMeExport, middleware.JWTMiddleware())
 	group.GET("/:username", u.getUserByUsername, middleware.JWTMiddleware())
 	group.GET("/:username/threads", u.getUserThreads, middleware.JWTMiddleware())
 	group.PUT("/:username/follow", u.putUserFollow, middleware.JWTMiddleware())
 	group.PUT("/:username/banned", u.putUserBanned, middleware.JWTMiddleware())
+	group.DELETE("/:username/banned", u.deleteUserBanned, middleware.JWTMiddleware())
+	group.GET("/:username/bans", u.getUserBans, middleware.JWTMiddleware())
 }
 
 // getUsers     godoc
@@ -44,6 +53,7 @@ func (u *usersController) Route(g *echo.Group) {
 // @Produce      json
 // @Param        page      query  int     false  "page, default 1"
 // @Param        limit     query  int     false  "limit, default 20"
+// @Param        cursor    query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
 // @Param        order_by  query  string  false  "options: registered_date, ranking, default registered_date"
 // @Param        status    query  string  false  "options: active, banned, default active"
 // @Param        keyword   query  string  false  "search by keyword, default empty string"
@@ -55,6 +65,7 @@ func (u *usersController) Route(g *echo.Group) {
 func (u *usersController) getUsers(c echo.Context) error {
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
 	orderBy := c.QueryParam("order_by")
 	status := c.QueryParam("status")
 	keyword := c.QueryParam("keyword")
@@ -78,6 +89,7 @@ func (u *usersController) getUsers(c echo.Context) error {
 		status,
 		uint(page),
 		uint(limit),
+		cursor,
 		keyword,
 	)
 
@@ -115,6 +127,132 @@ func (u *usersController) getMe(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// putMe         godoc
+// @Summary      Update Own Profile
+// @Description  This endpoint is used to update their own user profile
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.UpdateProfile  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  profileResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /users/me [put]
+func (u *usersController) putMe(c echo.Context) error {
+	p := new(payload.UpdateProfile)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	tp := u.tokenGenerator.ExtractToken(c)
+
+	userResponse, err := u.userService.UpdateOwn(c.Request().Context(), tp.ID, tp.Username, *p)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Update user successful.", userResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// putMePassword godoc
+// @Summary      Change Own Password
+// @Description  This endpoint is used to change their own password. Every session is signed out, the returned tokens belong to a new session of the current device.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.ChangePassword  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  loginResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /users/me/password [put]
+func (u *usersController) putMePassword(c echo.Context) error {
+	p := new(payload.ChangePassword)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if p.Device == "" {
+		p.Device = c.Request().UserAgent()
+	}
+
+	tp := u.tokenGenerator.ExtractToken(c)
+
+	tokenResponse, err := u.userService.ChangePassword(c.Request().Context(), tp.ID, tp.Username, *p)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Change password successful.", tokenResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// deleteMe      godoc
+// @Summary      Delete Own Account
+// @Description  This endpoint is used to delete their own account. The profile is anonymized and the likes, follows and sessions are removed, while the threads and comments are kept under a placeholder name so the discussions stay intact. It can't be undone.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.DeleteAccount  true  "request body"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /users/me [delete]
+func (u *usersController) deleteMe(c echo.Context) error {
+	p := new(payload.DeleteAccount)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	tp := u.tokenGenerator.ExtractToken(c)
+
+	if err := u.userService.DeleteOwn(c.Request().Context(), tp.ID, tp.Username, *p); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getMeExport   godoc
+// @Summary      Export Own Data
+// @Description  This endpoint is used to download their own profile, threads, comments, likes and follows as a JSON archive
+// @Tags         users
+// @Produce      json
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  response.PersonalData
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /users/me/export [get]
+func (u *usersController) getMeExport(c echo.Context) error {
+	tp := u.tokenGenerator.ExtractToken(c)
+
+	personalData, err := u.userService.ExportOwn(c.Request().Context(), tp.ID)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	// The archive is the document itself, so it isn't wrapped in the usual response.
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", tp.Username+"-data.json"))
+
+	return c.JSON(http.StatusOK, personalData)
+}
+
 // getUserByUsername godoc
 // @Summary      Get User by Username
 // @Description  This endpoint is used to get the another user by username
@@ -147,12 +285,20 @@ func (u *usersController) getUserByUsername(c echo.Context) error {
 // @Description  This endpoint is used to get the user threads
 // @Tags         users
 // @Produce      json
-// @Param        username  path   string  true   "username"
-// @Param        page      query  int     false  "page, default 1"
-// @Param        limit     query  int     false  "limit, default 10"
+// @Param        username       path   string  true   "username"
+// @Param        page           query  int     false  "page, default 1"
+// @Param        limit          query  int     false  "limit, default 10"
+// @Param        cursor         query  string  false  "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page"
+// @Param        sort           query  string  false  "options: newest, liked, viewed, commented, trending, default newest"
+// @Param        unanswered     query  bool    false  "only threads without comments, default false"
+// @Param        followed       query  bool    false  "only threads followed by current user, default false"
+// @Param        createdAfter   query  string  false  "only threads created on or after the date, format 2006-01-02"
+// @Param        createdBefore  query  string  false  "only threads created before the date, format 2006-01-02"
+// @Param        category       query  string  false  "category ID"
 // @Security     ApiKey
 // @Security     ApiKeyAuth
 // @Success      200  {object}  threadsResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /users/{username}/threads [get]
@@ -161,6 +307,9 @@ func (u *usersController) getUserThreads(c echo.Context) error {
 
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
+	sort := c.QueryParam("sort")
+	filter := newThreadFilter(c)
 
 	page, convErr := strconv.Atoi(pageStr)
 	if convErr != nil || page < 0 {
@@ -180,6 +329,9 @@ func (u *usersController) getUserThreads(c echo.Context) error {
 		username,
 		uint(page),
 		uint(limit),
+		cursor,
+		sort,
+		filter,
 	)
 
 	if err != nil {
@@ -222,12 +374,14 @@ func (u *usersController) putUserFollow(c echo.Context) error {
 }
 
 // putUserBanned godoc
-// @Summary      Banned/Unbanned a User
-// @Description  This endpoint is used to banned/unbanned a user
+// @Summary      Ban a User
+// @Description  This endpoint is used to ban a user with an optional reason, for the given days or permanently when the days is 0
+// @Description  The body is optional, without it the user is banned permanently.
 // @Tags         users
 // @Accept       json
 // @Produce      json
-// @Param        username  path  string  true  "username"
+// @Param        username  path  string           true   "username"
+// @Param        default   body  payload.BanUser  false  "request body"
 // @Security     ApiKey
 // @Security     ApiKeyAuth
 // @Success      204
@@ -240,10 +394,49 @@ func (u *usersController) putUserFollow(c echo.Context) error {
 func (u *usersController) putUserBanned(c echo.Context) error {
 	username := c.Param("username")
 
+	p := new(payload.BanUser)
+	if err := c.Bind(p); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	tp := u.tokenGenerator.ExtractToken(c)
+
+	if err := u.userService.Ban(
+		c.Request().Context(),
+		tp.ID,
+		tp.Role,
+		username,
+		*p,
+	); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteUserBanned godoc
+// @Summary      Unban a User
+// @Description  This endpoint is used to lift the active ban of a user
+// @Tags         users
+// @Produce      json
+// @Param        username  path  string  true  "username"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /users/{username}/banned [delete]
+func (u *usersController) deleteUserBanned(c echo.Context) error {
+	username := c.Param("username")
+
 	tp := u.tokenGenerator.ExtractToken(c)
 
-	if err := u.userService.ChangeBannedState(
+	if err := u.userService.Unban(
 		c.Request().Context(),
+		tp.ID,
 		tp.Role,
 		username,
 	); err != nil {
@@ -253,6 +446,40 @@ func (u *usersController) putUserBanned(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// getUserBans   godoc
+// @Summary      Get Ban History of a User
+// @Description  This endpoint is used to get the ban history of a user, the newest first
+// @Tags         users
+// @Produce      json
+// @Param        username  path  string  true  "username"
+// @Security     ApiKey
+// @Security     ApiKeyAuth
+// @Success      200  {object}  bansResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      403  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /users/{username}/bans [get]
+func (u *usersController) getUserBans(c echo.Context) error {
+	username := c.Param("username")
+
+	tp := u.tokenGenerator.ExtractToken(c)
+
+	bansResponse, err := u.userService.GetAllBan(
+		c.Request().Context(),
+		tp.Username,
+		tp.Role,
+		username,
+	)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	response := model.NewResponse("success", "Get ban history successful.", bansResponse)
+
+	return c.JSON(http.StatusOK, response)
+}
+
 // profileResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type profileResponse struct {
 	Status  string        `json:"status" extensions:"x-order=0"`
@@ -302,3 +529,10 @@ type pageInfoData struct {
 	PageTotal uint `json:"pageTotal" extensions:"x-order=2"`
 	Total     uint `json:"total" extensions:"x-order=3"`
 }
+
+// bansResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type bansResponse struct {
+	Status  string         `json:"status" extensions:"x-order=0"`
+	Message string         `json:"message" extensions:"x-order=1"`
+	Data    []response.Ban `json:"data" extensions:"x-order=2"`
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
 	mus "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user/mocks"
@@ -84,6 +86,7 @@ func TestGetUsers(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 		).Return(
 			func(ctx context.Context,
 				accessorUserID string,
@@ -91,6 +94,7 @@ func TestGetUsers(t *testing.T) {
 				status string,
 				page uint,
 				limit uint,
+				cursor string,
 				keyword string,
 			) response.Pagination[response.User] {
 				return dummyPagination
@@ -101,6 +105,7 @@ func TestGetUsers(t *testing.T) {
 				status string,
 				page uint,
 				limit uint,
+				cursor string,
 				keyword string,
 			) error {
 				return nil
@@ -165,6 +170,7 @@ func TestGetUsers(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
@@ -172,6 +178,7 @@ func TestGetUsers(t *testing.T) {
 						status string,
 						page uint,
 						limit uint,
+						cursor string,
 						keyword string,
 					) response.Pagination[response.User] {
 						return response.Pagination[response.User]{}
@@ -182,6 +189,7 @@ func TestGetUsers(t *testing.T) {
 						status string,
 						page uint,
 						limit uint,
+						cursor string,
 						keyword string,
 					) error {
 						return service.ErrRepository
@@ -348,6 +356,485 @@ func TestGetMe(t *testing.T) {
 	})
 }
 
+func TestPutMe(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-xyz",
+		Username: "sarifaturr",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyUser := response.User{
+			UserID:    "u-xyz",
+			Username:  "sarifaturr",
+			Email:     "sarifaturr@gmail.com",
+			Name:      "sari faturr",
+			Role:      "user",
+			IsActive:  true,
+			Bio:       "Frontend engineer",
+			AvatarURL: "https://example.com/sarifaturr.png",
+			Location:  "Indonesia",
+		}
+		dummyResp := model.NewResponse("success", "Update user successful.", dummyUser)
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"UpdateOwn",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-xyz",
+			"sarifaturr",
+			payload.UpdateProfile{
+				Email:     "sarifaturr@gmail.com",
+				Name:      "sari faturr",
+				Bio:       "Frontend engineer",
+				AvatarURL: "https://example.com/sarifaturr.png",
+				Location:  "Indonesia",
+			},
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.UpdateProfile) response.User {
+				return dummyUser
+			},
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.UpdateProfile) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			requestBody := `{"email": "sarifaturr@gmail.com", "name": "sari faturr", "bio": "Frontend engineer", "avatarURL": "https://example.com/sarifaturr.png", "location": "Indonesia"}`
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/me", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.putMe(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", response.User{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResp.Data, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			requestBody          string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviours       func()
+		}{
+			{
+				name:                 "it should return 400 status code, when the payload is not a valid JSON",
+				requestBody:          `{"email": 1}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviours:       func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when the email already exists",
+				requestBody:          `{"email": "sarifaturr@gmail.com", "name": "sari faturr"}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Data already exists.",
+				mockBehaviours: func() {
+					mockTokenGenerator.On(
+						"ExtractToken",
+						mock.AnythingOfType("*echo.context"),
+					).Return(
+						func(c echo.Context) generator.TokenPayload {
+							return dummyTokenPayload
+						},
+					).Once()
+
+					mockUserService.On(
+						"UpdateOwn",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateProfile{})),
+					).Return(
+						func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.UpdateProfile) response.User {
+							return response.User{}
+						},
+						func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.UpdateProfile) error {
+							return service.ErrDataAlreadyExists
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviours()
+
+				controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/users/me", strings.NewReader(testCase.requestBody))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotErr := controller.putMe(c)
+				if assert.Error(t, gotErr) {
+					if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutMePassword(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-xyz",
+		Username: "sarifaturr",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyLogin := response.Login{
+			Token:        "generatedtoken",
+			RefreshToken: "generatedrefreshtoken",
+			Role:         "user",
+		}
+		dummyResp := model.NewResponse("success", "Change password successful.", dummyLogin)
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"ChangePassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-xyz",
+			"sarifaturr",
+			payload.ChangePassword{
+				OldPassword: "sarifaturr",
+				NewPassword: "sarifaturr123",
+				Device:      "Firefox",
+			},
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.ChangePassword) response.Login {
+				return dummyLogin
+			},
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.ChangePassword) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with new tokens, when there is no error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			requestBody := `{"oldPassword": "sarifaturr", "newPassword": "sarifaturr123"}`
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/me/password", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set("User-Agent", "Firefox")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.putMePassword(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := model.NewResponse("", "", response.Login{})
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResp.Data, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"ChangePassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ChangePassword{})),
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.ChangePassword) response.Login {
+				return response.Login{}
+			},
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.ChangePassword) error {
+				return service.ErrCredentialNotMatch
+			},
+		).Once()
+
+		t.Run("it should return 401 status code, when the old password doesn't match", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			requestBody := `{"oldPassword": "wrongpassword", "newPassword": "sarifaturr123", "device": "Firefox"}`
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/me/password", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.putMePassword(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusUnauthorized, echoHTTPError.Code)
+					assert.Equal(t, "Username and password not match.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestDeleteMe(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-xyz",
+		Username: "sarifaturr",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"DeleteOwn",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-xyz",
+			"sarifaturr",
+			payload.DeleteAccount{Password: "sarifaturr"},
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.DeleteAccount) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			requestBody := `{"password": "sarifaturr"}`
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.deleteMe(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"DeleteOwn",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-xyz",
+			"sarifaturr",
+			payload.DeleteAccount{Password: "wrongpassword"},
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorUsername string, p payload.DeleteAccount) error {
+				return service.ErrCredentialNotMatch
+			},
+		).Once()
+
+		t.Run("it should return 401 status code, when the password doesn't match", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			requestBody := `{"password": "wrongpassword"}`
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/users/me", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.deleteMe(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusUnauthorized, echoHTTPError.Code)
+					assert.Equal(t, "Username and password not match.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetMeExport(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-xyz",
+		Username: "sarifaturr",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyPersonalData := response.PersonalData{
+			ExportedAt: "2022-07-06T09:10:20Z",
+			Profile: response.PersonalProfile{
+				UserID:   "u-xyz",
+				Username: "sarifaturr",
+				Email:    "sarifaturr@gmail.com",
+				Name:     "Sarifatur",
+				Role:     "user",
+				IsActive: true,
+			},
+			Threads:         []response.PersonalThread{},
+			Comments:        []response.PersonalComment{},
+			Likes:           []response.PersonalThreadEntry{},
+			Following:       []response.PersonalUserEntry{},
+			Followers:       []response.PersonalUserEntry{},
+			FollowedThreads: []response.PersonalThreadEntry{},
+		}
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"ExportOwn",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-xyz",
+		).Return(
+			func(ctx context.Context, accessorUserID string) response.PersonalData {
+				return dummyPersonalData
+			},
+			func(ctx context.Context, accessorUserID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with the archive, when there is no error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/export", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getMeExport(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, `attachment; filename="sarifaturr-data.json"`, rec.Header().Get(echo.HeaderContentDisposition))
+
+				var gotPersonalData response.PersonalData
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotPersonalData); assert.NoError(t, err) {
+					assert.Equal(t, dummyPersonalData, gotPersonalData)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"ExportOwn",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-xyz",
+		).Return(
+			func(ctx context.Context, accessorUserID string) response.PersonalData {
+				return response.PersonalData{}
+			},
+			func(ctx context.Context, accessorUserID string) error {
+				return service.ErrRepository
+			},
+		).Once()
+
+		t.Run("it should return 500 status code, when there is a repository error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me/export", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotErr := controller.getMeExport(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+				}
+			}
+		})
+	})
+}
+
 func TestGetUserByUsername(t *testing.T) {
 	mockUserService := &mus.UserService{}
 	mockTokenGenerator := &mtg.TokenGenerator{}
@@ -541,12 +1028,18 @@ func TestGetUserThreads(t *testing.T) {
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 			mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 		).Return(
 			func(ctx context.Context,
 				accessorUserID string,
 				username string,
 				page uint,
 				limit uint,
+				cursor string,
+				sort string,
+				f payload.ThreadFilter,
 			) response.Pagination[response.ManyThread] {
 				return dummyPagination
 			},
@@ -555,6 +1048,9 @@ func TestGetUserThreads(t *testing.T) {
 				username string,
 				page uint,
 				limit uint,
+				cursor string,
+				sort string,
+				f payload.ThreadFilter,
 			) error {
 				return nil
 			},
@@ -616,12 +1112,18 @@ func TestGetUserThreads(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
 					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.ThreadFilter{})),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
 						username string,
 						page uint,
 						limit uint,
+						cursor string,
+						sort string,
+						f payload.ThreadFilter,
 					) response.Pagination[response.ManyThread] {
 						return response.Pagination[response.ManyThread]{}
 					},
@@ -630,6 +1132,9 @@ func TestGetUserThreads(t *testing.T) {
 						username string,
 						page uint,
 						limit uint,
+						cursor string,
+						sort string,
+						f payload.ThreadFilter,
 					) error {
 						return service.ErrRepository
 					},
@@ -793,12 +1298,14 @@ func TestPutUserBanned(t *testing.T){
 		).Once()
 
 		mockUserService.On(
-			"ChangeBannedState",
+			"Ban",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			"a-abcd",
+			"admin",
+			"sarifaturr",
+			payload.BanUser{Reason: "Spamming", Days: 7},
 		).Return(
-			func(ctx context.Context, accessorRole string, username string) error {
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.BanUser) error {
 				return nil
 			},
 		).Once()
@@ -806,6 +1313,50 @@ func TestPutUserBanned(t *testing.T){
 		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
 			controller := NewUsersController(mockUserService, mockTokenGenerator)
 
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users", strings.NewReader(`{"reason": "Spamming", "days": 7}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:username/banned")
+			c.SetParamNames("username")
+			c.SetParamValues("sarifaturr")
+
+			if assert.NoError(t, controller.putUserBanned(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return generator.TokenPayload{
+					ID:       "a-abcd",
+					Username: "sarifaturr",
+					Role:     "admin",
+					IsActive: true,
+				}
+			},
+		).Once()
+
+		mockUserService.On(
+			"Ban",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"a-abcd",
+			"admin",
+			"sarifaturr",
+			payload.BanUser{},
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.BanUser) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code and ban permanently, when the body is empty", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPut, "/api/v1/users", nil)
 			rec := httptest.NewRecorder()
@@ -847,12 +1398,14 @@ func TestPutUserBanned(t *testing.T){
 					).Once()
 
 					mockUserService.On(
-						"ChangeBannedState",
+						"Ban",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.BanUser{})),
 					).Return(
-						func(ctx context.Context, accessorRole string, username string) error {
+						func(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.BanUser) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -867,7 +1420,8 @@ func TestPutUserBanned(t *testing.T){
 				controller := NewUsersController(mockUserService, mockTokenGenerator)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodPut, "/api/v1/users", nil)
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/users", strings.NewReader(`{"reason": "Spamming"}`))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
 				c.SetPath("/:username/banned")
@@ -885,3 +1439,213 @@ func TestPutUserBanned(t *testing.T){
 		}
 	})
 }
+
+func TestDeleteUserBanned(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "a-abcd",
+		Username: "sarifaturr",
+		Role:     "admin",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"Unban",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"a-abcd",
+			"admin",
+			"naruto",
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/users", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:username/banned")
+			c.SetParamNames("username")
+			c.SetParamValues("naruto")
+
+			if assert.NoError(t, controller.deleteUserBanned(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"Unban",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, accessorUserID string, accessorRole string, username string) error {
+				return service.ErrDataAlreadyExists
+			},
+		).Once()
+
+		t.Run("it should return 400 status code, when the user is not banned", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/users", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:username/banned")
+			c.SetParamNames("username")
+			c.SetParamValues("naruto")
+
+			gotErr := controller.deleteUserBanned(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+					assert.Equal(t, "Data already exists.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestGetUserBans(t *testing.T) {
+	mockUserService := &mus.UserService{}
+	mockTokenGenerator := &mtg.TokenGenerator{}
+
+	dummyTokenPayload := generator.TokenPayload{
+		ID:       "u-ZrxmQS",
+		Username: "naruto",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyBans := []response.Ban{
+			{
+				ID:             "b-abcdefg",
+				Reason:         "Spamming",
+				IssuerUsername: "sarifaturr",
+				ExpiresOn:      "08 Jun 22 10:00 UTC",
+				LiftedOn:       "08 Jun 22 10:00 UTC",
+				BannedOn:       "01 Jun 22 10:00 UTC",
+			},
+		}
+
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"GetAllBan",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"naruto",
+			"user",
+			"naruto",
+		).Return(
+			func(ctx context.Context, accessorUsername string, accessorRole string, username string) []response.Ban {
+				return dummyBans
+			},
+			func(ctx context.Context, accessorUsername string, accessorRole string, username string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:username/bans")
+			c.SetParamNames("username")
+			c.SetParamValues("naruto")
+
+			if assert.NoError(t, controller.getUserBans(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := model.NewResponse("", "", []response.Ban{})
+
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyBans, gotResponse.Data)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTokenGenerator.On(
+			"ExtractToken",
+			mock.AnythingOfType("*echo.context"),
+		).Return(
+			func(c echo.Context) generator.TokenPayload {
+				return dummyTokenPayload
+			},
+		).Once()
+
+		mockUserService.On(
+			"GetAllBan",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, accessorUsername string, accessorRole string, username string) []response.Ban {
+				return nil
+			},
+			func(ctx context.Context, accessorUsername string, accessorRole string, username string) error {
+				return service.ErrAccessForbidden
+			},
+		).Once()
+
+		t.Run("it should return 403 status code, when the user sees the bans of another user", func(t *testing.T) {
+			controller := NewUsersController(mockUserService, mockTokenGenerator)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:username/bans")
+			c.SetParamNames("username")
+			c.SetParamValues("erikrios")
+
+			gotErr := controller.getUserBans(c)
+			if assert.Error(t, gotErr) {
+				if echoHTTPError, ok := gotErr.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusForbidden, echoHTTPError.Code)
+				}
+			}
+		})
+	})
+}
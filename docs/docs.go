@@ -25,7 +25,7 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/admin/dashboard": {
+        "/admin/audit": {
             "get": {
                 "security": [
                     {
@@ -35,19 +35,81 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get all information for admin dashboard purpose",
+                "description": "This endpoint is used to get the audit log of the administrative actions, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get Info",
+                "summary": "Get Audit Logs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only actions taken by the username",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "e.g. thread.delete, comment.delete, thread.moderator.add, thread.moderator.remove, category.create, category.update, category.delete, user.ban, user.unban, user.role.grant, user.role.revoke, report.status.update",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: thread, comment, category, user, report, lockout",
+                        "name": "targetType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "target ID",
+                        "name": "targetID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only actions taken on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only actions taken before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.infoResponse"
+                            "$ref": "#/definitions/controller.auditLogsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -59,26 +121,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories": {
+        "/admin/dashboard": {
             "get": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get all category",
+                "description": "This endpoint is used to get all information for admin dashboard purpose",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Get Categories",
+                "summary": "Get Info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.categoriesResponse"
+                            "$ref": "#/definitions/controller.infoResponse"
                         }
                     },
                     "500": {
@@ -88,8 +153,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/export/{resource}": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
@@ -98,33 +165,47 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to create a category",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to download all the users, threads, comments or reports as CSV or NDJSON, the rows are streamed oldest first",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Create a Category",
+                "summary": "Export Data",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.CreateCategory"
-                        }
+                        "type": "string",
+                        "description": "available options: users, threads, comments, reports",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "available options: csv, ndjson, default csv",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only rows created on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only rows created before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.createThreadResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -133,20 +214,48 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
+                    }
+                }
+            }
+        },
+        "/admin/lockouts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
                     },
-                    "404": {
-                        "description": "Not Found",
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get the usernames and the IP addresses currently locked out of the login after too many failed attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get All Lockouts",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.lockoutsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -160,8 +269,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}": {
-            "put": {
+        "/admin/lockouts/{kind}/{value}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKey": []
@@ -170,31 +279,26 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to update a category",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to clear the failed login attempts and the lockout of a username or an IP address",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Update a Category",
+                "summary": "Clear Lockout",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.UpdateCategory"
-                        }
+                        "type": "string",
+                        "description": "lockout kind, available options: username, ip",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "category ID",
-                        "name": "id",
+                        "description": "username or IP address",
+                        "name": "value",
                         "in": "path",
                         "required": true
                     }
@@ -209,12 +313,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -234,8 +332,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/roles": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
@@ -244,35 +344,23 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to delete a category by ID",
+                "description": "This endpoint is used to get the available roles with their permissions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
-                ],
-                "summary": "Delete Category by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "Get All Roles",
                 "responses": {
-                    "204": {
-                        "description": ""
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.rolesResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -286,7 +374,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}/threads": {
+        "/admin/stats": {
             "get": {
                 "security": [
                     {
@@ -296,31 +384,36 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get the threads of particular category",
+                "description": "This endpoint is used to get the growth of the forum over a period for admin dashboard charts, with the top categories, the top threads and the most active users of the period",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Get Category Threads",
+                "summary": "Get Stats",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "start of the period, format 2006-01-02, default 30 days before to",
+                        "name": "from",
+                        "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
+                        "type": "string",
+                        "description": "end of the period, inclusive, format 2006-01-02, default today",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "bucket size, available options: day, week, month, default day",
+                        "name": "interval",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "limit, default 10",
+                        "description": "length of the top lists, default 5, max 50",
                         "name": "limit",
                         "in": "query"
                     }
@@ -329,11 +422,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.threadsResponse"
+                            "$ref": "#/definitions/controller.statsResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -347,46 +446,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/guest/threads": {
-            "get": {
+        "/admin/users/{username}/role": {
+            "put": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get all threads",
+                "description": "This endpoint is used to grant a role to the user, the user has to sign in again to use it",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "admin"
                 ],
-                "summary": "Get Threads",
+                "summary": "Grant Role",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "limit, default 10",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "search by keyword, default empty string",
-                        "name": "search",
-                        "in": "query"
+                        "description": "role to grant",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.GrantRole"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/controller.threadsResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -396,41 +514,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/guest/threads/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get thread by ID",
+                "description": "This endpoint is used to revoke the role of the user back to user, the user has to sign in again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "admin"
                 ],
-                "summary": "Get Thread by ID",
+                "summary": "Revoke Role",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
+                        "description": "username",
+                        "name": "username",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/controller.threadResponse"
-                        }
+                    "204": {
+                        "description": ""
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -450,53 +566,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/guest/threads/{id}/comments": {
+        "/categories": {
             "get": {
                 "security": [
                     {
                         "ApiKey": []
                     }
                 ],
-                "description": "This endpoint is used to get the thread comments",
+                "description": "This endpoint is used to get all category",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
-                ],
-                "summary": "Get Thread Comments",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit, default 20",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "categories"
                 ],
+                "summary": "Get Categories",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.commentsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.categoriesResponse"
                         }
                     },
                     "500": {
@@ -506,60 +595,67 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/guest/users": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get all users",
+                "description": "This endpoint is used to create a category",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "categories"
                 ],
-                "summary": "Get Users",
+                "summary": "Create a Category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateCategory"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createThreadResponse"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "limit, default 20",
-                        "name": "limit",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "options: registered_date, ranking, default registered_date",
-                        "name": "order_by",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "options: active, banned, default active",
-                        "name": "status",
-                        "in": "query"
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "search by keyword, default empty string",
-                        "name": "keyword",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/controller.profilesResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -571,35 +667,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/guest/users/{username}": {
-            "get": {
+        "/categories/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get the another user by username",
+                "description": "This endpoint is used to update a category",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "categories"
                 ],
-                "summary": "Get User by Username",
+                "summary": "Update a Category",
                 "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateCategory"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "username",
-                        "name": "username",
+                        "description": "category ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/controller.profileResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -615,49 +741,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/guest/users/{username}/threads": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get the user threads",
+                "description": "This endpoint is used to delete a category by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "categories"
                 ],
-                "summary": "Get User Threads",
+                "summary": "Delete Category by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "username",
-                        "name": "username",
+                        "description": "category ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
                     },
-                    {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit, default 10",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/controller.threadsResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -675,40 +793,86 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
+        "/categories/{id}/threads": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used for user login",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to get the threads of particular category",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "categories"
                 ],
-                "summary": "User Login",
+                "summary": "Get Category Threads",
                 "parameters": [
                     {
-                        "description": "user credentials",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.Login"
-                        }
+                        "type": "string",
+                        "description": "category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: newest, liked, viewed, commented, trending, default newest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads without comments, default false",
+                        "name": "unanswered",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads followed by current user, default false",
+                        "name": "followed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.loginResponse"
+                            "$ref": "#/definitions/controller.threadsResponse"
                         }
                     },
                     "400": {
@@ -717,12 +881,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -738,17 +896,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
+        "/email-verification": {
             "post": {
                 "security": [
-                    {
-                        "ApiKey": []
-                    },
                     {
                         "ApiKey": []
                     }
                 ],
-                "description": "This endpoint is used for user register.",
+                "description": "This endpoint is used to send the verification link to the given email, the link expires in 24 hours and can only be used once.\nThe response is the same whether the email is registered or not.",
                 "consumes": [
                     "application/json"
                 ],
@@ -756,26 +911,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "register"
+                    "email-verification"
                 ],
-                "summary": "User Register",
+                "summary": "Request Email Verification",
                 "parameters": [
                     {
-                        "description": "register payload",
+                        "description": "email payload",
                         "name": "default",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/payload.Register"
+                            "$ref": "#/definitions/payload.EmailRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/controller.registerResponse"
-                        }
+                    "204": {
+                        "description": ""
                     },
                     "400": {
                         "description": "Bad Request",
@@ -792,49 +944,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/reports": {
-            "get": {
+        "/email-verification/confirm": {
+            "post": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get all report",
+                "description": "This endpoint is used to verify the email with the token from the verification link.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reports"
+                    "email-verification"
                 ],
-                "summary": "Get Reports",
+                "summary": "Verify Email",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "options: review, accepted, default review",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit, default 20",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "verification token",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.VerifyEmail"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/controller.reportsResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -844,8 +996,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/feed": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
@@ -854,33 +1008,33 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to create a report",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to get the newest threads of followed users, comments on followed threads and threads in the categories the current user posts in",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reports"
+                    "feed"
                 ],
-                "summary": "Create a Report",
+                "summary": "Get Feed",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.CreateReport"
-                        }
+                        "type": "string",
+                        "description": "nextCursor of the previous page, default empty string (newest activity)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.createThreadResponse"
+                            "$ref": "#/definitions/controller.feedResponse"
                         }
                     },
                     "400": {
@@ -889,18 +1043,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -910,14 +1052,11 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads": {
+        "/guest/threads": {
             "get": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
                 "description": "This endpoint is used to get all threads",
@@ -925,7 +1064,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "guest"
                 ],
                 "summary": "Get Threads",
                 "parameters": [
@@ -943,9 +1082,45 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "search by keyword, default empty string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full-text search on title, description and comments, supports phrases in double quotes and -exclusion, default empty string",
                         "name": "search",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: newest, liked, viewed, commented, trending, default newest, or relevance when searching",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads without comments, default false",
+                        "name": "unanswered",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "category ID",
+                        "name": "category",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -955,6 +1130,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/controller.threadsResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -962,49 +1143,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/guest/threads/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to create a thread",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to get thread by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "guest"
                 ],
-                "summary": "Create a Thread",
+                "summary": "Get Thread by ID",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.CreateThread"
-                        }
-                    }
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/controller.createThreadResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.threadResponse"
                         }
                     },
                     "401": {
@@ -1028,24 +1197,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads/{id}": {
+        "/guest/threads/{id}/comments": {
             "get": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get thread by ID",
+                "description": "This endpoint is used to get the thread comments",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "guest"
                 ],
-                "summary": "Get Thread by ID",
+                "summary": "Get Thread Comments",
                 "parameters": [
                     {
                         "type": "string",
@@ -1053,19 +1219,43 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: flat, tree, slice, default flat",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum reply depth for tree and slice view, default 0 (unlimited)",
+                        "name": "depth",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.threadResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.commentsResponse"
                         }
                     },
                     "404": {
@@ -1081,65 +1271,66 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/guest/users": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to update a thread",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to get all users",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "guest"
                 ],
-                "summary": "Update a Thread",
+                "summary": "Get Users",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.UpdateThread"
-                        }
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 20",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": ""
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "options: registered_date, ranking, default registered_date",
+                        "name": "order_by",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "options: active, banned, default active",
+                        "name": "status",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
+                    {
+                        "type": "string",
+                        "description": "search by keyword, default empty string",
+                        "name": "keyword",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.profilesResponse"
                         }
                     },
                     "500": {
@@ -1149,41 +1340,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/guest/users/{username}": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to delete a thread by ID",
+                "description": "This endpoint is used to get the another user by username",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "guest"
                 ],
-                "summary": "Delete Thread by ID",
+                "summary": "Get User by Username",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
+                        "description": "username",
+                        "name": "username",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.profileResponse"
                         }
                     },
                     "404": {
@@ -1201,29 +1388,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads/{id}/comments": {
+        "/guest/users/{username}/threads": {
             "get": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get the thread comments",
+                "description": "This endpoint is used to get the user threads",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "guest"
                 ],
-                "summary": "Get Thread Comments",
+                "summary": "Get User Threads",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
+                        "description": "username",
+                        "name": "username",
                         "in": "path",
                         "required": true
                     },
@@ -1235,16 +1419,58 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "limit, default 20",
+                        "description": "limit, default 10",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: newest, liked, viewed, commented, trending, default newest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads without comments, default false",
+                        "name": "unanswered",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "category ID",
+                        "name": "category",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.commentsResponse"
+                            "$ref": "#/definitions/controller.threadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -1260,17 +1486,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/login": {
             "post": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to create a comment of a thread",
+                "description": "This endpoint is used for user login, a banned user gets 403 with the ban expiry and reason",
                 "consumes": [
                     "application/json"
                 ],
@@ -1278,32 +1503,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "login"
                 ],
-                "summary": "Create a Comment",
+                "summary": "User Login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "request body",
+                        "description": "user credentials",
                         "name": "default",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/payload.CreateComment"
+                            "$ref": "#/definitions/payload.Login"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.createThreadResponse"
+                            "$ref": "#/definitions/controller.loginResponse"
                         }
                     },
                     "400": {
@@ -1318,12 +1536,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1333,17 +1563,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads/{id}/follow": {
-            "put": {
+        "/login/refresh": {
+            "post": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to follow/unfollow a thread",
+                "description": "This endpoint is used to get a new access token, the given refresh token is rotated and can't be used again",
                 "consumes": [
                     "application/json"
                 ],
@@ -1351,30 +1578,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "login"
                 ],
-                "summary": "Follow/Unfollow a Thread",
+                "summary": "Refresh Token",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "refresh token",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.RefreshToken"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.loginResponse"
+                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1388,8 +1620,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads/{id}/like": {
-            "put": {
+        "/logout": {
+            "post": {
                 "security": [
                     {
                         "ApiKey": []
@@ -1398,26 +1630,14 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to like/unlike a thread",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used for user logout, the session of the current token is revoked",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
-                ],
-                "summary": "Like/Unlike a Thread",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "logout"
                 ],
+                "summary": "User Logout",
                 "responses": {
                     "204": {
                         "description": ""
@@ -1443,8 +1663,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads/{id}/moderators/add": {
-            "put": {
+        "/notifications": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
@@ -1453,55 +1673,33 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to add a moderator to thread",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to get the notifications of the current user, the newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
+                    "notifications"
                 ],
-                "summary": "Add a Moderator to Thread",
+                "summary": "Get Notifications",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.AddRemoveModerator"
-                        }
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.notificationsResponse"
                         }
                     },
                     "500": {
@@ -1513,7 +1711,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/threads/{id}/moderators/remove": {
+        "/notifications/read": {
             "put": {
                 "security": [
                     {
@@ -1523,57 +1721,18 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to remove a moderator from thread",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This endpoint is used to mark all notifications of the current user as read",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "threads"
-                ],
-                "summary": "Remove a Moderator from Thread",
-                "parameters": [
-                    {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.AddRemoveModerator"
-                        }
-                    },
-                    {
-                        "type": "string",
-                        "description": "thread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "notifications"
                 ],
+                "summary": "Mark All Notifications as Read",
                 "responses": {
                     "204": {
                         "description": ""
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1583,7 +1742,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/notifications/unread": {
             "get": {
                 "security": [
                     {
@@ -1593,51 +1752,19 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get all users",
+                "description": "This endpoint is used to get the total of unread notifications of the current user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Get Users",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit, default 20",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "options: registered_date, ranking, default registered_date",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "options: active, banned, default active",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "search by keyword, default empty string",
-                        "name": "keyword",
-                        "in": "query"
-                    }
+                    "notifications"
                 ],
+                "summary": "Get Unread Notification Total",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.profilesResponse"
+                            "$ref": "#/definitions/controller.unreadNotificationResponse"
                         }
                     },
                     "500": {
@@ -1649,8 +1776,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "get": {
+        "/notifications/{id}/read": {
+            "put": {
                 "security": [
                     {
                         "ApiKey": []
@@ -1659,26 +1786,26 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get their own user profile",
+                "description": "This endpoint is used to mark a notification of the current user as read",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "notifications"
+                ],
+                "summary": "Mark a Notification as Read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Own Profile",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/controller.profileResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
+                    "204": {
+                        "description": ""
                     },
                     "404": {
                         "description": "Not Found",
@@ -1695,42 +1822,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{username}": {
-            "get": {
+        "/password-reset": {
+            "post": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get the another user by username",
+                "description": "This endpoint is used to send the password reset link to the given email, the link expires in 1 hour and can only be used once.\nThe response is the same whether the email is registered or not.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "password-reset"
                 ],
-                "summary": "Get User by Username",
+                "summary": "Request Password Reset",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
+                        "description": "email payload",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.EmailRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/controller.profileResponse"
-                        }
+                    "204": {
+                        "description": ""
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1744,17 +1870,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{username}/banned": {
-            "put": {
+        "/password-reset/confirm": {
+            "post": {
                 "security": [
                     {
                         "ApiKey": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to banned/unbanned a user",
+                "description": "This endpoint is used to set a new password with the token from the password reset link, every session of the user is signed out.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1762,18 +1885,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "password-reset"
                 ],
-                "summary": "Banned/Unbanned a User",
+                "summary": "Reset Password",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                        "description": "reset password payload",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.ResetPassword"
+                        }
+                    }
+                ],
                 "responses": {
                     "204": {
                         "description": ""
@@ -1790,14 +1915,56 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKey": []
                     },
-                    "404": {
-                        "description": "Not Found",
+                    {
+                        "ApiKey": []
+                    }
+                ],
+                "description": "This endpoint is used for user register.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "register"
+                ],
+                "summary": "User Register",
+                "parameters": [
+                    {
+                        "description": "register payload",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.Register"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.registerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1811,8 +1978,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{username}/follow": {
-            "put": {
+        "/reports": {
+            "get": {
                 "security": [
                     {
                         "ApiKey": []
@@ -1821,7 +1988,59 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to follow/unfollow a user",
+                "description": "This endpoint is used to get all report",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get Reports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "options: review, accepted, rejected, default review",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 20",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.reportsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to create a report",
                 "consumes": [
                     "application/json"
                 ],
@@ -1829,21 +2048,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "reports"
                 ],
-                "summary": "Follow/Unfollow a User",
+                "summary": "Create a Report",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateReport"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createThreadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1866,8 +2096,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{username}/threads": {
-            "get": {
+        "/reports/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "ApiKey": []
@@ -1876,40 +2106,55 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint is used to get the user threads",
+                "description": "This endpoint is used to accept or reject a report, accepting a report will banned the reported user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "reports"
                 ],
-                "summary": "Get User Threads",
+                "summary": "Accept/Reject a Report",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "username",
-                        "name": "username",
+                        "description": "report ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "page, default 1",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit, default 10",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateReportStatus"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/controller.threadsResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -1926,510 +2171,3637 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controller.categoriesData": {
-            "type": "object",
-            "properties": {
-                "categories": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.Category"
-                    },
-                    "x-order": "0"
-                }
-            }
         },
-        "controller.categoriesResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
-                    "type": "string",
-                    "x-order": "0"
-                },
-                "message": {
-                    "type": "string",
-                    "x-order": "1"
-                },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.categoriesData"
+        "/stream": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to receive the realtime events as Server-Sent Events. The notifications of the current user are always streamed.\nEvent types: comment.created and thread.counter for the subscribed threads, user.counter for the subscribed users, notification.created for the current user.\nEvery event data is a JSON object with topic, type and data fields.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Stream Realtime Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma separated thread IDs to subscribe to",
+                        "name": "threads",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated user IDs to subscribe to",
+                        "name": "users",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
                 }
             }
         },
-        "controller.commentsInfoWrapper": {
-            "type": "object",
+        "/threads": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get all threads",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Get Threads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full-text search on title, description and comments, supports phrases in double quotes and -exclusion, default empty string",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: newest, liked, viewed, commented, trending, default newest, or relevance when searching",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads without comments, default false",
+                        "name": "unanswered",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads followed by current user, default false",
+                        "name": "followed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "category ID",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.threadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to create a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Create a Thread",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateThread"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createThreadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get thread by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Get Thread by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.threadResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to update a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Update a Thread",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateThread"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to delete a thread by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Delete Thread by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get the thread comments",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Get Thread Comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: flat, tree, slice, default flat",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum reply depth for tree and slice view, default 0 (unlimited)",
+                        "name": "depth",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.commentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to create a comment of a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Create a Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateComment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createThreadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/comments/{commentID}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to update a comment of a thread, only the author can update the comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Update a Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateComment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to delete a comment of a thread, the comment can be deleted by the author, the thread moderators, and the admin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Delete a Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/comments/{commentID}/pin": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to pin a root comment to the top of the thread comments, it replaces the previously pinned comment. Only the thread creator, the thread moderators, and the staff can pin a comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Pin a Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to unpin the pinned comment of a thread. Only the thread creator, the thread moderators, and the staff can unpin a comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Unpin a Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/comments/{commentID}/replies": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to reply a comment of a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Reply a Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateComment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createThreadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/follow": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to follow/unfollow a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Follow/Unfollow a Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/like": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to like/unlike a thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Like/Unlike a Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/lock": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to lock a thread, no one can comment on a locked thread. Only the thread creator, the thread moderators, and the staff can lock the thread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Lock a Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to unlock a thread. Only the thread creator, the thread moderators, and the staff can unlock the thread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Unlock a Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/moderation-logs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get the lock, pin and mute actions taken in a thread, newest first. Only the thread creator, the thread moderators, and the staff can see the logs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Get Thread Moderation Logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.moderationLogsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/moderators/add": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to add a moderator to thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Add a Moderator to Thread",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.AddRemoveModerator"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/moderators/remove": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to remove a moderator from thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Remove a Moderator from Thread",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.AddRemoveModerator"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/mutes": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to mute a user in a thread for the given hours, a muted user can't comment on the thread. Only the thread creator, the thread moderators, and the staff can mute a user, they can't be muted themselves",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Mute a User in Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.MuteUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/threads/{id}/mutes/{username}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to lift the active mute of a user in a thread. Only the thread creator, the thread moderators, and the staff can unmute a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "threads"
+                ],
+                "summary": "Unmute a User in Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "thread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get all users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get Users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 20",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: registered_date, ranking, default registered_date",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: active, banned, default active",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by keyword, default empty string",
+                        "name": "keyword",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.profilesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get their own user profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get Own Profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.profileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to update their own user profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update Own Profile",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateProfile"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.profileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to delete their own account. The profile is anonymized and the likes, follows and sessions are removed, while the threads and comments are kept under a placeholder name so the discussions stay intact. It can't be undone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete Own Account",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.DeleteAccount"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to download their own profile, threads, comments, likes and follows as a JSON archive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Export Own Data",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PersonalData"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/password": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to change their own password. Every session is signed out, the returned tokens belong to a new session of the current device.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Change Own Password",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.ChangePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.loginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get the another user by username",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get User by Username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.profileResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/banned": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to ban a user with an optional reason, for the given days or permanently when the days is 0\nThe body is optional, without it the user is banned permanently.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Ban a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/payload.BanUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to lift the active ban of a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unban a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/bans": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get the ban history of a user, the newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get Ban History of a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.bansResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/follow": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to follow/unfollow a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Follow/Unfollow a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/threads": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint is used to get the user threads",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get User Threads",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, default 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, default 10",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor or prevCursor of a previous response, switches to cursor pagination and ignores page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "options: newest, liked, viewed, commented, trending, default newest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads without comments, default false",
+                        "name": "unanswered",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only threads followed by current user, default false",
+                        "name": "followed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created on or after the date, format 2006-01-02",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only threads created before the date, format 2006-01-02",
+                        "name": "createdBefore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "category ID",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.threadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.auditLogsInfoWrapper": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AuditLog"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.auditLogsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.auditLogsInfoWrapper"
+                }
+            }
+        },
+        "controller.bansResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Ban"
+                    },
+                    "x-order": "2"
+                }
+            }
+        },
+        "controller.categoriesData": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Category"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "controller.categoriesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.categoriesData"
+                }
+            }
+        },
+        "controller.commentsInfoWrapper": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Comment"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.commentsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.commentsInfoWrapper"
+                }
+            }
+        },
+        "controller.createThreadResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.feedResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.Feed"
+                }
+            }
+        },
+        "controller.idData": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.infoResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.DashboardInfo"
+                }
+            }
+        },
+        "controller.lockoutsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Lockout"
+                    },
+                    "x-order": "2"
+                }
+            }
+        },
+        "controller.loginResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.Login"
+                }
+            }
+        },
+        "controller.moderationLogsInfoWrapper": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ModerationLog"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.moderationLogsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.moderationLogsInfoWrapper"
+                }
+            }
+        },
+        "controller.notificationsInfoWrapper": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Notification"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.notificationsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.notificationsInfoWrapper"
+                }
+            }
+        },
+        "controller.pageInfoData": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "x-order": "0"
+                },
+                "page": {
+                    "type": "integer",
+                    "x-order": "1"
+                },
+                "pageTotal": {
+                    "type": "integer",
+                    "x-order": "2"
+                },
+                "total": {
+                    "type": "integer",
+                    "x-order": "3"
+                }
+            }
+        },
+        "controller.profileResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.User"
+                }
+            }
+        },
+        "controller.profilesInfoWrapper": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.User"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.profilesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.profilesInfoWrapper"
+                }
+            }
+        },
+        "controller.registerResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.userIDData"
+                }
+            }
+        },
+        "controller.reportsData": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Report"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.reportsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.reportsData"
+                }
+            }
+        },
+        "controller.rolesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Role"
+                    },
+                    "x-order": "2"
+                }
+            }
+        },
+        "controller.statsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.Stats"
+                }
+            }
+        },
+        "controller.threadResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.Thread"
+                }
+            }
+        },
+        "controller.threadsInfoWrapper": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ManyThread"
+                    },
+                    "x-order": "0"
+                },
+                "pageInfo": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/controller.pageInfoData"
+                }
+            }
+        },
+        "controller.threadsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.threadsInfoWrapper"
+                }
+            }
+        },
+        "controller.unreadNotificationResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.UnreadNotification"
+                }
+            }
+        },
+        "controller.userIDData": {
+            "type": "object",
+            "properties": {
+                "userID": {
+                    "type": "string"
+                }
+            }
+        },
+        "echo.HTTPError": {
+            "type": "object",
+            "properties": {
+                "message": {}
+            }
+        },
+        "payload.AddRemoveModerator": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.BanUser": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "0"
+                },
+                "days": {
+                    "description": "Days of the ban, 0 means the ban is permanent",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "x-order": "1"
+                }
+            }
+        },
+        "payload.ChangePassword": {
+            "type": "object",
+            "properties": {
+                "oldPassword": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 8,
+                    "x-order": "0"
+                },
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 8,
+                    "x-order": "1"
+                },
+                "device": {
+                    "description": "Device is optional, the User-Agent header is used when it's empty",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "2"
+                }
+            }
+        },
+        "payload.CreateCategory": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 2,
+                    "x-order": "1"
+                }
+            }
+        },
+        "payload.CreateComment": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Comment is at most 1000 characters, so the comment event fits in a PostgreSQL NOTIFY payload",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.CreateReport": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "commentID": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "reason": {
+                    "type": "string",
+                    "minLength": 2,
+                    "x-order": "2"
+                }
+            }
+        },
+        "payload.CreateThread": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "categoryID": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 4,
+                    "x-order": "2"
+                }
+            }
+        },
+        "payload.DeleteAccount": {
+            "type": "object",
             "properties": {
-                "list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.Comment"
-                    },
+                "password": {
+                    "description": "Password confirms the deletion, it can't be undone",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 8,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.EmailRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 5,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.GrantRole": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "description": "Role, available options: admin, global_moderator, user",
+                    "type": "string",
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.Login": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
                     "x-order": "0"
                 },
-                "pageInfo": {
-                    "x-order": "1",
-                    "$ref": "#/definitions/controller.pageInfoData"
+                "password": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 8,
+                    "x-order": "1"
+                },
+                "device": {
+                    "description": "Device is optional, the User-Agent header is used when it's empty",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "2"
                 }
             }
         },
-        "controller.commentsResponse": {
+        "payload.MuteUser": {
             "type": "object",
             "properties": {
-                "status": {
+                "username": {
                     "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
                     "x-order": "0"
                 },
-                "message": {
+                "reason": {
                     "type": "string",
+                    "maxLength": 255,
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.commentsInfoWrapper"
+                "hours": {
+                    "description": "Hours of the mute",
+                    "type": "integer",
+                    "maximum": 8760,
+                    "minimum": 1,
+                    "x-order": "2"
                 }
             }
         },
-        "controller.createThreadResponse": {
+        "payload.RefreshToken": {
             "type": "object",
             "properties": {
-                "status": {
+                "refreshToken": {
+                    "type": "string",
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.Register": {
+            "type": "object",
+            "properties": {
+                "username": {
                     "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
                     "x-order": "0"
                 },
-                "message": {
+                "email": {
                     "type": "string",
+                    "maxLength": 50,
+                    "minLength": 5,
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.idData"
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "x-order": "2"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 8,
+                    "x-order": "3"
                 }
             }
         },
-        "controller.idData": {
+        "payload.ResetPassword": {
             "type": "object",
             "properties": {
-                "ID": {
-                    "type": "string"
+                "token": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 8,
+                    "x-order": "1"
                 }
             }
         },
-        "controller.infoResponse": {
+        "payload.UpdateCategory": {
             "type": "object",
             "properties": {
-                "status": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 2,
+                    "x-order": "1"
+                }
+            }
+        },
+        "payload.UpdateComment": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Comment is at most 1000 characters, so the comment event fits in a PostgreSQL NOTIFY payload",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.UpdateProfile": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string",
+                    "maxLength": 50,
+                    "minLength": 5,
                     "x-order": "0"
                 },
-                "message": {
+                "name": {
                     "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/response.DashboardInfo"
+                "bio": {
+                    "description": "Bio, AvatarURL and Location are optional, an empty value clears them",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "2"
+                },
+                "avatarURL": {
+                    "description": "AvatarURL must be an absolute http or https URL",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "3"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "x-order": "4"
                 }
             }
         },
-        "controller.loginResponse": {
+        "payload.UpdateReportStatus": {
             "type": "object",
             "properties": {
                 "status": {
+                    "description": "Status, available options: rejected, accepted",
+                    "type": "string",
+                    "maxLength": 9,
+                    "minLength": 5,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.UpdateThread": {
+            "type": "object",
+            "properties": {
+                "title": {
                     "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
                     "x-order": "0"
                 },
-                "message": {
+                "description": {
                     "type": "string",
+                    "minLength": 2,
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/response.Login"
+                "categoryID": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 4,
+                    "x-order": "2"
                 }
             }
         },
-        "controller.pageInfoData": {
+        "payload.VerifyEmail": {
             "type": "object",
             "properties": {
-                "limit": {
-                    "type": "integer",
+                "token": {
+                    "type": "string",
+                    "x-order": "0"
+                }
+            }
+        },
+        "response.AuditLog": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "type": "string",
                     "x-order": "0"
                 },
-                "page": {
-                    "type": "integer",
+                "actorID": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "actorUsername": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "action": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "targetType": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "targetID": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "before": {
+                    "description": "Before is the state of the target before the action, null if the action created it",
+                    "type": "object",
+                    "x-order": "6"
+                },
+                "after": {
+                    "description": "After is the state of the target after the action, null if the action deleted it",
+                    "type": "object",
+                    "x-order": "7"
+                },
+                "ipAddress": {
+                    "type": "string",
+                    "x-order": "8"
+                },
+                "createdOn": {
+                    "description": "CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "9"
+                }
+            }
+        },
+        "response.Ban": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "reason": {
+                    "type": "string",
                     "x-order": "1"
                 },
-                "pageTotal": {
-                    "type": "integer",
-                    "x-order": "2"
+                "issuerUsername": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "isPermanent": {
+                    "type": "boolean",
+                    "x-order": "3"
+                },
+                "expiresOn": {
+                    "description": "ExpiresOn layout format: time.RFC822 (02 Jan 06 15:04 MST), empty when the ban is permanent",
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "x-order": "5"
+                },
+                "liftedOn": {
+                    "description": "LiftedOn layout format: time.RFC822 (02 Jan 06 15:04 MST), empty when the ban is active",
+                    "type": "string",
+                    "x-order": "6"
                 },
-                "total": {
-                    "type": "integer",
-                    "x-order": "3"
+                "lifterUsername": {
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "bannedOn": {
+                    "description": "BannedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "8"
                 }
             }
         },
-        "controller.profileResponse": {
+        "response.Category": {
             "type": "object",
             "properties": {
-                "status": {
+                "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "name": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/response.User"
-                }
-            }
-        },
-        "controller.profilesInfoWrapper": {
-            "type": "object",
-            "properties": {
-                "list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.User"
-                    },
-                    "x-order": "0"
+                "description": {
+                    "type": "string",
+                    "x-order": "2"
                 },
-                "pageInfo": {
-                    "x-order": "1",
-                    "$ref": "#/definitions/controller.pageInfoData"
+                "createdOn": {
+                    "description": "CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "3"
                 }
             }
         },
-        "controller.profilesResponse": {
+        "response.CategoryStats": {
             "type": "object",
             "properties": {
-                "status": {
+                "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "name": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.profilesInfoWrapper"
+                "newThread": {
+                    "type": "integer",
+                    "x-order": "2"
                 }
             }
         },
-        "controller.registerResponse": {
+        "response.Comment": {
             "type": "object",
             "properties": {
-                "status": {
+                "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "userID": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.userIDData"
-                }
-            }
-        },
-        "controller.reportsData": {
-            "type": "object",
-            "properties": {
-                "list": {
+                "replies": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.Report"
+                        "$ref": "#/definitions/response.Comment"
                     },
-                    "x-order": "0"
+                    "x-order": "10"
                 },
-                "pageInfo": {
-                    "x-order": "1",
-                    "$ref": "#/definitions/controller.pageInfoData"
-                }
-            }
-        },
-        "controller.reportsResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
+                "isPinned": {
+                    "description": "IsPinned comment is listed on top of the first page, in addition to the limit",
+                    "type": "boolean",
+                    "x-order": "11"
+                },
+                "username": {
                     "type": "string",
-                    "x-order": "0"
+                    "x-order": "2"
                 },
-                "message": {
+                "name": {
                     "type": "string",
-                    "x-order": "1"
+                    "x-order": "3"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.reportsData"
+                "comment": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "publishedOn": {
+                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "isEdited": {
+                    "type": "boolean",
+                    "x-order": "6"
+                },
+                "editedOn": {
+                    "description": "EditedOn layout format: time.RFC822 (02 Jan 06 15:04 MST), empty if the comment has never been edited",
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "isDeleted": {
+                    "description": "IsDeleted comments are tombstones, their content and author are hidden",
+                    "type": "boolean",
+                    "x-order": "8"
+                },
+                "parentID": {
+                    "description": "ParentID is empty for the root comments",
+                    "type": "string",
+                    "x-order": "9"
                 }
             }
         },
-        "controller.threadResponse": {
+        "response.DashboardInfo": {
             "type": "object",
             "properties": {
-                "status": {
-                    "type": "string",
+                "totalUser": {
+                    "type": "integer",
                     "x-order": "0"
                 },
-                "message": {
-                    "type": "string",
+                "totalThread": {
+                    "type": "integer",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/response.Thread"
+                "totalModerator": {
+                    "type": "integer",
+                    "x-order": "2"
+                },
+                "totalReport": {
+                    "type": "integer",
+                    "x-order": "3"
                 }
             }
         },
-        "controller.threadsInfoWrapper": {
+        "response.Feed": {
             "type": "object",
             "properties": {
                 "list": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.ManyThread"
+                        "$ref": "#/definitions/response.FeedItem"
                     },
                     "x-order": "0"
                 },
-                "pageInfo": {
-                    "x-order": "1",
-                    "$ref": "#/definitions/controller.pageInfoData"
+                "nextCursor": {
+                    "description": "NextCursor is passed as the cursor query param to get the next page, empty if there is no more item",
+                    "type": "string",
+                    "x-order": "1"
                 }
             }
         },
-        "controller.threadsResponse": {
+        "response.FeedComment": {
             "type": "object",
             "properties": {
-                "status": {
+                "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "userID": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.threadsInfoWrapper"
-                }
-            }
-        },
-        "controller.userIDData": {
-            "type": "object",
-            "properties": {
-                "userID": {
-                    "type": "string"
-                }
-            }
-        },
-        "echo.HTTPError": {
-            "type": "object",
-            "properties": {
-                "message": {}
-            }
-        },
-        "payload.AddRemoveModerator": {
-            "type": "object",
-            "properties": {
                 "username": {
                     "type": "string",
-                    "maxLength": 20,
-                    "minLength": 2,
-                    "x-order": "0"
-                }
-            }
-        },
-        "payload.CreateCategory": {
-            "type": "object",
-            "properties": {
+                    "x-order": "2"
+                },
                 "name": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
-                    "x-order": "0"
+                    "x-order": "3"
                 },
-                "description": {
-                    "type": "string",
-                    "minLength": 2,
-                    "x-order": "1"
-                }
-            }
-        },
-        "payload.CreateComment": {
-            "type": "object",
-            "properties": {
                 "comment": {
                     "type": "string",
-                    "minLength": 1,
-                    "x-order": "0"
+                    "x-order": "4"
+                },
+                "parentID": {
+                    "description": "ParentID is empty for the root comments",
+                    "type": "string",
+                    "x-order": "5"
                 }
             }
         },
-        "payload.CreateReport": {
+        "response.FeedItem": {
             "type": "object",
             "properties": {
-                "username": {
+                "type": {
+                    "description": "Type, available options: followed_user_thread, followed_thread_comment, category_thread",
                     "type": "string",
-                    "maxLength": 10,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "commentID": {
+                "ID": {
                     "type": "string",
-                    "maxLength": 10,
-                    "minLength": 2,
                     "x-order": "1"
                 },
-                "reason": {
+                "publishedOn": {
+                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
-                    "minLength": 2,
                     "x-order": "2"
+                },
+                "thread": {
+                    "x-order": "3",
+                    "$ref": "#/definitions/response.FeedThread"
+                },
+                "comment": {
+                    "description": "Comment is only present for the followed_thread_comment items",
+                    "x-order": "4",
+                    "$ref": "#/definitions/response.FeedComment"
                 }
             }
         },
-        "payload.CreateThread": {
+        "response.FeedThread": {
             "type": "object",
             "properties": {
-                "title": {
+                "ID": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "description": {
+                "title": {
                     "type": "string",
-                    "minLength": 2,
                     "x-order": "1"
                 },
-                "categoryID": {
+                "description": {
                     "type": "string",
-                    "maxLength": 6,
-                    "minLength": 4,
                     "x-order": "2"
-                }
-            }
-        },
-        "payload.Login": {
-            "type": "object",
-            "properties": {
-                "username": {
+                },
+                "categoryID": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "categoryName": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "creatorID": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "creatorUsername": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "creatorName": {
                     "type": "string",
-                    "maxLength": 20,
-                    "minLength": 2,
-                    "x-order": "0"
+                    "x-order": "7"
                 },
-                "password": {
+                "publishedOn": {
+                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
-                    "maxLength": 20,
-                    "minLength": 8,
-                    "x-order": "1"
+                    "x-order": "8"
                 }
             }
         },
-        "payload.Register": {
+        "response.Lockout": {
             "type": "object",
             "properties": {
-                "username": {
+                "kind": {
+                    "description": "Kind, available options: username, ip",
                     "type": "string",
-                    "maxLength": 20,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "email": {
+                "value": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 5,
                     "x-order": "1"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 1,
+                "failedAttempts": {
+                    "type": "integer",
                     "x-order": "2"
                 },
-                "password": {
+                "lastFailedOn": {
+                    "description": "LastFailedOn and LockedUntil layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
-                    "maxLength": 20,
-                    "minLength": 8,
                     "x-order": "3"
+                },
+                "lockedUntil": {
+                    "type": "string",
+                    "x-order": "4"
                 }
             }
         },
-        "payload.UpdateCategory": {
+        "response.Login": {
             "type": "object",
             "properties": {
-                "name": {
+                "token": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "description": {
+                "refreshToken": {
                     "type": "string",
-                    "minLength": 2,
                     "x-order": "1"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2"
                 }
             }
         },
-        "payload.UpdateThread": {
+        "response.ManyThread": {
             "type": "object",
             "properties": {
-                "title": {
+                "ID": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "description": {
+                "title": {
                     "type": "string",
-                    "minLength": 2,
                     "x-order": "1"
                 },
+                "totalFollower": {
+                    "type": "integer",
+                    "x-order": "10"
+                },
+                "totalComment": {
+                    "type": "integer",
+                    "x-order": "11"
+                },
+                "creatorID": {
+                    "type": "string",
+                    "x-order": "12"
+                },
+                "creatorUsername": {
+                    "type": "string",
+                    "x-order": "13"
+                },
+                "creatorName": {
+                    "type": "string",
+                    "x-order": "14"
+                },
+                "highlight": {
+                    "description": "Highlight is only present when searching",
+                    "x-order": "15",
+                    "$ref": "#/definitions/response.ThreadHighlight"
+                },
                 "categoryID": {
                     "type": "string",
-                    "maxLength": 6,
-                    "minLength": 4,
                     "x-order": "2"
+                },
+                "categoryName": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "publishedOn": {
+                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "isLiked": {
+                    "type": "boolean",
+                    "x-order": "5"
+                },
+                "isFollowed": {
+                    "type": "boolean",
+                    "x-order": "6"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "totalViewer": {
+                    "type": "integer",
+                    "x-order": "8"
+                },
+                "totalLike": {
+                    "type": "integer",
+                    "x-order": "9"
                 }
             }
         },
-        "response.Category": {
+        "response.ModerationLog": {
             "type": "object",
             "properties": {
                 "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "name": {
+                "action": {
+                    "description": "Action is one of lock, unlock, pin, unpin, mute and unmute",
                     "type": "string",
                     "x-order": "1"
                 },
-                "description": {
+                "actorID": {
                     "type": "string",
                     "x-order": "2"
                 },
+                "actorUsername": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "targetUserID": {
+                    "description": "TargetUserID and TargetUsername are only present for mute and unmute",
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "targetUsername": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "commentID": {
+                    "description": "CommentID is only present for pin and unpin",
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "reason": {
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "expiresOn": {
+                    "description": "ExpiresOn layout format: time.RFC822 (02 Jan 06 15:04 MST), only present for mute",
+                    "type": "string",
+                    "x-order": "8"
+                },
                 "createdOn": {
                     "description": "CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
-                    "x-order": "3"
+                    "x-order": "9"
                 }
             }
         },
-        "response.Comment": {
+        "response.Moderator": {
             "type": "object",
             "properties": {
-                "ID": {
+                "moderatorID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "userID": {
+                "userId": {
                     "type": "string",
                     "x-order": "1"
                 },
@@ -2437,156 +5809,287 @@ const docTemplate = `{
                     "type": "string",
                     "x-order": "2"
                 },
-                "name": {
+                "email": {
                     "type": "string",
                     "x-order": "3"
                 },
-                "comment": {
+                "name": {
                     "type": "string",
                     "x-order": "4"
                 },
-                "publishedOn": {
-                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "role": {
                     "type": "string",
                     "x-order": "5"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "x-order": "6"
+                },
+                "registeredOn": {
+                    "description": "RegisteredOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "7"
                 }
             }
         },
-        "response.DashboardInfo": {
+        "response.Notification": {
             "type": "object",
             "properties": {
-                "totalUser": {
-                    "type": "integer",
+                "ID": {
+                    "type": "string",
                     "x-order": "0"
                 },
-                "totalThread": {
-                    "type": "integer",
+                "type": {
+                    "description": "Type, available options: comment, follow, like, moderator",
+                    "type": "string",
                     "x-order": "1"
                 },
-                "totalModerator": {
-                    "type": "integer",
+                "actorID": {
+                    "type": "string",
                     "x-order": "2"
                 },
-                "totalReport": {
-                    "type": "integer",
+                "actorUsername": {
+                    "type": "string",
                     "x-order": "3"
+                },
+                "actorName": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "threadID": {
+                    "description": "ThreadID and ThreadTitle are empty for the follow notifications",
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "threadTitle": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "commentID": {
+                    "description": "CommentID is only filled for the comment notifications",
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "isRead": {
+                    "type": "boolean",
+                    "x-order": "8"
+                },
+                "createdOn": {
+                    "description": "CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "9"
                 }
             }
         },
-        "response.Login": {
+        "response.PersonalComment": {
             "type": "object",
             "properties": {
-                "token": {
+                "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "role": {
+                "threadID": {
                     "type": "string",
                     "x-order": "1"
+                },
+                "threadTitle": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "parentID": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "comment": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "publishedAt": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "editedAt": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "deletedAt": {
+                    "type": "string",
+                    "x-order": "7"
                 }
             }
         },
-        "response.ManyThread": {
+        "response.PersonalData": {
             "type": "object",
             "properties": {
-                "ID": {
+                "exportedAt": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "title": {
-                    "type": "string",
-                    "x-order": "1"
+                "profile": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/response.PersonalProfile"
                 },
-                "totalFollower": {
-                    "type": "integer",
-                    "x-order": "10"
+                "threads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PersonalThread"
+                    },
+                    "x-order": "2"
                 },
-                "totalComment": {
-                    "type": "integer",
-                    "x-order": "11"
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PersonalComment"
+                    },
+                    "x-order": "3"
+                },
+                "likes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PersonalThreadEntry"
+                    },
+                    "x-order": "4"
+                },
+                "following": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PersonalUserEntry"
+                    },
+                    "x-order": "5"
+                },
+                "followers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PersonalUserEntry"
+                    },
+                    "x-order": "6"
+                },
+                "followedThreads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PersonalThreadEntry"
+                    },
+                    "x-order": "7"
+                }
+            }
+        },
+        "response.PersonalProfile": {
+            "type": "object",
+            "properties": {
+                "userID": {
+                    "type": "string",
+                    "x-order": "0"
                 },
-                "creatorID": {
+                "username": {
                     "type": "string",
-                    "x-order": "12"
+                    "x-order": "1"
                 },
-                "creatorUsername": {
+                "registeredAt": {
                     "type": "string",
-                    "x-order": "13"
+                    "x-order": "10"
                 },
-                "creatorName": {
+                "updatedAt": {
                     "type": "string",
-                    "x-order": "14"
+                    "x-order": "11"
                 },
-                "categoryID": {
+                "email": {
                     "type": "string",
                     "x-order": "2"
                 },
-                "categoryName": {
+                "name": {
                     "type": "string",
                     "x-order": "3"
                 },
-                "publishedOn": {
-                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "bio": {
                     "type": "string",
                     "x-order": "4"
                 },
-                "isLiked": {
-                    "type": "boolean",
+                "avatarURL": {
+                    "type": "string",
                     "x-order": "5"
                 },
-                "isFollowed": {
-                    "type": "boolean",
+                "location": {
+                    "type": "string",
                     "x-order": "6"
                 },
-                "description": {
+                "role": {
                     "type": "string",
                     "x-order": "7"
                 },
-                "totalViewer": {
-                    "type": "integer",
+                "isActive": {
+                    "type": "boolean",
                     "x-order": "8"
                 },
-                "totalLike": {
-                    "type": "integer",
+                "isVerified": {
+                    "type": "boolean",
                     "x-order": "9"
                 }
             }
         },
-        "response.Moderator": {
+        "response.PersonalThread": {
             "type": "object",
             "properties": {
-                "moderatorID": {
+                "ID": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "userId": {
+                "title": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "username": {
+                "description": {
                     "type": "string",
                     "x-order": "2"
                 },
-                "email": {
+                "categoryID": {
                     "type": "string",
                     "x-order": "3"
                 },
-                "name": {
+                "categoryName": {
                     "type": "string",
                     "x-order": "4"
                 },
-                "role": {
+                "publishedAt": {
                     "type": "string",
                     "x-order": "5"
                 },
-                "isActive": {
-                    "type": "boolean",
+                "updatedAt": {
+                    "type": "string",
                     "x-order": "6"
+                }
+            }
+        },
+        "response.PersonalThreadEntry": {
+            "type": "object",
+            "properties": {
+                "threadID": {
+                    "type": "string",
+                    "x-order": "0"
                 },
-                "registeredOn": {
-                    "description": "RegisteredOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "threadTitle": {
                     "type": "string",
-                    "x-order": "7"
+                    "x-order": "1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "x-order": "2"
+                }
+            }
+        },
+        "response.PersonalUserEntry": {
+            "type": "object",
+            "properties": {
+                "userID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "username": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "x-order": "2"
                 }
             }
         },
@@ -2653,6 +6156,99 @@ const docTemplate = `{
                 }
             }
         },
+        "response.Role": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "1"
+                }
+            }
+        },
+        "response.Stats": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From and To layout format: 2006-01-02, To is inclusive",
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "to": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "interval": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "series": {
+                    "description": "Series is ordered by the start of the bucket, buckets without activity are included",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.StatsBucket"
+                    },
+                    "x-order": "3"
+                },
+                "topCategories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CategoryStats"
+                    },
+                    "x-order": "4"
+                },
+                "topThreads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ThreadStats"
+                    },
+                    "x-order": "5"
+                },
+                "mostActiveUsers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.UserStats"
+                    },
+                    "x-order": "6"
+                }
+            }
+        },
+        "response.StatsBucket": {
+            "type": "object",
+            "properties": {
+                "start": {
+                    "description": "Start layout format: 2006-01-02, a week starts on Monday",
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "newUser": {
+                    "type": "integer",
+                    "x-order": "1"
+                },
+                "newThread": {
+                    "type": "integer",
+                    "x-order": "2"
+                },
+                "newComment": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "newLike": {
+                    "type": "integer",
+                    "x-order": "4"
+                },
+                "newReport": {
+                    "type": "integer",
+                    "x-order": "5"
+                }
+            }
+        },
         "response.Thread": {
             "type": "object",
             "properties": {
@@ -2688,6 +6284,15 @@ const docTemplate = `{
                     "type": "string",
                     "x-order": "15"
                 },
+                "isLocked": {
+                    "type": "boolean",
+                    "x-order": "16"
+                },
+                "pinnedCommentID": {
+                    "description": "PinnedCommentID is empty if no comment is pinned",
+                    "type": "string",
+                    "x-order": "17"
+                },
                 "categoryID": {
                     "type": "string",
                     "x-order": "2"
@@ -2726,6 +6331,79 @@ const docTemplate = `{
                 }
             }
         },
+        "response.ThreadHighlight": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "commentID": {
+                    "description": "CommentID and Comment are empty if no comment of the thread matches the search",
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "comment": {
+                    "type": "string",
+                    "x-order": "3"
+                }
+            }
+        },
+        "response.ThreadStats": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "title": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "creatorID": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "creatorUsername": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "categoryID": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "categoryName": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "publishedOn": {
+                    "description": "PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "newComment": {
+                    "type": "integer",
+                    "x-order": "7"
+                },
+                "newLike": {
+                    "type": "integer",
+                    "x-order": "8"
+                }
+            }
+        },
+        "response.UnreadNotification": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer",
+                    "x-order": "0"
+                }
+            }
+        },
         "response.User": {
             "type": "object",
             "properties": {
@@ -2741,6 +6419,18 @@ const docTemplate = `{
                     "type": "boolean",
                     "x-order": "10"
                 },
+                "bio": {
+                    "type": "string",
+                    "x-order": "11"
+                },
+                "avatarURL": {
+                    "type": "string",
+                    "x-order": "12"
+                },
+                "location": {
+                    "type": "string",
+                    "x-order": "13"
+                },
                 "email": {
                     "type": "string",
                     "x-order": "2"
@@ -2775,6 +6465,35 @@ const docTemplate = `{
                     "x-order": "9"
                 }
             }
+        },
+        "response.UserStats": {
+            "type": "object",
+            "properties": {
+                "userID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "username": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "newThread": {
+                    "type": "integer",
+                    "x-order": "4"
+                },
+                "newComment": {
+                    "type": "integer",
+                    "x-order": "5"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// AuditLog records an administrative mutation, Before and After hold the JSON state of the target.
+type AuditLog struct {
+	ID         string
+	Actor      User
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     []byte
+	After      []byte
+	IPAddress  string
+	CreatedAt  time.Time
+}
+
+// AuditFilter narrows down the audit log, the zero value fields are ignored.
+type AuditFilter struct {
+	ActorUsername string
+	Action        string
+	TargetType    string
+	TargetID      string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
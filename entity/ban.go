@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// Ban is a record of the ban history of the user, a zero ExpiresAt means the ban is permanent.
+type Ban struct {
+	ID        string
+	User      User
+	Issuer    User
+	Reason    string
+	ExpiresAt time.Time
+	LiftedAt  time.Time
+	Lifter    User
+	CreatedAt time.Time
+}
+
+func (b Ban) IsPermanent() bool {
+	return b.ExpiresAt.IsZero()
+}
@@ -6,7 +6,20 @@ type Comment struct {
 	ID        string
 	User      User
 	Thread    Thread
+	ParentID  string
 	Comment   string
+	IsPinned  bool
+	Replies   []Comment
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	EditedAt  time.Time
+	DeletedAt time.Time
 }
+
+type CommentView int
+
+const (
+	FlatView CommentView = iota
+	TreeView
+	SliceView
+)
@@ -0,0 +1,7 @@
+package entity
+
+// CounterReconciliation is the number of rows whose counter columns were corrected by a reconciliation.
+type CounterReconciliation struct {
+	Threads int64
+	Users   int64
+}
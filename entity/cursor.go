@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Cursor is a position in a listing ordered by the active sort key, then by the creation time and the ID.
+type Cursor struct {
+	Key       int64
+	CreatedAt time.Time
+	ID        string
+	// Backward selects the items before the position instead of the items after it.
+	Backward bool
+}
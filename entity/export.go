@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+// ExportFilter narrows an export to the rows created in [CreatedAfter, CreatedBefore), a zero valued field disables its bound.
+type ExportFilter struct {
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type FeedItemType int
+
+const (
+	// FollowedUserThread is a new thread created by a followed user.
+	FollowedUserThread FeedItemType = iota
+	// FollowedThreadComment is a new comment on a followed thread.
+	FollowedThreadComment
+	// CategoryThread is a new thread in a category the user posts in.
+	CategoryThread
+)
+
+type FeedItem struct {
+	Type FeedItemType
+	// ID is the thread ID for the thread items and the comment ID for the comment items.
+	ID        string
+	Thread    Thread
+	Comment   Comment
+	CreatedAt time.Time
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// LoginAttempt counts the recent failed logins of a username or an IP address.
+type LoginAttempt struct {
+	Kind           LoginAttemptKind
+	Value          string
+	FailedAttempts uint
+	LastFailedAt   time.Time
+	LockedUntil    time.Time
+}
+
+type LoginAttemptKind int
+
+const (
+	UsernameLoginAttempt LoginAttemptKind = iota
+	IPLoginAttempt
+)
@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// ModerationLog records an action taken by a moderator in a thread.
+type ModerationLog struct {
+	ID         string
+	Thread     Thread
+	Actor      User
+	Action     ModerationAction
+	TargetUser User
+	Comment    Comment
+	Reason     string
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+type ModerationAction int
+
+const (
+	LockAction ModerationAction = iota
+	UnlockAction
+	PinAction
+	UnpinAction
+	MuteAction
+	UnmuteAction
+)
+
+// String returns the name of the action, it's also the value stored in the moderation log.
+func (m ModerationAction) String() string {
+	switch m {
+	case UnlockAction:
+		return "unlock"
+	case PinAction:
+		return "pin"
+	case UnpinAction:
+		return "unpin"
+	case MuteAction:
+		return "mute"
+	case UnmuteAction:
+		return "unmute"
+	default:
+		return "lock"
+	}
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type Notification struct {
+	ID string
+	// User is the recipient of the notification.
+	User User
+	// Actor is the user whose action triggered the notification.
+	Actor     User
+	Type      NotificationType
+	Thread    Thread
+	Comment   Comment
+	IsRead    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NotificationType int
+
+const (
+	CommentNotification NotificationType = iota
+	FollowNotification
+	LikeNotification
+	ModeratorNotification
+)
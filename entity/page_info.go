@@ -5,4 +5,6 @@ type PageInfo struct {
 	Page      uint
 	PageTotal uint
 	Total     uint
+	// Cursor switches the listing from page numbers to keyset pagination, the total isn't counted then.
+	Cursor *Cursor
 }
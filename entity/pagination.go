@@ -1,10 +1,12 @@
 package entity
 
 type Entity interface {
-	Thread | User | Comment | Category | UserBanned
+	Thread | User | Comment | Category | UserBanned | Notification | ModerationLog | AuditLog
 }
 
 type Pagination[T Entity] struct {
-	List     []T
-	PageInfo PageInfo
+	List       []T
+	PageInfo   PageInfo
+	NextCursor *Cursor
+	PrevCursor *Cursor
 }
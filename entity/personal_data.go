@@ -0,0 +1,12 @@
+package entity
+
+// PersonalData gathers everything a user created or did in the forum, for the user's own export.
+type PersonalData struct {
+	User            User
+	Threads         []Thread
+	Comments        []Comment
+	Likes           []Like
+	Following       []UserFollow
+	Followers       []UserFollow
+	FollowedThreads []ThreadFollow
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type Session struct {
+	ID                       string
+	User                     User
+	Device                   string
+	RefreshTokenHash         string
+	PreviousRefreshTokenHash string
+	TokenVersion             uint
+	ExpiresAt                time.Time
+	RevokedAt                time.Time
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+type StatsInterval int
+
+const (
+	DailyInterval StatsInterval = iota
+	WeeklyInterval
+	MonthlyInterval
+)
+
+// String returns the name of the interval, it's also the date_trunc field name of the interval.
+func (s StatsInterval) String() string {
+	switch s {
+	case WeeklyInterval:
+		return "week"
+	case MonthlyInterval:
+		return "month"
+	default:
+		return "day"
+	}
+}
+
+// StatsPeriod is the half-open range [From, To) the statistics are computed over.
+type StatsPeriod struct {
+	From     time.Time
+	To       time.Time
+	Interval StatsInterval
+}
+
+// StatsBucket holds the activity of a single interval, it starts at Start.
+type StatsBucket struct {
+	Start      time.Time
+	NewUser    uint64
+	NewThread  uint64
+	NewComment uint64
+	NewLike    uint64
+	NewReport  uint64
+}
+
+type CategoryStats struct {
+	Category  Category
+	NewThread uint64
+}
+
+type ThreadStats struct {
+	Thread     Thread
+	NewComment uint64
+	NewLike    uint64
+}
+
+type UserStats struct {
+	User       User
+	NewThread  uint64
+	NewComment uint64
+}
@@ -15,6 +15,41 @@ type Thread struct {
 	IsLiked       bool
 	IsFollowed    bool
 	Moderators    []Moderator
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	Highlight     ThreadHighlight
+	// LockedAt is zero if the thread is open for new comments.
+	LockedAt        time.Time
+	PinnedCommentID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ThreadHighlight holds the highlighted snippets of a full-text search match.
+type ThreadHighlight struct {
+	Rank        float64
+	Title       string
+	Description string
+	// CommentID and Comment are empty if no comment of the thread matches the search.
+	CommentID string
+	Comment   string
+}
+
+type ThreadSort int
+
+const (
+	NewestSort ThreadSort = iota
+	MostLikedSort
+	MostViewedSort
+	MostCommentedSort
+	TrendingSort
+	// RelevanceSort orders by the full-text search rank, it only applies when searching.
+	RelevanceSort
+)
+
+// ThreadFilter narrows a thread listing, a zero valued field disables its filter.
+type ThreadFilter struct {
+	Unanswered    bool
+	FollowedOnly  bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	CategoryID    string
 }
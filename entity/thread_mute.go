@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// ThreadMute keeps the user from commenting in the thread until ExpiresAt.
+type ThreadMute struct {
+	Thread    Thread
+	User      User
+	Moderator User
+	Reason    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
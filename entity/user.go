@@ -7,15 +7,22 @@ type User struct {
 	Username       string
 	Email          string
 	Name           string
+	Bio            string
+	AvatarURL      string
+	Location       string
 	Password       string
 	Role           string
 	IsActive       bool
+	IsVerified     bool
+	TokenVersion   uint
 	TotalThread    uint64
 	TotalFollower  uint64
 	TotalFollowing uint64
 	IsFollowed     bool
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+	// DeletedAt is zero unless the account is deleted, a deleted account is anonymized instead of removed.
+	DeletedAt time.Time
 }
 
 type UserStatus bool
@@ -19,4 +19,5 @@ type ReportStatus int
 const (
 	Review ReportStatus = iota
 	Accepted
+	Rejected
 )
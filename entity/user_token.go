@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// UserToken is a single-use token sent to the email of the user, only the hash of the token is stored.
+type UserToken struct {
+	ID        string
+	User      User
+	Purpose   UserTokenPurpose
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    time.Time
+	CreatedAt time.Time
+}
+
+type UserTokenPurpose int
+
+const (
+	EmailVerificationToken UserTokenPurpose = iota
+	PasswordResetToken
+)
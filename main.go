@@ -1,30 +1,62 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/config"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/controller"
 	_ "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/docs"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/middleware"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	ar "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/admin"
+	adr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/audit"
 	cr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/category"
+	fr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/feed"
+	lr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/loginattempt"
+	nr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification"
 	rr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/report"
+	sr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/session"
 	tr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread"
 	ur "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user"
+	utr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/usertoken"
 	as "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/admin"
+	ads "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
 	cs "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/category"
+	fs "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/feed"
+	ns "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/notification"
 	rs "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/report"
 	ts "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/thread"
 	us "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/user"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/mailer"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/scheduler"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/viewcounter"
 	_ "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/validation"
 	"github.com/labstack/echo/v4"
 	echoSwagger "github.com/swaggo/echo-swagger"
 )
 
+// banSchedulerInterval is how often the expired bans are lifted, the login lifts an expired ban right away anyway.
+const banSchedulerInterval = time.Minute
+
+const (
+	// viewFlushInterval is how often the buffered thread views are written to the database.
+	viewFlushInterval = 10 * time.Second
+	// viewDedupeWindow is how long the repeated views of the same viewer are counted once.
+	viewDedupeWindow = 30 * time.Minute
+	// shutdownTimeout bounds the wait for the in-flight requests and the last view flush on shutdown.
+	shutdownTimeout = 10 * time.Second
+)
+
 // @title           Forum Group Discussion API
 // @version         1.0
 // @description     API for Forum Group Discussion
@@ -62,35 +94,117 @@ func main() {
 		log.Printf("Successfully connected to database with instance address: %p", db)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), db, os.Args[2:]); err != nil {
+			log.Fatalln(err.Error())
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		if err := runReconcile(context.Background(), db); err != nil {
+			log.Fatalln(err.Error())
+		}
+		return
+	}
+
+	if os.Getenv("MIGRATE_ON_START") == "true" {
+		if err := migrateOnStart(context.Background(), db); err != nil {
+			log.Fatalln(err.Error())
+		}
+	}
+
 	port := ":" + os.Getenv("PORT")
 
 	idGenerator := generator.NewNanoidIDGenerator()
 	passwordGenerator := generator.NewBcryptPasswordGenerator()
 	tokenGenerator := generator.NewJWTTokenGenerator()
 
+	var hub realtime.Hub = realtime.NewMemoryHub()
+	if os.Getenv("REALTIME_BACKEND") == "postgres" {
+		listener, err := config.NewPostgreSQLListener()
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		hub, err = realtime.NewPostgresHub(db, listener)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+	}
+
+	var m mailer.Mailer = mailer.NewLogMailer(os.Getenv("MAIL_LOG_FILE"))
+	if os.Getenv("MAIL_BACKEND") == "smtp" {
+		smtpPort, err := strconv.Atoi(os.Getenv("SMTP_PORT"))
+		if err != nil {
+			log.Fatalf("string conversion failed: %s\n", err.Error())
+		}
+
+		m = mailer.NewSMTPMailer(
+			os.Getenv("SMTP_HOST"),
+			smtpPort,
+			os.Getenv("SMTP_USERNAME"),
+			os.Getenv("SMTP_PASSWORD"),
+			os.Getenv("MAIL_FROM"),
+		)
+	}
+
 	userRepository := ur.NewUserRepositoryImpl(db)
 	categoryRepository := cr.NewCategoryRepositoryImpl(db)
 	threadRepository := tr.NewThreadRepositoryImpl(db)
 	reportRepository := rr.NewReportRepositoryImpl(db)
 	adminRepository := ar.NewAdminRepositoryImpl(db)
+	sessionRepository := sr.NewSessionRepositoryImpl(db)
+	feedRepository := fr.NewFeedRepositoryImpl(db)
+	notificationRepository := nr.NewNotificationRepositoryImpl(db)
+	userTokenRepository := utr.NewUserTokenRepositoryImpl(db)
+	loginAttemptRepository := lr.NewLoginAttemptRepositoryImpl(db)
+	auditRepository := adr.NewAuditRepositoryImpl(db)
+	txManager := repository.NewTxManagerImpl(db)
+
+	viewCounter := viewcounter.NewBatchViewCounter(threadRepository.IncrementTotalViewers, viewDedupeWindow)
 
-	userService := us.NewUserServiceImpl(userRepository, threadRepository, idGenerator, passwordGenerator, tokenGenerator)
-	categoryService := cs.NewCategoryServiceImpl(categoryRepository, threadRepository, idGenerator)
-	threadService := ts.NewThreadServiceImpl(threadRepository, categoryRepository, userRepository, idGenerator)
-	reportService := rs.NewReportServiceImpl(reportRepository, userRepository, threadRepository, idGenerator)
-	adminService := as.NewAdminServiceImpl(adminRepository)
+	auditService := ads.NewAuditServiceImpl(auditRepository, idGenerator)
+
+	userService := us.NewUserServiceImpl(userRepository, threadRepository, sessionRepository, notificationRepository, userTokenRepository, loginAttemptRepository, idGenerator, passwordGenerator, tokenGenerator, hub, m, auditService, txManager)
+	categoryService := cs.NewCategoryServiceImpl(categoryRepository, threadRepository, idGenerator, auditService, txManager)
+	threadService := ts.NewThreadServiceImpl(threadRepository, categoryRepository, userRepository, notificationRepository, idGenerator, hub, auditService, txManager, viewCounter)
+	reportService := rs.NewReportServiceImpl(reportRepository, userRepository, threadRepository, idGenerator, auditService, txManager)
+	adminService := as.NewAdminServiceImpl(adminRepository, userRepository, loginAttemptRepository, auditService, txManager)
+	feedService := fs.NewFeedServiceImpl(feedRepository)
+	notificationService := ns.NewNotificationServiceImpl(notificationRepository)
 
 	registerController := controller.NewRegisterController(userService)
 	loginController := controller.NewLoginController(userService)
+	emailVerificationController := controller.NewEmailVerificationController(userService)
+	passwordResetController := controller.NewPasswordResetController(userService)
+	logoutController := controller.NewLogoutController(userService, tokenGenerator)
 	usersController := controller.NewUsersController(userService, tokenGenerator)
 	categoriesController := controller.NewCategoriesController(categoryService, tokenGenerator)
 	threadsController := controller.NewThreadsController(threadService, tokenGenerator)
-	adminController := controller.NewAdminController(adminService, tokenGenerator)
+	adminController := controller.NewAdminController(adminService, auditService, tokenGenerator)
 	reportsController := controller.NewReportsController(reportService, tokenGenerator)
 	guestController := controller.NewGuestController(threadService, userService)
+	feedController := controller.NewFeedController(feedService, tokenGenerator)
+	notificationsController := controller.NewNotificationsController(notificationService, tokenGenerator)
+	streamController := controller.NewStreamController(hub, tokenGenerator)
+
+	middleware.UseTokenChecker(userService)
+
+	scheduler.Every(context.Background(), "lift expired bans", banSchedulerInterval, userService.LiftExpiredBans)
+
+	viewFlushCtx, stopViewFlush := context.WithCancel(context.Background())
+	viewFlushDone := scheduler.Every(viewFlushCtx, "flush thread views", viewFlushInterval, viewCounter.Flush)
 
 	e := echo.New()
 
+	// The IP extractor must be set before the IP address middleware, which reads it once.
+	if err := middleware.IPExtractor(e); err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	middleware.IPAddress(e)
+
 	if os.Getenv("ENV") == "production" {
 		middleware.CORS(e)
 		middleware.BodyLimit(e)
@@ -110,12 +224,45 @@ func main() {
 
 	registerController.Route(g)
 	loginController.Route(g)
+	emailVerificationController.Route(g)
+	passwordResetController.Route(g)
+	logoutController.Route(g)
 	usersController.Route(g)
 	categoriesController.Route(g)
 	threadsController.Route(g)
 	adminController.Route(g)
 	reportsController.Route(g)
 	guestController.Route(g)
+	feedController.Route(g)
+	notificationsController.Route(g)
+	streamController.Route(g)
 
-	e.Logger.Fatal(e.Start(port))
+	go func() {
+		if err := e.Start(port); err != nil && err != http.ErrServerClosed {
+			e.Logger.Fatal(err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// The open event streams hold the shutdown until the timeout, the buffered views are flushed anyway.
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Error shutting down server: %s\n", err.Error())
+	}
+
+	// A scheduled flush that is cut off buffers its views again, so the final flush waits for it to be over.
+	stopViewFlush()
+	<-viewFlushDone
+
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelFlush()
+
+	if err := viewCounter.Flush(flushCtx); err != nil {
+		log.Printf("Error flushing thread views: %s\n", err.Error())
+	}
 }
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/requestctx"
+	"github.com/labstack/echo/v4"
+)
+
+// IPAddress puts the IP address of the request into its context, so that the audit log and the view counter can use it.
+// The address is taken by e.IPExtractor, see IPExtractor, and falls back to the connection address when it isn't set,
+// so the recorded address is never read from a header the client can forge.
+func IPAddress(e *echo.Echo) {
+	extractIP := e.IPExtractor
+	if extractIP == nil {
+		extractIP = echo.ExtractIPDirect()
+	}
+
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := requestctx.WithIPAddress(c.Request().Context(), extractIP(c.Request()))
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPExtractor sets how c.RealIP() finds the IP address of the client. The X-Forwarded-For header is only trusted
+// when the request comes through one of the proxies in TRUSTED_PROXIES, a comma separated list of CIDR ranges,
+// otherwise a client could forge its IP address. Without trusted proxies, the address of the connection is used.
+func IPExtractor(e *echo.Echo) (err error) {
+	e.IPExtractor, err = NewIPExtractor(os.Getenv("TRUSTED_PROXIES"))
+	return
+}
+
+func NewIPExtractor(trustedProxies string) (extractor echo.IPExtractor, err error) {
+	var options []echo.TrustOption
+	for _, value := range strings.Split(trustedProxies, ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+
+		_, ipRange, parseErr := net.ParseCIDR(value)
+		if parseErr != nil {
+			err = fmt.Errorf("invalid trusted proxy %q: %w", value, parseErr)
+			return
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	if len(options) == 0 {
+		extractor = echo.ExtractIPDirect()
+		return
+	}
+
+	// Only the given ranges are trusted, echo trusts the loopback, link-local and private addresses by default.
+	options = append(options, echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
+	extractor = echo.ExtractIPFromXFFHeader(options...)
+	return
+}
@@ -1,17 +1,78 @@
 package middleware
 
 import (
+	"context"
+	"log"
+	"net/http"
 	"os"
 
+	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
+// TokenChecker reports whether an access token is no longer valid, although its signature and expiry are.
+type TokenChecker interface {
+	// IsTokenRevoked reports whether the session of the token has been revoked, e.g. by logout,
+	// or the user token version has been bumped, e.g. by a ban.
+	IsTokenRevoked(ctx context.Context, sessionID string, userID string, tokenVersion uint) (revoked bool, err error)
+}
+
+var tokenChecker TokenChecker
+
+// UseTokenChecker makes JWTMiddleware reject the revoked and the stale access tokens.
+func UseTokenChecker(checker TokenChecker) {
+	tokenChecker = checker
+}
+
 func JWTMiddleware() echo.MiddlewareFunc {
 	secret := os.Getenv("JWT_SECRET")
 	config := middleware.JWTConfig{
 		SigningKey: []byte(secret),
 	}
 
-	return middleware.JWTWithConfig(config)
+	jwtMiddleware := middleware.JWTWithConfig(config)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return jwtMiddleware(checkToken(next))
+	}
+}
+
+func checkToken(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if tokenChecker == nil {
+			return next(c)
+		}
+
+		token, ok := c.Get("user").(*jwt.Token)
+		if !ok {
+			return next(c)
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired token.")
+		}
+
+		userID, _ := claims["id"].(string)
+		sessionID, _ := claims["sid"].(string)
+		if userID == "" || sessionID == "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired token.")
+		}
+
+		// The numbers of jwt.MapClaims are decoded as float64.
+		tokenVersion, _ := claims["ver"].(float64)
+
+		revoked, err := tokenChecker.IsTokenRevoked(c.Request().Context(), sessionID, userID, uint(tokenVersion))
+		if err != nil {
+			log.Println(err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "Something went wrong.")
+		}
+
+		if revoked {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired token.")
+		}
+
+		return next(c)
+	}
 }
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/migrations"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/migrator"
+)
+
+const migrateUsage = "usage: migrate up|down|status|to <version>"
+
+// runMigrate handles the migrate subcommand, the version 0 of the to command reverts every migration.
+func runMigrate(ctx context.Context, db *sql.DB, args []string) error {
+	m, err := migrator.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migration")
+		}
+		return err
+	case "down":
+		reverted, err := m.Down(ctx)
+		if err == nil {
+			fmt.Printf("reverted %d_%s\n", reverted.Version, reverted.Name)
+		}
+		return err
+	case "to":
+		if len(args) != 2 {
+			return errors.New(migrateUsage)
+		}
+
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %w", args[1], err)
+		}
+
+		applied, reverted, err := m.To(ctx, version)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %d_%s\n", migration.Version, migration.Name)
+		}
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			appliedOn := "pending"
+			if !status.AppliedAt.IsZero() {
+				appliedOn = status.AppliedAt.Format(time.RFC822)
+			}
+			fmt.Printf("%d_%s\t%s\n", status.Migration.Version, status.Migration.Name, appliedOn)
+		}
+		return nil
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+// migrateOnStart applies the pending migrations before the server starts, it's enabled by MIGRATE_ON_START=true.
+func migrateOnStart(ctx context.Context, db *sql.DB) error {
+	m, err := migrator.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	applied, err := m.Up(ctx)
+	for _, migration := range applied {
+		log.Printf("Applied migration %d_%s\n", migration.Version, migration.Name)
+	}
+
+	return err
+}
@@ -0,0 +1,4 @@
+ALTER TABLE comments
+    ADD COLUMN parent_id char(9) NULL,
+    ADD CONSTRAINT fk_comments_comments foreign key (parent_id) references comments (id) on delete cascade;
+CREATE INDEX idx_comments_parent_id ON comments (parent_id);
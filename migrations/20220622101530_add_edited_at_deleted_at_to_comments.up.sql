@@ -0,0 +1,3 @@
+ALTER TABLE comments
+    ADD COLUMN edited_at  timestamp NULL,
+    ADD COLUMN deleted_at timestamp NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE sessions
+(
+    id                          char(9),
+    user_id                     char(8)      NOT NULL,
+    device                      varchar(255) NOT NULL DEFAULT '',
+    refresh_token_hash          char(64)     NOT NULL,
+    previous_refresh_token_hash char(64)     NULL,
+    expires_at                  timestamp    NOT NULL,
+    revoked_at                  timestamp    NULL,
+    created_at                  timestamp    NOT NULL DEFAULT current_timestamp,
+    updated_at                  timestamp    NOT NULL DEFAULT current_timestamp,
+    primary key (id),
+    constraint fk_sessions_users foreign key (user_id) references users (id) on delete cascade,
+    unique (refresh_token_hash)
+);
+CREATE INDEX idx_sessions_user_id_device ON sessions (user_id, device);
+CREATE INDEX idx_sessions_previous_refresh_token_hash ON sessions (previous_refresh_token_hash);
@@ -0,0 +1,4 @@
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS token_version;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS token_version;
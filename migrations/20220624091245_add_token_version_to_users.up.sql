@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN token_version integer NOT NULL DEFAULT 0;
+ALTER TABLE sessions
+    ADD COLUMN token_version integer NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_comments_search_vector;
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS search_vector;
+DROP INDEX IF EXISTS idx_threads_search_vector;
+ALTER TABLE threads
+    DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,9 @@
+ALTER TABLE threads
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+                setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+                setweight(to_tsvector('english', coalesce(description, '')), 'B')
+        ) STORED;
+CREATE INDEX idx_threads_search_vector ON threads USING GIN (search_vector);
+ALTER TABLE comments
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (to_tsvector('english', coalesce(comment, ''))) STORED;
+CREATE INDEX idx_comments_search_vector ON comments USING GIN (search_vector);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_comments_thread_id_created_at;
+DROP INDEX IF EXISTS idx_threads_category_id_created_at;
+DROP INDEX IF EXISTS idx_threads_creator_id_created_at;
@@ -0,0 +1,3 @@
+CREATE INDEX idx_threads_creator_id_created_at ON threads (creator_id, created_at DESC);
+CREATE INDEX idx_threads_category_id_created_at ON threads (category_id, created_at DESC);
+CREATE INDEX idx_comments_thread_id_created_at ON comments (thread_id, created_at DESC);
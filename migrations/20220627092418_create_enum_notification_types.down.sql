@@ -0,0 +1 @@
+DROP TYPE notification_types;
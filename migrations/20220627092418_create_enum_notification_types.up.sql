@@ -0,0 +1 @@
+CREATE TYPE notification_types AS ENUM ('comment', 'follow', 'like', 'moderator');
@@ -0,0 +1,20 @@
+CREATE TABLE notifications
+(
+    id         char(9),
+    user_id    char(8)            NOT NULL,
+    actor_id   char(8)            NOT NULL,
+    type       notification_types NOT NULL,
+    thread_id  char(9)            NULL,
+    comment_id char(9)            NULL,
+    is_read    boolean            NOT NULL DEFAULT false,
+    created_at timestamp          NOT NULL DEFAULT current_timestamp,
+    updated_at timestamp          NOT NULL DEFAULT current_timestamp,
+    primary key (id),
+    constraint fk_notifications_user_users foreign key (user_id) references users (id) on delete cascade,
+    constraint fk_notifications_actor_users foreign key (actor_id) references users (id) on delete cascade,
+    constraint fk_notifications_threads foreign key (thread_id) references threads (id) on delete cascade,
+    constraint fk_notifications_comments foreign key (comment_id) references comments (id) on delete cascade
+);
+
+CREATE INDEX idx_notifications_user_id_created_at ON notifications (user_id, created_at DESC);
+CREATE INDEX idx_notifications_user_id_unread ON notifications (user_id) WHERE is_read = false;
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN bio        varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN location   varchar(50)  NOT NULL DEFAULT '';
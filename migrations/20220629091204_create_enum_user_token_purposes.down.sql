@@ -0,0 +1 @@
+DROP TYPE user_token_purposes;
@@ -0,0 +1 @@
+CREATE TYPE user_token_purposes AS ENUM ('email_verification', 'password_reset');
@@ -0,0 +1,15 @@
+CREATE TABLE user_tokens
+(
+    id         char(9),
+    user_id    char(8)             NOT NULL,
+    purpose    user_token_purposes NOT NULL,
+    token_hash char(64)            NOT NULL,
+    expires_at timestamp           NOT NULL,
+    used_at    timestamp           NULL,
+    created_at timestamp           NOT NULL DEFAULT current_timestamp,
+    primary key (id),
+    constraint fk_user_tokens_users foreign key (user_id) references users (id) on delete cascade,
+    unique (token_hash)
+);
+
+CREATE INDEX idx_user_tokens_user_id_purpose ON user_tokens (user_id, purpose) WHERE used_at IS NULL;
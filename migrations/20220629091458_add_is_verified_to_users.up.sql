@@ -0,0 +1,6 @@
+ALTER TABLE users
+    ADD COLUMN is_verified boolean NOT NULL DEFAULT false;
+
+-- The accounts registered before the email verification are trusted.
+UPDATE users
+SET is_verified = true;
@@ -0,0 +1 @@
+DROP TYPE login_attempt_kinds;
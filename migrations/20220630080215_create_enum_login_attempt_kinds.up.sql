@@ -0,0 +1 @@
+CREATE TYPE login_attempt_kinds AS ENUM ('username', 'ip');
@@ -0,0 +1,11 @@
+CREATE TABLE login_attempts
+(
+    kind            login_attempt_kinds NOT NULL,
+    value           varchar(255)        NOT NULL,
+    failed_attempts integer             NOT NULL DEFAULT 0,
+    last_failed_at  timestamp           NOT NULL,
+    locked_until    timestamp           NULL,
+    primary key (kind, value)
+);
+
+CREATE INDEX idx_login_attempts_locked_until ON login_attempts (locked_until) WHERE locked_until IS NOT NULL;
@@ -0,0 +1,9 @@
+UPDATE users
+SET role = 'user'
+WHERE role = 'global_moderator';
+
+ALTER TYPE roles RENAME TO roles_old;
+CREATE TYPE roles AS ENUM ('admin', 'user');
+ALTER TABLE users
+    ALTER COLUMN role TYPE roles USING role::text::roles;
+DROP TYPE roles_old;
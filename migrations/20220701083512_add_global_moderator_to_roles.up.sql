@@ -0,0 +1 @@
+ALTER TYPE roles ADD VALUE IF NOT EXISTS 'global_moderator' BEFORE 'user';
@@ -0,0 +1,24 @@
+CREATE TABLE bans
+(
+    id         char(9),
+    user_id    char(8)   NOT NULL,
+    issuer_id  char(8)   NULL,
+    reason     text      NOT NULL,
+    expires_at timestamp NULL,
+    lifted_at  timestamp NULL,
+    lifter_id  char(8)   NULL,
+    created_at timestamp NOT NULL DEFAULT current_timestamp,
+    primary key (id),
+    constraint fk_bans_users foreign key (user_id) references users (id) on delete cascade,
+    constraint fk_bans_issuers foreign key (issuer_id) references users (id) on delete set null,
+    constraint fk_bans_lifters foreign key (lifter_id) references users (id) on delete set null
+);
+
+CREATE UNIQUE INDEX idx_bans_user_id_active ON bans (user_id) WHERE lifted_at IS NULL;
+
+CREATE INDEX idx_bans_expires_at ON bans (expires_at) WHERE lifted_at IS NULL AND expires_at IS NOT NULL;
+
+INSERT INTO bans (id, user_id, reason, created_at)
+SELECT 'b-' || substr(md5(id), 1, 7), id, 'Banned before the ban history was recorded.', updated_at
+FROM users
+WHERE is_active = false;
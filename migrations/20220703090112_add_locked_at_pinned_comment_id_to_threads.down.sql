@@ -0,0 +1,4 @@
+ALTER TABLE threads
+    DROP CONSTRAINT IF EXISTS fk_threads_pinned_comments,
+    DROP COLUMN IF EXISTS pinned_comment_id,
+    DROP COLUMN IF EXISTS locked_at;
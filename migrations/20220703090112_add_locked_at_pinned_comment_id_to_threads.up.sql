@@ -0,0 +1,4 @@
+ALTER TABLE threads
+    ADD COLUMN locked_at         timestamp NULL,
+    ADD COLUMN pinned_comment_id char(9)   NULL,
+    ADD CONSTRAINT fk_threads_pinned_comments foreign key (pinned_comment_id) references comments (id) on delete set null;
@@ -0,0 +1,13 @@
+CREATE TABLE thread_mutes
+(
+    thread_id    char(9)   NOT NULL,
+    user_id      char(8)   NOT NULL,
+    moderator_id char(8)   NULL,
+    reason       text      NOT NULL,
+    expires_at   timestamp NOT NULL,
+    created_at   timestamp NOT NULL DEFAULT current_timestamp,
+    primary key (thread_id, user_id),
+    constraint fk_thread_mutes_threads foreign key (thread_id) references threads (id) on delete cascade,
+    constraint fk_thread_mutes_users foreign key (user_id) references users (id) on delete cascade,
+    constraint fk_thread_mutes_moderators foreign key (moderator_id) references users (id) on delete set null
+);
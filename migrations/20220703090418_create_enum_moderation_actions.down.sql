@@ -0,0 +1 @@
+DROP TYPE moderation_actions;
@@ -0,0 +1 @@
+CREATE TYPE moderation_actions AS ENUM ('lock', 'unlock', 'pin', 'unpin', 'mute', 'unmute');
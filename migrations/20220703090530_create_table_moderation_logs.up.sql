@@ -0,0 +1,19 @@
+CREATE TABLE moderation_logs
+(
+    id             char(9),
+    thread_id      char(9)            NOT NULL,
+    actor_id       char(8)            NULL,
+    action         moderation_actions NOT NULL,
+    target_user_id char(8)            NULL,
+    comment_id     char(9)            NULL,
+    reason         text               NOT NULL DEFAULT '',
+    expires_at     timestamp          NULL,
+    created_at     timestamp          NOT NULL DEFAULT current_timestamp,
+    primary key (id),
+    constraint fk_moderation_logs_threads foreign key (thread_id) references threads (id) on delete cascade,
+    constraint fk_moderation_logs_actors foreign key (actor_id) references users (id) on delete set null,
+    constraint fk_moderation_logs_target_users foreign key (target_user_id) references users (id) on delete set null,
+    constraint fk_moderation_logs_comments foreign key (comment_id) references comments (id) on delete set null
+);
+
+CREATE INDEX idx_moderation_logs_thread_id_created_at ON moderation_logs (thread_id, created_at DESC);
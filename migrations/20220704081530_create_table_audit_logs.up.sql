@@ -0,0 +1,31 @@
+CREATE TABLE audit_logs
+(
+    id          char(9),
+    actor_id    char(8)     NULL,
+    action      varchar(50) NOT NULL,
+    target_type varchar(20) NOT NULL,
+    target_id   varchar(20) NOT NULL,
+    before      jsonb       NULL,
+    after       jsonb       NULL,
+    ip_address  varchar(45) NOT NULL DEFAULT '',
+    created_at  timestamp   NOT NULL DEFAULT current_timestamp,
+    primary key (id)
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at DESC);
+CREATE INDEX idx_audit_logs_actor_id_created_at ON audit_logs (actor_id, created_at DESC);
+CREATE INDEX idx_audit_logs_target_type_target_id ON audit_logs (target_type, target_id);
+
+-- The audit log is append-only, the actor is not a foreign key so that deleting a user doesn't rewrite the log.
+CREATE FUNCTION reject_audit_log_changes() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_logs_append_only
+    BEFORE UPDATE OR DELETE
+    ON audit_logs
+    FOR EACH ROW
+EXECUTE FUNCTION reject_audit_log_changes();
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_user_banneds_created_at;
+DROP INDEX IF EXISTS idx_likes_created_at;
+DROP INDEX IF EXISTS idx_comments_created_at;
+DROP INDEX IF EXISTS idx_threads_created_at;
+DROP INDEX IF EXISTS idx_users_created_at;
@@ -0,0 +1,5 @@
+CREATE INDEX idx_users_created_at ON users (created_at);
+CREATE INDEX idx_threads_created_at ON threads (created_at);
+CREATE INDEX idx_comments_created_at ON comments (created_at);
+CREATE INDEX idx_likes_created_at ON likes (created_at);
+CREATE INDEX idx_user_banneds_created_at ON user_banneds (created_at);
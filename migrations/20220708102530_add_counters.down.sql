@@ -0,0 +1,9 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS total_following,
+    DROP COLUMN IF EXISTS total_follower,
+    DROP COLUMN IF EXISTS total_thread;
+
+ALTER TABLE threads
+    DROP COLUMN IF EXISTS total_comment,
+    DROP COLUMN IF EXISTS total_like,
+    DROP COLUMN IF EXISTS total_follower;
@@ -0,0 +1,19 @@
+ALTER TABLE threads
+    ADD COLUMN total_follower int NOT NULL DEFAULT 0,
+    ADD COLUMN total_like     int NOT NULL DEFAULT 0,
+    ADD COLUMN total_comment  int NOT NULL DEFAULT 0;
+
+ALTER TABLE users
+    ADD COLUMN total_thread    int NOT NULL DEFAULT 0,
+    ADD COLUMN total_follower  int NOT NULL DEFAULT 0,
+    ADD COLUMN total_following int NOT NULL DEFAULT 0;
+
+UPDATE threads t
+SET total_follower = (SELECT count(tf.id) FROM thread_follows tf WHERE tf.thread_id = t.id),
+    total_like     = (SELECT count(l.id) FROM likes l WHERE l.thread_id = t.id),
+    total_comment  = (SELECT count(c.id) FROM comments c WHERE c.thread_id = t.id AND c.deleted_at IS NULL);
+
+UPDATE users u
+SET total_thread    = (SELECT count(t.id) FROM threads t WHERE t.creator_id = u.id),
+    total_follower  = (SELECT count(uf.id) FROM user_follows uf WHERE uf.following_id = u.id),
+    total_following = (SELECT count(uf.id) FROM user_follows uf WHERE uf.user_id = u.id);
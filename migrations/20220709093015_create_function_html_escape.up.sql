@@ -0,0 +1,9 @@
+CREATE OR REPLACE FUNCTION html_escape(value text) RETURNS text
+    LANGUAGE sql
+    IMMUTABLE
+    STRICT
+AS
+$$
+SELECT replace(replace(replace(replace(replace(value, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''',
+               '&#39;')
+$$;
@@ -0,0 +1,2 @@
+ALTER TABLE audit_logs
+    ALTER COLUMN target_id TYPE varchar(20);
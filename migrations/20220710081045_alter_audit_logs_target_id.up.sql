@@ -0,0 +1,3 @@
+-- The cleared lockouts are audited with their username or IP address as the target, an IPv6 address has up to 45 characters.
+ALTER TABLE audit_logs
+    ALTER COLUMN target_id TYPE varchar(45);
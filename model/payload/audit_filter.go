@@ -0,0 +1,14 @@
+package payload
+
+type AuditFilter struct {
+	// ActorUsername only returns the actions taken by the user
+	ActorUsername string
+	Action        string
+	// TargetType is one of thread, comment, category, user, report and lockout
+	TargetType string
+	TargetID   string
+	// CreatedAfter, format: 2006-01-02
+	CreatedAfter string
+	// CreatedBefore, format: 2006-01-02
+	CreatedBefore string
+}
@@ -0,0 +1,8 @@
+package payload
+
+// BanUser is optional, an empty body bans the user permanently without a reason.
+type BanUser struct {
+	Reason string `json:"reason" validate:"max=255" extensions:"x-order=0"`
+	// Days of the ban, 0 means the ban is permanent
+	Days uint `json:"days" validate:"max=3650" extensions:"x-order=1"`
+}
@@ -0,0 +1,8 @@
+package payload
+
+type ChangePassword struct {
+	OldPassword string `json:"oldPassword" validate:"nonzero,min=8,max=20" extensions:"x-order=0"`
+	NewPassword string `json:"newPassword" validate:"nonzero,min=8,max=20" extensions:"x-order=1"`
+	// Device is optional, the User-Agent header is used when it's empty
+	Device string `json:"device" validate:"max=255" extensions:"x-order=2"`
+}
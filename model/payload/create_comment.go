@@ -1,5 +1,6 @@
 package payload
 
 type CreateComment struct {
-	Comment string `json:"comment" validate:"nonzero,min=1" extensions:"x-order=0"`
+	// Comment is at most 1000 characters, so the comment event fits in a PostgreSQL NOTIFY payload
+	Comment string `json:"comment" validate:"nonzero,min=1,max=1000" extensions:"x-order=0"`
 }
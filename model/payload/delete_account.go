@@ -0,0 +1,6 @@
+package payload
+
+type DeleteAccount struct {
+	// Password confirms the deletion, it can't be undone
+	Password string `json:"password" validate:"nonzero,min=8,max=20" extensions:"x-order=0"`
+}
@@ -0,0 +1,6 @@
+package payload
+
+// EmailRequest is used to request a verification or a password reset email.
+type EmailRequest struct {
+	Email string `json:"email" validate:"nonzero,min=5,max=50" extensions:"x-order=0"`
+}
@@ -0,0 +1,10 @@
+package payload
+
+type ExportFilter struct {
+	// Format is one of csv and ndjson, default csv
+	Format string
+	// CreatedAfter, format: 2006-01-02
+	CreatedAfter string
+	// CreatedBefore, format: 2006-01-02
+	CreatedBefore string
+}
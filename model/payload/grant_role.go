@@ -0,0 +1,6 @@
+package payload
+
+type GrantRole struct {
+	// Role, available options: admin, global_moderator, user
+	Role string `json:"role" validate:"nonzero" extensions:"x-order=0"`
+}
@@ -3,4 +3,8 @@ package payload
 type Login struct {
 	Username string `json:"username" validate:"nonzero,min=2,max=20" extensions:"x-order=0"`
 	Password string `json:"password" validate:"nonzero,min=8,max=20" extensions:"x-order=1"`
+	// Device is optional, the User-Agent header is used when it's empty
+	Device string `json:"device" validate:"max=255" extensions:"x-order=2"`
+	// IPAddress is filled from the request, it's used to throttle the failed attempts
+	IPAddress string `json:"-" swaggerignore:"true"`
 }
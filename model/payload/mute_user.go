@@ -0,0 +1,8 @@
+package payload
+
+type MuteUser struct {
+	Username string `json:"username" validate:"nonzero,min=2,max=20" extensions:"x-order=0"`
+	Reason   string `json:"reason" validate:"max=255" extensions:"x-order=1"`
+	// Hours of the mute
+	Hours uint `json:"hours" validate:"min=1,max=8760" extensions:"x-order=2"`
+}
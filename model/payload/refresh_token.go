@@ -0,0 +1,5 @@
+package payload
+
+type RefreshToken struct {
+	RefreshToken string `json:"refreshToken" validate:"nonzero" extensions:"x-order=0"`
+}
@@ -0,0 +1,6 @@
+package payload
+
+type ResetPassword struct {
+	Token       string `json:"token" validate:"nonzero" extensions:"x-order=0"`
+	NewPassword string `json:"newPassword" validate:"nonzero,min=8,max=20" extensions:"x-order=1"`
+}
@@ -0,0 +1,12 @@
+package payload
+
+type StatsFilter struct {
+	// From, format: 2006-01-02, default 30 days before To
+	From string
+	// To is inclusive, format: 2006-01-02, default today
+	To string
+	// Interval is one of day, week and month, default day
+	Interval string
+	// Limit is the length of the top lists, default 5
+	Limit uint
+}
@@ -0,0 +1,13 @@
+package payload
+
+type ThreadFilter struct {
+	// Unanswered only returns threads without any comment
+	Unanswered bool
+	// FollowedOnly only returns threads followed by the accessor
+	FollowedOnly bool
+	// CreatedAfter, format: 2006-01-02
+	CreatedAfter string
+	// CreatedBefore, format: 2006-01-02
+	CreatedBefore string
+	CategoryID    string
+}
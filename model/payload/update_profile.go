@@ -0,0 +1,11 @@
+package payload
+
+type UpdateProfile struct {
+	Email string `json:"email" validate:"nonzero,min=5,max=50" extensions:"x-order=0"`
+	Name  string `json:"name" validate:"nonzero,min=1,max=50" extensions:"x-order=1"`
+	// Bio, AvatarURL and Location are optional, an empty value clears them
+	Bio string `json:"bio" validate:"max=255" extensions:"x-order=2"`
+	// AvatarURL must be an absolute http or https URL
+	AvatarURL string `json:"avatarURL" validate:"max=255" extensions:"x-order=3"`
+	Location  string `json:"location" validate:"max=50" extensions:"x-order=4"`
+}
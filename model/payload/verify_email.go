@@ -0,0 +1,5 @@
+package payload
+
+type VerifyEmail struct {
+	Token string `json:"token" validate:"nonzero" extensions:"x-order=0"`
+}
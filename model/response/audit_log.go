@@ -0,0 +1,19 @@
+package response
+
+import "encoding/json"
+
+type AuditLog struct {
+	ID            string `json:"ID" extensions:"x-order=0"`
+	ActorID       string `json:"actorID" extensions:"x-order=1"`
+	ActorUsername string `json:"actorUsername" extensions:"x-order=2"`
+	Action        string `json:"action" extensions:"x-order=3"`
+	TargetType    string `json:"targetType" extensions:"x-order=4"`
+	TargetID      string `json:"targetID" extensions:"x-order=5"`
+	// Before is the state of the target before the action, null if the action created it
+	Before json.RawMessage `json:"before" swaggertype:"object" extensions:"x-order=6"`
+	// After is the state of the target after the action, null if the action deleted it
+	After     json.RawMessage `json:"after" swaggertype:"object" extensions:"x-order=7"`
+	IPAddress string          `json:"ipAddress" extensions:"x-order=8"`
+	// CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	CreatedOn string `json:"createdOn" extensions:"x-order=9"`
+}
@@ -0,0 +1,16 @@
+package response
+
+type Ban struct {
+	ID             string `json:"ID" extensions:"x-order=0"`
+	Reason         string `json:"reason" extensions:"x-order=1"`
+	IssuerUsername string `json:"issuerUsername" extensions:"x-order=2"`
+	IsPermanent    bool   `json:"isPermanent" extensions:"x-order=3"`
+	// ExpiresOn layout format: time.RFC822 (02 Jan 06 15:04 MST), empty when the ban is permanent
+	ExpiresOn string `json:"expiresOn" extensions:"x-order=4"`
+	IsActive  bool   `json:"isActive" extensions:"x-order=5"`
+	// LiftedOn layout format: time.RFC822 (02 Jan 06 15:04 MST), empty when the ban is active
+	LiftedOn       string `json:"liftedOn" extensions:"x-order=6"`
+	LifterUsername string `json:"lifterUsername" extensions:"x-order=7"`
+	// BannedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	BannedOn string `json:"bannedOn" extensions:"x-order=8"`
+}
@@ -8,4 +8,14 @@ type Comment struct {
 	Comment  string `json:"comment" extensions:"x-order=4"`
 	// PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
 	PublishedOn string `json:"publishedOn" extensions:"x-order=5"`
+	IsEdited    bool   `json:"isEdited" extensions:"x-order=6"`
+	// EditedOn layout format: time.RFC822 (02 Jan 06 15:04 MST), empty if the comment has never been edited
+	EditedOn string `json:"editedOn" extensions:"x-order=7"`
+	// IsDeleted comments are tombstones, their content and author are hidden
+	IsDeleted bool `json:"isDeleted" extensions:"x-order=8"`
+	// ParentID is empty for the root comments
+	ParentID string    `json:"parentID" extensions:"x-order=9"`
+	Replies  []Comment `json:"replies,omitempty" extensions:"x-order=10"`
+	// IsPinned comment is listed on top of the first page, in addition to the limit
+	IsPinned bool `json:"isPinned" extensions:"x-order=11"`
 }
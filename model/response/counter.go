@@ -0,0 +1,12 @@
+package response
+
+type ThreadCounter struct {
+	ThreadID      string `json:"threadID" extensions:"x-order=0"`
+	TotalLike     uint64 `json:"totalLike" extensions:"x-order=1"`
+	TotalFollower uint64 `json:"totalFollower" extensions:"x-order=2"`
+}
+
+type UserCounter struct {
+	UserID        string `json:"userID" extensions:"x-order=0"`
+	TotalFollower uint64 `json:"totalFollower" extensions:"x-order=1"`
+}
@@ -0,0 +1,60 @@
+package response
+
+// The export rows are written as CSV columns or NDJSON lines, the timestamps use the time.RFC3339 layout and are empty
+// when unset.
+
+type UserExport struct {
+	ID           string `json:"ID" extensions:"x-order=0"`
+	Username     string `json:"username" extensions:"x-order=1"`
+	Email        string `json:"email" extensions:"x-order=2"`
+	Name         string `json:"name" extensions:"x-order=3"`
+	Role         string `json:"role" extensions:"x-order=4"`
+	IsActive     bool   `json:"isActive" extensions:"x-order=5"`
+	IsVerified   bool   `json:"isVerified" extensions:"x-order=6"`
+	Location     string `json:"location" extensions:"x-order=7"`
+	TotalThread  uint   `json:"totalThread" extensions:"x-order=8"`
+	RegisteredAt string `json:"registeredAt" extensions:"x-order=9"`
+}
+
+type ThreadExport struct {
+	ID              string `json:"ID" extensions:"x-order=0"`
+	Title           string `json:"title" extensions:"x-order=1"`
+	CreatorID       string `json:"creatorID" extensions:"x-order=2"`
+	CreatorUsername string `json:"creatorUsername" extensions:"x-order=3"`
+	CategoryID      string `json:"categoryID" extensions:"x-order=4"`
+	CategoryName    string `json:"categoryName" extensions:"x-order=5"`
+	TotalViewer     uint   `json:"totalViewer" extensions:"x-order=6"`
+	TotalLike       uint   `json:"totalLike" extensions:"x-order=7"`
+	TotalComment    uint   `json:"totalComment" extensions:"x-order=8"`
+	LockedAt        string `json:"lockedAt" extensions:"x-order=9"`
+	PublishedAt     string `json:"publishedAt" extensions:"x-order=10"`
+	UpdatedAt       string `json:"updatedAt" extensions:"x-order=11"`
+}
+
+type CommentExport struct {
+	ID          string `json:"ID" extensions:"x-order=0"`
+	ThreadID    string `json:"threadID" extensions:"x-order=1"`
+	ParentID    string `json:"parentID" extensions:"x-order=2"`
+	UserID      string `json:"userID" extensions:"x-order=3"`
+	Username    string `json:"username" extensions:"x-order=4"`
+	Comment     string `json:"comment" extensions:"x-order=5"`
+	PublishedAt string `json:"publishedAt" extensions:"x-order=6"`
+	EditedAt    string `json:"editedAt" extensions:"x-order=7"`
+	DeletedAt   string `json:"deletedAt" extensions:"x-order=8"`
+}
+
+type ReportExport struct {
+	ID                string `json:"ID" extensions:"x-order=0"`
+	ModeratorID       string `json:"moderatorID" extensions:"x-order=1"`
+	ModeratorUsername string `json:"moderatorUsername" extensions:"x-order=2"`
+	UserID            string `json:"userID" extensions:"x-order=3"`
+	Username          string `json:"username" extensions:"x-order=4"`
+	ThreadID          string `json:"threadID" extensions:"x-order=5"`
+	ThreadTitle       string `json:"threadTitle" extensions:"x-order=6"`
+	CommentID         string `json:"commentID" extensions:"x-order=7"`
+	Comment           string `json:"comment" extensions:"x-order=8"`
+	Reason            string `json:"reason" extensions:"x-order=9"`
+	Status            string `json:"status" extensions:"x-order=10"`
+	ReportedAt        string `json:"reportedAt" extensions:"x-order=11"`
+	UpdatedAt         string `json:"updatedAt" extensions:"x-order=12"`
+}
@@ -0,0 +1,41 @@
+package response
+
+type Feed struct {
+	List []FeedItem `json:"list" extensions:"x-order=0"`
+	// NextCursor is passed as the cursor query param to get the next page, empty if there is no more item
+	NextCursor string `json:"nextCursor" extensions:"x-order=1"`
+}
+
+type FeedItem struct {
+	// Type, available options: followed_user_thread, followed_thread_comment, category_thread
+	Type string `json:"type" extensions:"x-order=0"`
+	ID   string `json:"ID" extensions:"x-order=1"`
+	// PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	PublishedOn string     `json:"publishedOn" extensions:"x-order=2"`
+	Thread      FeedThread `json:"thread" extensions:"x-order=3"`
+	// Comment is only present for the followed_thread_comment items
+	Comment *FeedComment `json:"comment,omitempty" extensions:"x-order=4"`
+}
+
+type FeedThread struct {
+	ID              string `json:"ID" extensions:"x-order=0"`
+	Title           string `json:"title" extensions:"x-order=1"`
+	Description     string `json:"description" extensions:"x-order=2"`
+	CategoryID      string `json:"categoryID" extensions:"x-order=3"`
+	CategoryName    string `json:"categoryName" extensions:"x-order=4"`
+	CreatorID       string `json:"creatorID" extensions:"x-order=5"`
+	CreatorUsername string `json:"creatorUsername" extensions:"x-order=6"`
+	CreatorName     string `json:"creatorName" extensions:"x-order=7"`
+	// PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	PublishedOn string `json:"publishedOn" extensions:"x-order=8"`
+}
+
+type FeedComment struct {
+	ID       string `json:"ID" extensions:"x-order=0"`
+	UserID   string `json:"userID" extensions:"x-order=1"`
+	Username string `json:"username" extensions:"x-order=2"`
+	Name     string `json:"name" extensions:"x-order=3"`
+	Comment  string `json:"comment" extensions:"x-order=4"`
+	// ParentID is empty for the root comments
+	ParentID string `json:"parentID" extensions:"x-order=5"`
+}
@@ -0,0 +1,11 @@
+package response
+
+type Lockout struct {
+	// Kind, available options: username, ip
+	Kind           string `json:"kind" extensions:"x-order=0"`
+	Value          string `json:"value" extensions:"x-order=1"`
+	FailedAttempts uint   `json:"failedAttempts" extensions:"x-order=2"`
+	// LastFailedOn and LockedUntil layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	LastFailedOn string `json:"lastFailedOn" extensions:"x-order=3"`
+	LockedUntil  string `json:"lockedUntil" extensions:"x-order=4"`
+}
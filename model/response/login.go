@@ -1,6 +1,7 @@
 package response
 
 type Login struct {
-	Token string `json:"token" extensions:"x-order=0"`
-	Role  string `json:"role" extensions:"x-order=1"`
+	Token        string `json:"token" extensions:"x-order=0"`
+	RefreshToken string `json:"refreshToken" extensions:"x-order=1"`
+	Role         string `json:"role" extensions:"x-order=2"`
 }
@@ -0,0 +1,19 @@
+package response
+
+type ModerationLog struct {
+	ID string `json:"ID" extensions:"x-order=0"`
+	// Action is one of lock, unlock, pin, unpin, mute and unmute
+	Action        string `json:"action" extensions:"x-order=1"`
+	ActorID       string `json:"actorID" extensions:"x-order=2"`
+	ActorUsername string `json:"actorUsername" extensions:"x-order=3"`
+	// TargetUserID and TargetUsername are only present for mute and unmute
+	TargetUserID   string `json:"targetUserID" extensions:"x-order=4"`
+	TargetUsername string `json:"targetUsername" extensions:"x-order=5"`
+	// CommentID is only present for pin and unpin
+	CommentID string `json:"commentID" extensions:"x-order=6"`
+	Reason    string `json:"reason" extensions:"x-order=7"`
+	// ExpiresOn layout format: time.RFC822 (02 Jan 06 15:04 MST), only present for mute
+	ExpiresOn string `json:"expiresOn" extensions:"x-order=8"`
+	// CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	CreatedOn string `json:"createdOn" extensions:"x-order=9"`
+}
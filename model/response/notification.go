@@ -0,0 +1,22 @@
+package response
+
+type Notification struct {
+	ID string `json:"ID" extensions:"x-order=0"`
+	// Type, available options: comment, follow, like, moderator
+	Type          string `json:"type" extensions:"x-order=1"`
+	ActorID       string `json:"actorID" extensions:"x-order=2"`
+	ActorUsername string `json:"actorUsername" extensions:"x-order=3"`
+	ActorName     string `json:"actorName" extensions:"x-order=4"`
+	// ThreadID and ThreadTitle are empty for the follow notifications
+	ThreadID    string `json:"threadID" extensions:"x-order=5"`
+	ThreadTitle string `json:"threadTitle" extensions:"x-order=6"`
+	// CommentID is only filled for the comment notifications
+	CommentID string `json:"commentID" extensions:"x-order=7"`
+	IsRead    bool   `json:"isRead" extensions:"x-order=8"`
+	// CreatedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	CreatedOn string `json:"createdOn" extensions:"x-order=9"`
+}
+
+type UnreadNotification struct {
+	Total uint `json:"total" extensions:"x-order=0"`
+}
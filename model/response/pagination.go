@@ -1,10 +1,12 @@
 package response
 
 type Entity interface {
-	ManyThread | Category | Comment | User | Report
+	ManyThread | Category | Comment | User | Report | Notification | ModerationLog | AuditLog
 }
 
 type Pagination[T Entity] struct {
-	List     []T      `json:"list" extensions:"x-order=0"`
-	PageInfo PageInfo `json:"pageInfo" extensions:"x-order=1"`
+	List       []T      `json:"list" extensions:"x-order=0"`
+	PageInfo   PageInfo `json:"pageInfo" extensions:"x-order=1"`
+	NextCursor string   `json:"nextCursor,omitempty" extensions:"x-order=2"`
+	PrevCursor string   `json:"prevCursor,omitempty" extensions:"x-order=3"`
 }
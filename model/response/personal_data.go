@@ -0,0 +1,64 @@
+package response
+
+// PersonalData is the archive of the own data of a user, the times use the time.RFC3339 layout and are empty when
+// unset.
+type PersonalData struct {
+	ExportedAt      string                `json:"exportedAt" extensions:"x-order=0"`
+	Profile         PersonalProfile       `json:"profile" extensions:"x-order=1"`
+	Threads         []PersonalThread      `json:"threads" extensions:"x-order=2"`
+	Comments        []PersonalComment     `json:"comments" extensions:"x-order=3"`
+	Likes           []PersonalThreadEntry `json:"likes" extensions:"x-order=4"`
+	Following       []PersonalUserEntry   `json:"following" extensions:"x-order=5"`
+	Followers       []PersonalUserEntry   `json:"followers" extensions:"x-order=6"`
+	FollowedThreads []PersonalThreadEntry `json:"followedThreads" extensions:"x-order=7"`
+}
+
+type PersonalProfile struct {
+	UserID       string `json:"userID" extensions:"x-order=0"`
+	Username     string `json:"username" extensions:"x-order=1"`
+	Email        string `json:"email" extensions:"x-order=2"`
+	Name         string `json:"name" extensions:"x-order=3"`
+	Bio          string `json:"bio" extensions:"x-order=4"`
+	AvatarURL    string `json:"avatarURL" extensions:"x-order=5"`
+	Location     string `json:"location" extensions:"x-order=6"`
+	Role         string `json:"role" extensions:"x-order=7"`
+	IsActive     bool   `json:"isActive" extensions:"x-order=8"`
+	IsVerified   bool   `json:"isVerified" extensions:"x-order=9"`
+	RegisteredAt string `json:"registeredAt" extensions:"x-order=10"`
+	UpdatedAt    string `json:"updatedAt" extensions:"x-order=11"`
+}
+
+type PersonalThread struct {
+	ID           string `json:"ID" extensions:"x-order=0"`
+	Title        string `json:"title" extensions:"x-order=1"`
+	Description  string `json:"description" extensions:"x-order=2"`
+	CategoryID   string `json:"categoryID" extensions:"x-order=3"`
+	CategoryName string `json:"categoryName" extensions:"x-order=4"`
+	PublishedAt  string `json:"publishedAt" extensions:"x-order=5"`
+	UpdatedAt    string `json:"updatedAt" extensions:"x-order=6"`
+}
+
+type PersonalComment struct {
+	ID          string `json:"ID" extensions:"x-order=0"`
+	ThreadID    string `json:"threadID" extensions:"x-order=1"`
+	ThreadTitle string `json:"threadTitle" extensions:"x-order=2"`
+	ParentID    string `json:"parentID" extensions:"x-order=3"`
+	Comment     string `json:"comment" extensions:"x-order=4"`
+	PublishedAt string `json:"publishedAt" extensions:"x-order=5"`
+	EditedAt    string `json:"editedAt" extensions:"x-order=6"`
+	DeletedAt   string `json:"deletedAt" extensions:"x-order=7"`
+}
+
+// PersonalThreadEntry is a like or a follow of a thread.
+type PersonalThreadEntry struct {
+	ThreadID    string `json:"threadID" extensions:"x-order=0"`
+	ThreadTitle string `json:"threadTitle" extensions:"x-order=1"`
+	CreatedAt   string `json:"createdAt" extensions:"x-order=2"`
+}
+
+// PersonalUserEntry is a followed or a following user.
+type PersonalUserEntry struct {
+	UserID    string `json:"userID" extensions:"x-order=0"`
+	Username  string `json:"username" extensions:"x-order=1"`
+	CreatedAt string `json:"createdAt" extensions:"x-order=2"`
+}
@@ -0,0 +1,6 @@
+package response
+
+type Role struct {
+	Name        string   `json:"name" extensions:"x-order=0"`
+	Permissions []string `json:"permissions" extensions:"x-order=1"`
+}
@@ -0,0 +1,51 @@
+package response
+
+type Stats struct {
+	// From and To layout format: 2006-01-02, To is inclusive
+	From     string `json:"from" extensions:"x-order=0"`
+	To       string `json:"to" extensions:"x-order=1"`
+	Interval string `json:"interval" extensions:"x-order=2"`
+	// Series is ordered by the start of the bucket, buckets without activity are included
+	Series          []StatsBucket   `json:"series" extensions:"x-order=3"`
+	TopCategories   []CategoryStats `json:"topCategories" extensions:"x-order=4"`
+	TopThreads      []ThreadStats   `json:"topThreads" extensions:"x-order=5"`
+	MostActiveUsers []UserStats     `json:"mostActiveUsers" extensions:"x-order=6"`
+}
+
+type StatsBucket struct {
+	// Start layout format: 2006-01-02, a week starts on Monday
+	Start      string `json:"start" extensions:"x-order=0"`
+	NewUser    uint   `json:"newUser" extensions:"x-order=1"`
+	NewThread  uint   `json:"newThread" extensions:"x-order=2"`
+	NewComment uint   `json:"newComment" extensions:"x-order=3"`
+	NewLike    uint   `json:"newLike" extensions:"x-order=4"`
+	NewReport  uint   `json:"newReport" extensions:"x-order=5"`
+}
+
+type CategoryStats struct {
+	ID        string `json:"ID" extensions:"x-order=0"`
+	Name      string `json:"name" extensions:"x-order=1"`
+	NewThread uint   `json:"newThread" extensions:"x-order=2"`
+}
+
+type ThreadStats struct {
+	ID              string `json:"ID" extensions:"x-order=0"`
+	Title           string `json:"title" extensions:"x-order=1"`
+	CreatorID       string `json:"creatorID" extensions:"x-order=2"`
+	CreatorUsername string `json:"creatorUsername" extensions:"x-order=3"`
+	CategoryID      string `json:"categoryID" extensions:"x-order=4"`
+	CategoryName    string `json:"categoryName" extensions:"x-order=5"`
+	// PublishedOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	PublishedOn string `json:"publishedOn" extensions:"x-order=6"`
+	NewComment  uint   `json:"newComment" extensions:"x-order=7"`
+	NewLike     uint   `json:"newLike" extensions:"x-order=8"`
+}
+
+type UserStats struct {
+	UserID     string `json:"userID" extensions:"x-order=0"`
+	Username   string `json:"username" extensions:"x-order=1"`
+	Name       string `json:"name" extensions:"x-order=2"`
+	Role       string `json:"role" extensions:"x-order=3"`
+	NewThread  uint   `json:"newThread" extensions:"x-order=4"`
+	NewComment uint   `json:"newComment" extensions:"x-order=5"`
+}
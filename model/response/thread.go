@@ -17,6 +17,17 @@ type ManyThread struct {
 	CreatorID       string `json:"creatorID" extensions:"x-order=12"`
 	CreatorUsername string `json:"creatorUsername" extensions:"x-order=13"`
 	CreatorName     string `json:"creatorName" extensions:"x-order=14"`
+	// Highlight is only present when searching
+	Highlight *ThreadHighlight `json:"highlight,omitempty" extensions:"x-order=15"`
+}
+
+// ThreadHighlight holds the search snippets as HTML, the text is escaped and the matched words are wrapped in <b></b>
+type ThreadHighlight struct {
+	Title       string `json:"title" extensions:"x-order=0"`
+	Description string `json:"description" extensions:"x-order=1"`
+	// CommentID and Comment are empty if no comment of the thread matches the search
+	CommentID string `json:"commentID" extensions:"x-order=2"`
+	Comment   string `json:"comment" extensions:"x-order=3"`
 }
 
 type Thread struct {
@@ -37,4 +48,7 @@ type Thread struct {
 	CreatorID       string      `json:"creatorID" extensions:"x-order=13"`
 	CreatorUsername string      `json:"creatorUsername" extensions:"x-order=14"`
 	CreatorName     string      `json:"creatorName" extensions:"x-order=15"`
+	IsLocked        bool        `json:"isLocked" extensions:"x-order=16"`
+	// PinnedCommentID is empty if no comment is pinned
+	PinnedCommentID string `json:"pinnedCommentID" extensions:"x-order=17"`
 }
@@ -13,4 +13,7 @@ type User struct {
 	TotalFollower  uint   `json:"totalFollower" extensions:"x-order=8"`
 	TotalFollowing uint   `json:"totalFollowing" extensions:"x-order=9"`
 	IsFollowed     bool   `json:"isFollowed" extensions:"x-order=10"`
+	Bio            string `json:"bio" extensions:"x-order=11"`
+	AvatarURL      string `json:"avatarURL" extensions:"x-order=12"`
+	Location       string `json:"location" extensions:"x-order=13"`
 }
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/counter"
+)
+
+// runReconcile handles the reconcile subcommand, it recomputes the counter columns that drifted from the rows they count.
+func runReconcile(ctx context.Context, db *sql.DB) error {
+	reconciliation, err := counter.NewCounterRepositoryImpl(db).Reconcile(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("reconciled the counters of %d threads and %d users\n", reconciliation.Threads, reconciliation.Users)
+	return nil
+}
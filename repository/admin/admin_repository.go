@@ -8,4 +8,29 @@ import (
 
 type AdminRepository interface {
 	FindDashboardInfo(ctx context.Context) (info entity.DashboardInfo, err error)
+
+	// FindStatsSeries returns one bucket per interval of the period, including the intervals without activity.
+	FindStatsSeries(ctx context.Context, period entity.StatsPeriod) (buckets []entity.StatsBucket, err error)
+
+	// FindTopCategories orders the categories by the number of threads created in the period.
+	FindTopCategories(ctx context.Context, period entity.StatsPeriod, limit uint) (categories []entity.CategoryStats, err error)
+
+	// FindTopThreads orders the threads by the number of comments and likes they received in the period.
+	FindTopThreads(ctx context.Context, period entity.StatsPeriod, limit uint) (threads []entity.ThreadStats, err error)
+
+	// FindMostActiveUsers orders the users by the number of threads and comments they created in the period.
+	FindMostActiveUsers(ctx context.Context, period entity.StatsPeriod, limit uint) (users []entity.UserStats, err error)
+
+	// StreamUsers calls fn for every user matching the filter, oldest first, without loading them all in memory.
+	// It stops at the first error returned by fn and returns it as is.
+	StreamUsers(ctx context.Context, filter entity.ExportFilter, fn func(user entity.User) error) (err error)
+
+	// StreamThreads works like StreamUsers for the threads.
+	StreamThreads(ctx context.Context, filter entity.ExportFilter, fn func(thread entity.Thread) error) (err error)
+
+	// StreamComments works like StreamUsers for the comments, the deleted comments included.
+	StreamComments(ctx context.Context, filter entity.ExportFilter, fn func(comment entity.Comment) error) (err error)
+
+	// StreamReports works like StreamUsers for the reports.
+	StreamReports(ctx context.Context, filter entity.ExportFilter, fn func(report entity.UserBanned) error) (err error)
 }
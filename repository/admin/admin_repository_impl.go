@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
@@ -17,6 +18,11 @@ func NewAdminRepositoryImpl(db *sql.DB) *adminRepositoryImpl {
 	return &adminRepositoryImpl{db: db}
 }
 
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (a *adminRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, a.db)
+}
+
 func (a *adminRepositoryImpl) FindDashboardInfo(ctx context.Context) (info entity.DashboardInfo, err error) {
 	statement := `SELECT (SELECT count(u.id)
         FROM users u)        AS total_user,
@@ -27,7 +33,7 @@ func (a *adminRepositoryImpl) FindDashboardInfo(ctx context.Context) (info entit
        (SELECT count(r.id)
         FROM user_banneds r) AS total_report;`
 
-	row := a.db.QueryRowContext(ctx, statement)
+	row := a.conn(ctx).QueryRowContext(ctx, statement)
 
 	switch dbErr := row.Scan(
 		&info.TotalUser,
@@ -52,3 +58,512 @@ func (a *adminRepositoryImpl) FindDashboardInfo(ctx context.Context) (info entit
 		}
 	}
 }
+
+func (a *adminRepositoryImpl) FindStatsSeries(
+	ctx context.Context,
+	period entity.StatsPeriod,
+) (buckets []entity.StatsBucket, err error) {
+	// Every bucket is clamped to the period, so a partial first or last bucket only counts the requested days.
+	statement := `SELECT b.start,
+       (SELECT count(u.id)
+        FROM users u
+        WHERE u.created_at >= greatest(b.start, $1) AND u.created_at < least(b.start + $4::interval, $2))  AS new_user,
+       (SELECT count(t.id)
+        FROM threads t
+        WHERE t.created_at >= greatest(b.start, $1) AND t.created_at < least(b.start + $4::interval, $2))  AS new_thread,
+       (SELECT count(c.id)
+        FROM comments c
+        WHERE c.deleted_at IS NULL
+          AND c.created_at >= greatest(b.start, $1) AND c.created_at < least(b.start + $4::interval, $2)) AS new_comment,
+       (SELECT count(l.id)
+        FROM likes l
+        WHERE l.created_at >= greatest(b.start, $1) AND l.created_at < least(b.start + $4::interval, $2))  AS new_like,
+       (SELECT count(r.id)
+        FROM user_banneds r
+        WHERE r.created_at >= greatest(b.start, $1) AND r.created_at < least(b.start + $4::interval, $2))  AS new_report
+FROM generate_series(date_trunc($3, $1::timestamp), $2::timestamp - interval '1 microsecond', $4::interval) AS b(start)
+ORDER BY b.start;`
+
+	unit := period.Interval.String()
+
+	rows, dbErr := a.conn(ctx).QueryContext(ctx, statement, period.From, period.To, unit, "1 "+unit)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	buckets = make([]entity.StatsBucket, 0)
+	for rows.Next() {
+		var bucket entity.StatsBucket
+		if dbErr := rows.Scan(
+			&bucket.Start,
+			&bucket.NewUser,
+			&bucket.NewThread,
+			&bucket.NewComment,
+			&bucket.NewLike,
+			&bucket.NewReport,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (a *adminRepositoryImpl) FindTopCategories(
+	ctx context.Context,
+	period entity.StatsPeriod,
+	limit uint,
+) (categories []entity.CategoryStats, err error) {
+	statement := `SELECT c.id, c.name, c.description, c.created_at, c.updated_at, count(t.id) AS new_thread
+FROM categories c
+         INNER JOIN threads t ON t.category_id = c.id
+WHERE t.created_at >= $1
+  AND t.created_at < $2
+GROUP BY c.id
+ORDER BY new_thread DESC, c.name
+LIMIT $3;`
+
+	rows, dbErr := a.conn(ctx).QueryContext(ctx, statement, period.From, period.To, limit)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	categories = make([]entity.CategoryStats, 0)
+	for rows.Next() {
+		var category entity.CategoryStats
+		if dbErr := rows.Scan(
+			&category.Category.ID,
+			&category.Category.Name,
+			&category.Category.Description,
+			&category.Category.CreatedAt,
+			&category.Category.UpdatedAt,
+			&category.NewThread,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		categories = append(categories, category)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (a *adminRepositoryImpl) FindTopThreads(
+	ctx context.Context,
+	period entity.StatsPeriod,
+	limit uint,
+) (threads []entity.ThreadStats, err error) {
+	statement := `SELECT t.id,
+       t.title,
+       u.id,
+       u.username,
+       ct.id,
+       ct.name,
+       t.created_at,
+       coalesce(cm.new_comment, 0) AS new_comment,
+       coalesce(l.new_like, 0)     AS new_like
+FROM threads t
+         INNER JOIN users u ON u.id = t.creator_id
+         INNER JOIN categories ct ON ct.id = t.category_id
+         LEFT JOIN (SELECT c.thread_id, count(c.id) AS new_comment
+                    FROM comments c
+                    WHERE c.deleted_at IS NULL
+                      AND c.created_at >= $1
+                      AND c.created_at < $2
+                    GROUP BY c.thread_id) cm ON cm.thread_id = t.id
+         LEFT JOIN (SELECT l.thread_id, count(l.id) AS new_like
+                    FROM likes l
+                    WHERE l.created_at >= $1
+                      AND l.created_at < $2
+                    GROUP BY l.thread_id) l ON l.thread_id = t.id
+WHERE cm.new_comment IS NOT NULL
+   OR l.new_like IS NOT NULL
+ORDER BY coalesce(cm.new_comment, 0) + coalesce(l.new_like, 0) DESC, t.created_at DESC
+LIMIT $3;`
+
+	rows, dbErr := a.conn(ctx).QueryContext(ctx, statement, period.From, period.To, limit)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	threads = make([]entity.ThreadStats, 0)
+	for rows.Next() {
+		var thread entity.ThreadStats
+		if dbErr := rows.Scan(
+			&thread.Thread.ID,
+			&thread.Thread.Title,
+			&thread.Thread.Creator.ID,
+			&thread.Thread.Creator.Username,
+			&thread.Thread.Category.ID,
+			&thread.Thread.Category.Name,
+			&thread.Thread.CreatedAt,
+			&thread.NewComment,
+			&thread.NewLike,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		threads = append(threads, thread)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (a *adminRepositoryImpl) FindMostActiveUsers(
+	ctx context.Context,
+	period entity.StatsPeriod,
+	limit uint,
+) (users []entity.UserStats, err error) {
+	statement := `SELECT u.id,
+       u.username,
+       u.name,
+       u.role,
+       coalesce(t.new_thread, 0)   AS new_thread,
+       coalesce(cm.new_comment, 0) AS new_comment
+FROM users u
+         LEFT JOIN (SELECT t.creator_id, count(t.id) AS new_thread
+                    FROM threads t
+                    WHERE t.created_at >= $1
+                      AND t.created_at < $2
+                    GROUP BY t.creator_id) t ON t.creator_id = u.id
+         LEFT JOIN (SELECT c.user_id, count(c.id) AS new_comment
+                    FROM comments c
+                    WHERE c.deleted_at IS NULL
+                      AND c.created_at >= $1
+                      AND c.created_at < $2
+                    GROUP BY c.user_id) cm ON cm.user_id = u.id
+WHERE t.new_thread IS NOT NULL
+   OR cm.new_comment IS NOT NULL
+ORDER BY coalesce(t.new_thread, 0) + coalesce(cm.new_comment, 0) DESC, u.username
+LIMIT $3;`
+
+	rows, dbErr := a.conn(ctx).QueryContext(ctx, statement, period.From, period.To, limit)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	users = make([]entity.UserStats, 0)
+	for rows.Next() {
+		var user entity.UserStats
+		if dbErr := rows.Scan(
+			&user.User.ID,
+			&user.User.Username,
+			&user.User.Name,
+			&user.User.Role,
+			&user.NewThread,
+			&user.NewComment,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		users = append(users, user)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (a *adminRepositoryImpl) StreamUsers(
+	ctx context.Context,
+	filter entity.ExportFilter,
+	fn func(user entity.User) error,
+) (err error) {
+	statement := `SELECT u.id,
+       u.username,
+       u.email,
+       u.name,
+       u.role,
+       u.is_active,
+       u.is_verified,
+       u.location,
+       u.total_thread,
+       u.created_at,
+       u.updated_at
+FROM users u
+WHERE ($1::timestamp IS NULL OR u.created_at >= $1)
+  AND ($2::timestamp IS NULL OR u.created_at < $2)
+ORDER BY u.created_at, u.id;`
+
+	err = a.stream(ctx, statement, filter, func(rows *sql.Rows) (scanErr error, fnErr error) {
+		var user entity.User
+		if scanErr = rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.Email,
+			&user.Name,
+			&user.Role,
+			&user.IsActive,
+			&user.IsVerified,
+			&user.Location,
+			&user.TotalThread,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); scanErr != nil {
+			return
+		}
+		fnErr = fn(user)
+		return
+	})
+	return
+}
+
+func (a *adminRepositoryImpl) StreamThreads(
+	ctx context.Context,
+	filter entity.ExportFilter,
+	fn func(thread entity.Thread) error,
+) (err error) {
+	statement := `SELECT t.id,
+       t.title,
+       u.id,
+       u.username,
+       c.id,
+       c.name,
+       t.total_viewer,
+       t.total_like,
+       t.total_comment,
+       t.locked_at,
+       t.created_at,
+       t.updated_at
+FROM threads t
+         INNER JOIN users u ON u.id = t.creator_id
+         INNER JOIN categories c ON c.id = t.category_id
+WHERE ($1::timestamp IS NULL OR t.created_at >= $1)
+  AND ($2::timestamp IS NULL OR t.created_at < $2)
+ORDER BY t.created_at, t.id;`
+
+	err = a.stream(ctx, statement, filter, func(rows *sql.Rows) (scanErr error, fnErr error) {
+		var thread entity.Thread
+		var lockedAt sql.NullTime
+		if scanErr = rows.Scan(
+			&thread.ID,
+			&thread.Title,
+			&thread.Creator.ID,
+			&thread.Creator.Username,
+			&thread.Category.ID,
+			&thread.Category.Name,
+			&thread.TotalViewer,
+			&thread.TotalLike,
+			&thread.TotalComment,
+			&lockedAt,
+			&thread.CreatedAt,
+			&thread.UpdatedAt,
+		); scanErr != nil {
+			return
+		}
+		thread.LockedAt = lockedAt.Time
+		fnErr = fn(thread)
+		return
+	})
+	return
+}
+
+func (a *adminRepositoryImpl) StreamComments(
+	ctx context.Context,
+	filter entity.ExportFilter,
+	fn func(comment entity.Comment) error,
+) (err error) {
+	statement := `SELECT c.id,
+       c.thread_id,
+       c.parent_id,
+       u.id,
+       u.username,
+       c.comment,
+       c.created_at,
+       c.updated_at,
+       c.edited_at,
+       c.deleted_at
+FROM comments c
+         INNER JOIN users u ON u.id = c.user_id
+WHERE ($1::timestamp IS NULL OR c.created_at >= $1)
+  AND ($2::timestamp IS NULL OR c.created_at < $2)
+ORDER BY c.created_at, c.id;`
+
+	err = a.stream(ctx, statement, filter, func(rows *sql.Rows) (scanErr error, fnErr error) {
+		var comment entity.Comment
+		var parentID sql.NullString
+		var editedAt, deletedAt sql.NullTime
+		if scanErr = rows.Scan(
+			&comment.ID,
+			&comment.Thread.ID,
+			&parentID,
+			&comment.User.ID,
+			&comment.User.Username,
+			&comment.Comment,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+			&editedAt,
+			&deletedAt,
+		); scanErr != nil {
+			return
+		}
+		comment.ParentID = parentID.String
+		comment.EditedAt = editedAt.Time
+		comment.DeletedAt = deletedAt.Time
+		fnErr = fn(comment)
+		return
+	})
+	return
+}
+
+func (a *adminRepositoryImpl) StreamReports(
+	ctx context.Context,
+	filter entity.ExportFilter,
+	fn func(report entity.UserBanned) error,
+) (err error) {
+	statement := `SELECT r.id,
+       m.id,
+       u1.id,
+       u1.username,
+       u2.id,
+       u2.username,
+       th.id,
+       th.title,
+       c.id,
+       c.comment,
+       r.reason,
+       r.status,
+       r.created_at,
+       r.updated_at
+FROM user_banneds r
+         INNER JOIN moderators m ON m.id = r.moderator_id
+         INNER JOIN users u1 ON u1.id = m.user_id
+         INNER JOIN users u2 ON u2.id = r.user_id
+         INNER JOIN comments c ON c.id = r.comment_id
+         INNER JOIN threads th ON th.id = c.thread_id
+WHERE ($1::timestamp IS NULL OR r.created_at >= $1)
+  AND ($2::timestamp IS NULL OR r.created_at < $2)
+ORDER BY r.created_at, r.id;`
+
+	err = a.stream(ctx, statement, filter, func(rows *sql.Rows) (scanErr error, fnErr error) {
+		var report entity.UserBanned
+		if scanErr = rows.Scan(
+			&report.ID,
+			&report.Moderator.ID,
+			&report.Moderator.User.ID,
+			&report.Moderator.User.Username,
+			&report.User.ID,
+			&report.User.Username,
+			&report.Thread.ID,
+			&report.Thread.Title,
+			&report.Comment.ID,
+			&report.Comment.Comment,
+			&report.Reason,
+			&report.Status,
+			&report.CreatedAt,
+			&report.UpdatedAt,
+		); scanErr != nil {
+			return
+		}
+		fnErr = fn(report)
+		return
+	})
+	return
+}
+
+// stream runs the statement with the bounds of the filter as $1 and $2 and calls next for every row.
+// A scan error is reported as repository.ErrDatabase, while an error of the callback is returned as is.
+func (a *adminRepositoryImpl) stream(
+	ctx context.Context,
+	statement string,
+	filter entity.ExportFilter,
+	next func(rows *sql.Rows) (scanErr error, fnErr error),
+) (err error) {
+	rows, dbErr := a.conn(ctx).QueryContext(ctx, statement, nullTime(filter.CreatedAfter), nullTime(filter.CreatedBefore))
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	for rows.Next() {
+		scanErr, fnErr := next(rows)
+		if scanErr != nil {
+			log.Println(scanErr)
+			err = repository.ErrDatabase
+			return
+		}
+		if fnErr != nil {
+			err = fnErr
+			return
+		}
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
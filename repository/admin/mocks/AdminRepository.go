@@ -35,6 +35,154 @@ func (_m *AdminRepository) FindDashboardInfo(ctx context.Context) (entity.Dashbo
 	return r0, r1
 }
 
+// FindMostActiveUsers provides a mock function with given fields: ctx, period, limit
+func (_m *AdminRepository) FindMostActiveUsers(ctx context.Context, period entity.StatsPeriod, limit uint) ([]entity.UserStats, error) {
+	ret := _m.Called(ctx, period, limit)
+
+	var r0 []entity.UserStats
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsPeriod, uint) []entity.UserStats); ok {
+		r0 = rf(ctx, period, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.UserStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsPeriod, uint) error); ok {
+		r1 = rf(ctx, period, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindStatsSeries provides a mock function with given fields: ctx, period
+func (_m *AdminRepository) FindStatsSeries(ctx context.Context, period entity.StatsPeriod) ([]entity.StatsBucket, error) {
+	ret := _m.Called(ctx, period)
+
+	var r0 []entity.StatsBucket
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsPeriod) []entity.StatsBucket); ok {
+		r0 = rf(ctx, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.StatsBucket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsPeriod) error); ok {
+		r1 = rf(ctx, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTopCategories provides a mock function with given fields: ctx, period, limit
+func (_m *AdminRepository) FindTopCategories(ctx context.Context, period entity.StatsPeriod, limit uint) ([]entity.CategoryStats, error) {
+	ret := _m.Called(ctx, period, limit)
+
+	var r0 []entity.CategoryStats
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsPeriod, uint) []entity.CategoryStats); ok {
+		r0 = rf(ctx, period, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CategoryStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsPeriod, uint) error); ok {
+		r1 = rf(ctx, period, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTopThreads provides a mock function with given fields: ctx, period, limit
+func (_m *AdminRepository) FindTopThreads(ctx context.Context, period entity.StatsPeriod, limit uint) ([]entity.ThreadStats, error) {
+	ret := _m.Called(ctx, period, limit)
+
+	var r0 []entity.ThreadStats
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsPeriod, uint) []entity.ThreadStats); ok {
+		r0 = rf(ctx, period, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ThreadStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsPeriod, uint) error); ok {
+		r1 = rf(ctx, period, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamComments provides a mock function with given fields: ctx, filter, fn
+func (_m *AdminRepository) StreamComments(ctx context.Context, filter entity.ExportFilter, fn func(entity.Comment) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ExportFilter, func(entity.Comment) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamReports provides a mock function with given fields: ctx, filter, fn
+func (_m *AdminRepository) StreamReports(ctx context.Context, filter entity.ExportFilter, fn func(entity.UserBanned) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ExportFilter, func(entity.UserBanned) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamThreads provides a mock function with given fields: ctx, filter, fn
+func (_m *AdminRepository) StreamThreads(ctx context.Context, filter entity.ExportFilter, fn func(entity.Thread) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ExportFilter, func(entity.Thread) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamUsers provides a mock function with given fields: ctx, filter, fn
+func (_m *AdminRepository) StreamUsers(ctx context.Context, filter entity.ExportFilter, fn func(entity.User) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ExportFilter, func(entity.User) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewAdminRepository interface {
 	mock.TestingT
 	Cleanup(func())
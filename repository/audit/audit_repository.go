@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type AuditRepository interface {
+	Insert(ctx context.Context, auditLog entity.AuditLog) (err error)
+
+	FindAllWithFilterAndPagination(
+		ctx context.Context,
+		filter entity.AuditFilter,
+		pageInfo entity.PageInfo,
+	) (pagination entity.Pagination[entity.AuditLog], err error)
+}
@@ -0,0 +1,179 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"math"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type auditRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewAuditRepositoryImpl(db *sql.DB) *auditRepositoryImpl {
+	return &auditRepositoryImpl{db: db}
+}
+
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (a *auditRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, a.db)
+}
+
+func (a *auditRepositoryImpl) Insert(ctx context.Context, auditLog entity.AuditLog) (err error) {
+	statement := `INSERT INTO audit_logs (id, actor_id, action, target_type, target_id, before, after, ip_address)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`
+
+	if _, dbErr := a.conn(ctx).ExecContext(
+		ctx,
+		statement,
+		auditLog.ID,
+		nullString(auditLog.Actor.ID),
+		auditLog.Action,
+		auditLog.TargetType,
+		auditLog.TargetID,
+		nullJSON(auditLog.Before),
+		nullJSON(auditLog.After),
+		auditLog.IPAddress,
+	); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (a *auditRepositoryImpl) FindAllWithFilterAndPagination(
+	ctx context.Context,
+	filter entity.AuditFilter,
+	pageInfo entity.PageInfo,
+) (pagination entity.Pagination[entity.AuditLog], err error) {
+	statement := `SELECT l.id,
+       coalesce(l.actor_id, '')  AS actor_id,
+       coalesce(u.username, '')  AS actor_username,
+       l.action,
+       l.target_type,
+       l.target_id,
+       l.before,
+       l.after,
+       l.ip_address,
+       l.created_at
+FROM audit_logs l
+         LEFT JOIN users u ON l.actor_id = u.id
+WHERE ($3::varchar = '' OR u.username = $3)
+  AND ($4::varchar = '' OR l.action = $4)
+  AND ($5::varchar = '' OR l.target_type = $5)
+  AND ($6::varchar = '' OR l.target_id = $6)
+  AND ($7::timestamp IS NULL OR l.created_at >= $7)
+  AND ($8::timestamp IS NULL OR l.created_at < $8)
+ORDER BY l.created_at DESC, l.id DESC
+OFFSET $1 LIMIT $2;`
+
+	rows, dbErr := a.conn(ctx).QueryContext(
+		ctx,
+		statement,
+		(pageInfo.Page-1)*pageInfo.Limit,
+		pageInfo.Limit*1,
+		filter.ActorUsername,
+		filter.Action,
+		filter.TargetType,
+		filter.TargetID,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+	)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	pagination.List = make([]entity.AuditLog, 0)
+	for rows.Next() {
+		var auditLog entity.AuditLog
+		if dbErr := rows.Scan(
+			&auditLog.ID,
+			&auditLog.Actor.ID,
+			&auditLog.Actor.Username,
+			&auditLog.Action,
+			&auditLog.TargetType,
+			&auditLog.TargetID,
+			&auditLog.Before,
+			&auditLog.After,
+			&auditLog.IPAddress,
+			&auditLog.CreatedAt,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		pagination.List = append(pagination.List, auditLog)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	countStatement := `SELECT count(l.id)
+FROM audit_logs l
+         LEFT JOIN users u ON l.actor_id = u.id
+WHERE ($1::varchar = '' OR u.username = $1)
+  AND ($2::varchar = '' OR l.action = $2)
+  AND ($3::varchar = '' OR l.target_type = $3)
+  AND ($4::varchar = '' OR l.target_id = $4)
+  AND ($5::timestamp IS NULL OR l.created_at >= $5)
+  AND ($6::timestamp IS NULL OR l.created_at < $6);`
+
+	row := a.conn(ctx).QueryRowContext(
+		ctx,
+		countStatement,
+		filter.ActorUsername,
+		filter.Action,
+		filter.TargetType,
+		filter.TargetID,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+	)
+
+	var count uint
+	switch dbErr := row.Scan(&count); dbErr {
+	case nil:
+		pagination.PageInfo.Limit = pageInfo.Limit
+		pagination.PageInfo.Page = pageInfo.Page
+		pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
+		pagination.PageInfo.Total = count
+	default:
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+
+	return
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// nullJSON stores an empty document as NULL.
+func nullJSON(value []byte) any {
+	if len(value) == 0 {
+		return nil
+	}
+	return string(value)
+}
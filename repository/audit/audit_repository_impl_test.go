@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo AuditRepository = NewAuditRepositoryImpl(db)
+
+	auditLog := entity.AuditLog{
+		ID:         "a-aBcDeFg",
+		Actor:      entity.User{ID: "u-aBcDeF"},
+		Action:     "category.delete",
+		TargetType: "category",
+		TargetID:   "c-aBcDeF",
+		Before:     []byte(`{"name":"Go"}`),
+		IPAddress:  "10.0.0.1",
+	}
+
+	t.Run("it should store an empty document as NULL, when there is no error", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
+			auditLog.ID,
+			sql.NullString{String: auditLog.Actor.ID, Valid: true},
+			auditLog.Action,
+			auditLog.TargetType,
+			auditLog.TargetID,
+			`{"name":"Go"}`,
+			nil,
+			auditLog.IPAddress,
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		gotError := repo.Insert(context.Background(), auditLog)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+
+	t.Run("it should return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO audit_logs").WillReturnError(sql.ErrConnDone)
+
+		gotError := repo.Insert(context.Background(), auditLog)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+}
+
+func TestFindAllWithFilterAndPagination(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo AuditRepository = NewAuditRepositoryImpl(db)
+
+	createdAfter := time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC)
+	filter := entity.AuditFilter{ActorUsername: "erikrios", CreatedAfter: createdAfter}
+	pageInfo := entity.PageInfo{Page: 2, Limit: 1}
+
+	t.Run("it should return the filtered audit logs, when there is no error", func(t *testing.T) {
+		returnedRows := sqlmock.NewRows([]string{
+			"id", "actor_id", "actor_username", "action", "target_type", "target_id", "before", "after", "ip_address", "created_at",
+		})
+		returnedRows.AddRow("a-aBcDeFg", "u-aBcDeF", "erikrios", "user.ban", "user", "u-gHiJkL", nil, []byte(`{"reason":"spam"}`), "10.0.0.1", time.Now())
+
+		mock.ExpectQuery("SELECT (.+) FROM audit_logs").
+			WithArgs(uint(1), uint(1), "erikrios", "", "", "", sql.NullTime{Time: createdAfter, Valid: true}, sql.NullTime{}).
+			WillReturnRows(returnedRows)
+		mock.ExpectQuery("SELECT count").
+			WithArgs("erikrios", "", "", "", sql.NullTime{Time: createdAfter, Valid: true}, sql.NullTime{}).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		gotPagination, gotError := repo.FindAllWithFilterAndPagination(context.Background(), filter, pageInfo)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		if assert.NoError(t, gotError) && assert.Len(t, gotPagination.List, 1) {
+			assert.Equal(t, "user.ban", gotPagination.List[0].Action)
+			assert.Nil(t, gotPagination.List[0].Before)
+			assert.Equal(t, `{"reason":"spam"}`, string(gotPagination.List[0].After))
+			assert.Equal(t, uint(2), gotPagination.PageInfo.PageTotal)
+			assert.Equal(t, uint(2), gotPagination.PageInfo.Total)
+		}
+	})
+
+	t.Run("it should return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM audit_logs").WillReturnError(sql.ErrConnDone)
+
+		_, gotError := repo.FindAllWithFilterAndPagination(context.Background(), filter, pageInfo)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+}
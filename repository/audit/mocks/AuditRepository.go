@@ -0,0 +1,65 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepository is an autogenerated mock type for the AuditRepository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+// FindAllWithFilterAndPagination provides a mock function with given fields: ctx, filter, pageInfo
+func (_m *AuditRepository) FindAllWithFilterAndPagination(ctx context.Context, filter entity.AuditFilter, pageInfo entity.PageInfo) (entity.Pagination[entity.AuditLog], error) {
+	ret := _m.Called(ctx, filter, pageInfo)
+
+	var r0 entity.Pagination[entity.AuditLog]
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuditFilter, entity.PageInfo) entity.Pagination[entity.AuditLog]); ok {
+		r0 = rf(ctx, filter, pageInfo)
+	} else {
+		r0 = ret.Get(0).(entity.Pagination[entity.AuditLog])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entity.AuditFilter, entity.PageInfo) error); ok {
+		r1 = rf(ctx, filter, pageInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, auditLog
+func (_m *AuditRepository) Insert(ctx context.Context, auditLog entity.AuditLog) error {
+	ret := _m.Called(ctx, auditLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuditLog) error); ok {
+		r0 = rf(ctx, auditLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAuditRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditRepository(t mockConstructorTestingTNewAuditRepository) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
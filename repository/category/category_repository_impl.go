@@ -17,10 +17,15 @@ func NewCategoryRepositoryImpl(db *sql.DB) *categoryRepositoryImpl {
 	return &categoryRepositoryImpl{db: db}
 }
 
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (c *categoryRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, c.db)
+}
+
 func (c *categoryRepositoryImpl) FindAll(ctx context.Context) (categories []entity.Category, err error) {
 	statement := "SELECT id, name, description, created_at, updated_at FROM categories;"
 
-	rows, dbErr := c.db.QueryContext(ctx, statement)
+	rows, dbErr := c.conn(ctx).QueryContext(ctx, statement)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -62,7 +67,7 @@ func (c *categoryRepositoryImpl) FindAll(ctx context.Context) (categories []enti
 func (c *categoryRepositoryImpl) FindByID(ctx context.Context, ID string) (category entity.Category, err error) {
 	statement := "SELECT id, name, description, created_at, updated_at FROM categories WHERE id = $1;"
 
-	row := c.db.QueryRowContext(ctx, statement, ID)
+	row := c.conn(ctx).QueryRowContext(ctx, statement, ID)
 
 	switch dbErr := row.Scan(&category.ID, &category.Name, &category.Description, &category.CreatedAt, &category.UpdatedAt); dbErr {
 	case sql.ErrNoRows:
@@ -86,7 +91,7 @@ func (c *categoryRepositoryImpl) FindByID(ctx context.Context, ID string) (categ
 func (c *categoryRepositoryImpl) Insert(ctx context.Context, category entity.Category) (err error) {
 	statement := "INSERT INTO categories(id, name, description) VALUES ($1, $2, $3);"
 
-	result, dbErr := c.db.ExecContext(ctx, statement, category.ID, category.Name, category.Description)
+	result, dbErr := c.conn(ctx).ExecContext(ctx, statement, category.ID, category.Name, category.Description)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -111,7 +116,7 @@ func (c *categoryRepositoryImpl) Insert(ctx context.Context, category entity.Cat
 func (c *categoryRepositoryImpl) Update(ctx context.Context, ID string, category entity.Category) (err error) {
 	statement := "UPDATE categories SET name = $2, description = $3, updated_at = current_timestamp WHERE id = $1;"
 
-	result, dbErr := c.db.ExecContext(ctx, statement, ID, category.Name, category.Description)
+	result, dbErr := c.conn(ctx).ExecContext(ctx, statement, ID, category.Name, category.Description)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -136,7 +141,7 @@ func (c *categoryRepositoryImpl) Update(ctx context.Context, ID string, category
 func (c *categoryRepositoryImpl) Delete(ctx context.Context, ID string) (err error) {
 	statement := "DELETE FROM categories WHERE id = $1;"
 
-	result, dbErr := c.db.ExecContext(ctx, statement, ID)
+	result, dbErr := c.conn(ctx).ExecContext(ctx, statement, ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
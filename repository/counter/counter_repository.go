@@ -0,0 +1,13 @@
+package counter
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type CounterRepository interface {
+	// Reconcile recomputes the counter columns of the threads and the users from the rows they count,
+	// only the rows that drifted are updated.
+	Reconcile(ctx context.Context) (reconciliation entity.CounterReconciliation, err error)
+}
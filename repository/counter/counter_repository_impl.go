@@ -0,0 +1,88 @@
+package counter
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type counterRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewCounterRepositoryImpl(db *sql.DB) *counterRepositoryImpl {
+	return &counterRepositoryImpl{db: db}
+}
+
+func (c *counterRepositoryImpl) Reconcile(ctx context.Context) (reconciliation entity.CounterReconciliation, err error) {
+	threadStatement := `UPDATE threads t
+SET total_follower = s.total_follower,
+    total_like     = s.total_like,
+    total_comment  = s.total_comment
+FROM (SELECT t.id,
+             (SELECT count(tf.id) FROM thread_follows tf WHERE tf.thread_id = t.id)                  AS total_follower,
+             (SELECT count(l.id) FROM likes l WHERE l.thread_id = t.id)                              AS total_like,
+             (SELECT count(cm.id) FROM comments cm WHERE cm.thread_id = t.id AND cm.deleted_at IS NULL) AS total_comment
+      FROM threads t) s
+WHERE t.id = s.id
+  AND (t.total_follower, t.total_like, t.total_comment) IS DISTINCT FROM (s.total_follower, s.total_like, s.total_comment);`
+
+	userStatement := `UPDATE users u
+SET total_thread    = s.total_thread,
+    total_follower  = s.total_follower,
+    total_following = s.total_following
+FROM (SELECT u.id,
+             (SELECT count(t.id) FROM threads t WHERE t.creator_id = u.id)           AS total_thread,
+             (SELECT count(uf.id) FROM user_follows uf WHERE uf.following_id = u.id) AS total_follower,
+             (SELECT count(uf.id) FROM user_follows uf WHERE uf.user_id = u.id)      AS total_following
+      FROM users u) s
+WHERE u.id = s.id
+  AND (u.total_thread, u.total_follower, u.total_following) IS DISTINCT FROM (s.total_thread, s.total_follower, s.total_following);`
+
+	tx, dbErr := repository.BeginTx(ctx, c.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	if reconciliation.Threads, err = execCount(ctx, tx, threadStatement); err != nil {
+		return
+	}
+
+	if reconciliation.Users, err = execCount(ctx, tx, userStatement); err != nil {
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+// execCount runs the statement in the transaction and returns the number of affected rows.
+func execCount(ctx context.Context, tx repository.DBTX, statement string) (count int64, err error) {
+	result, dbErr := tx.ExecContext(ctx, statement)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr = result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
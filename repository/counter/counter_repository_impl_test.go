@@ -0,0 +1,54 @@
+package counter
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo CounterRepository = NewCounterRepositoryImpl(db)
+
+	t.Run("it should return the number of corrected rows, when there is no error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE threads t").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec("UPDATE users u").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		gotReconciliation, gotError := repo.Reconcile(context.Background())
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.Equal(t, entity.CounterReconciliation{Threads: 3, Users: 2}, gotReconciliation)
+	})
+
+	t.Run("it should roll back and return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE threads t").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec("UPDATE users u").WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, gotError := repo.Reconcile(context.Background())
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CounterRepository is an autogenerated mock type for the CounterRepository type
+type CounterRepository struct {
+	mock.Mock
+}
+
+// Reconcile provides a mock function with given fields: ctx
+func (_m *CounterRepository) Reconcile(ctx context.Context) (entity.CounterReconciliation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 entity.CounterReconciliation
+	if rf, ok := ret.Get(0).(func(context.Context) entity.CounterReconciliation); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.CounterReconciliation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCounterRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCounterRepository creates a new instance of CounterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCounterRepository(t mockConstructorTestingTNewCounterRepository) *CounterRepository {
+	mock := &CounterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,16 @@
+package feed
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type FeedRepository interface {
+	FindAllByUserID(
+		ctx context.Context,
+		userID string,
+		cursor *entity.Cursor,
+		limit uint,
+	) (items []entity.FeedItem, err error)
+}
@@ -0,0 +1,147 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type feedRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewFeedRepositoryImpl(db *sql.DB) *feedRepositoryImpl {
+	return &feedRepositoryImpl{db: db}
+}
+
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (f *feedRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, f.db)
+}
+
+func (f *feedRepositoryImpl) FindAllByUserID(
+	ctx context.Context,
+	userID string,
+	cursor *entity.Cursor,
+	limit uint,
+) (items []entity.FeedItem, err error) {
+	statement := `WITH followed_users AS (SELECT uf.following_id AS id
+                        FROM user_follows uf
+                        WHERE uf.user_id = $1),
+     posted_categories AS (SELECT t.category_id AS id
+                           FROM threads t
+                           WHERE t.creator_id = $1
+                           UNION
+                           SELECT t.category_id
+                           FROM comments cm
+                                    INNER JOIN threads t on t.id = cm.thread_id
+                           WHERE cm.user_id = $1),
+     activity AS (SELECT 0 AS type, t.id AS item_id, t.id AS thread_id, t.created_at
+                  FROM threads t
+                  WHERE t.creator_id IN (SELECT id FROM followed_users)
+                  UNION ALL
+                  SELECT 1, cm.id, cm.thread_id, cm.created_at
+                  FROM comments cm
+                  WHERE cm.thread_id IN (SELECT tf.thread_id FROM thread_follows tf WHERE tf.user_id = $1)
+                    AND cm.user_id <> $1
+                    AND cm.deleted_at IS NULL
+                  UNION ALL
+                  SELECT 2, t.id, t.id, t.created_at
+                  FROM threads t
+                  WHERE t.category_id IN (SELECT id FROM posted_categories)
+                    AND t.creator_id <> $1
+                    AND t.creator_id NOT IN (SELECT id FROM followed_users))
+SELECT a.type,
+       a.item_id,
+       a.created_at,
+       t.id                       as thread_id,
+       t.title                    as thread_title,
+       t.description              as thread_description,
+       t.created_at               as thread_created_at,
+       t.creator_id,
+       tu.username                as creator_username,
+       tu.name                    as creator_name,
+       t.category_id,
+       c.name                     as category_name,
+       coalesce(cm.id, '')        as comment_id,
+       coalesce(cm.comment, '')   as comment,
+       coalesce(cm.parent_id, '') as comment_parent_id,
+       coalesce(cm.user_id, '')   as comment_user_id,
+       coalesce(cu.username, '')  as comment_username,
+       coalesce(cu.name, '')      as comment_name
+FROM activity a
+         INNER JOIN threads t on t.id = a.thread_id
+         INNER JOIN categories c on c.id = t.category_id
+         INNER JOIN users tu on tu.id = t.creator_id
+         LEFT JOIN comments cm on a.type = 1 AND cm.id = a.item_id
+         LEFT JOIN users cu on cu.id = cm.user_id
+WHERE $2::timestamp IS NULL
+   OR (a.created_at, a.item_id) < ($2, $3)
+ORDER BY a.created_at DESC, a.item_id DESC
+LIMIT $4;`
+
+	var cursorCreatedAt sql.NullTime
+	var cursorID string
+	if cursor != nil {
+		cursorCreatedAt = sql.NullTime{Time: cursor.CreatedAt, Valid: true}
+		cursorID = cursor.ID
+	}
+
+	rows, dbErr := f.conn(ctx).QueryContext(ctx, statement, userID, cursorCreatedAt, cursorID, limit)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	items = make([]entity.FeedItem, 0)
+	for rows.Next() {
+		var item entity.FeedItem
+		var itemType int
+		if dbErr := rows.Scan(
+			&itemType,
+			&item.ID,
+			&item.CreatedAt,
+			&item.Thread.ID,
+			&item.Thread.Title,
+			&item.Thread.Description,
+			&item.Thread.CreatedAt,
+			&item.Thread.Creator.ID,
+			&item.Thread.Creator.Username,
+			&item.Thread.Creator.Name,
+			&item.Thread.Category.ID,
+			&item.Thread.Category.Name,
+			&item.Comment.ID,
+			&item.Comment.Comment,
+			&item.Comment.ParentID,
+			&item.Comment.User.ID,
+			&item.Comment.User.Username,
+			&item.Comment.User.Name,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		item.Type = entity.FeedItemType(itemType)
+		if item.Type == entity.FollowedThreadComment {
+			item.Comment.CreatedAt = item.CreatedAt
+		}
+		items = append(items, item)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+
+	return
+}
@@ -0,0 +1,54 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedRepository is an autogenerated mock type for the FeedRepository type
+type FeedRepository struct {
+	mock.Mock
+}
+
+// FindAllByUserID provides a mock function with given fields: ctx, userID, cursor, limit
+func (_m *FeedRepository) FindAllByUserID(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) ([]entity.FeedItem, error) {
+	ret := _m.Called(ctx, userID, cursor, limit)
+
+	var r0 []entity.FeedItem
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entity.Cursor, uint) []entity.FeedItem); ok {
+		r0 = rf(ctx, userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.FeedItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *entity.Cursor, uint) error); ok {
+		r1 = rf(ctx, userID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewFeedRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFeedRepository creates a new instance of FeedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFeedRepository(t mockConstructorTestingTNewFeedRepository) *FeedRepository {
+	mock := &FeedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
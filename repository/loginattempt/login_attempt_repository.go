@@ -0,0 +1,40 @@
+package loginattempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type LoginAttemptRepository interface {
+	// FindLockedUntil returns the latest lockout end of the username and the IP address, it's zero when neither is locked.
+	FindLockedUntil(
+		ctx context.Context,
+		username string,
+		ipAddress string,
+	) (lockedUntil time.Time, err error)
+
+	FindAllLocked(ctx context.Context, now time.Time) (attempts []entity.LoginAttempt, err error)
+
+	// IncrementFailure counts a failed login, the count restarts when both the previous failure and the lockout ended before the given time.
+	IncrementFailure(
+		ctx context.Context,
+		kind entity.LoginAttemptKind,
+		value string,
+		since time.Time,
+	) (failedAttempts uint, err error)
+
+	Lock(
+		ctx context.Context,
+		kind entity.LoginAttemptKind,
+		value string,
+		until time.Time,
+	) (err error)
+
+	Delete(
+		ctx context.Context,
+		kind entity.LoginAttemptKind,
+		value string,
+	) (err error)
+}
@@ -0,0 +1,196 @@
+package loginattempt
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type loginAttemptRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewLoginAttemptRepositoryImpl(db *sql.DB) *loginAttemptRepositoryImpl {
+	return &loginAttemptRepositoryImpl{db: db}
+}
+
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (l *loginAttemptRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, l.db)
+}
+
+func (l *loginAttemptRepositoryImpl) FindLockedUntil(
+	ctx context.Context,
+	username string,
+	ipAddress string,
+) (lockedUntil time.Time, err error) {
+	statement := `SELECT max(locked_until)
+FROM login_attempts
+WHERE (kind = 'username' AND value = $1)
+   OR (kind = 'ip' AND value = $2);`
+
+	var nullLockedUntil sql.NullTime
+
+	row := l.conn(ctx).QueryRowContext(ctx, statement, username, ipAddress)
+	if dbErr := row.Scan(&nullLockedUntil); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	lockedUntil = nullLockedUntil.Time
+	return
+}
+
+func (l *loginAttemptRepositoryImpl) FindAllLocked(
+	ctx context.Context,
+	now time.Time,
+) (attempts []entity.LoginAttempt, err error) {
+	statement := `SELECT kind, value, failed_attempts, last_failed_at, locked_until
+FROM login_attempts
+WHERE locked_until > $1
+ORDER BY locked_until DESC;`
+
+	rows, dbErr := l.conn(ctx).QueryContext(ctx, statement, now)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer rows.Close()
+
+	attempts = make([]entity.LoginAttempt, 0)
+
+	for rows.Next() {
+		var attempt entity.LoginAttempt
+		var kind string
+
+		if dbErr := rows.Scan(
+			&kind,
+			&attempt.Value,
+			&attempt.FailedAttempts,
+			&attempt.LastFailedAt,
+			&attempt.LockedUntil,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+
+		attempt.Kind = stringToLoginAttemptKind(kind)
+		attempts = append(attempts, attempt)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (l *loginAttemptRepositoryImpl) IncrementFailure(
+	ctx context.Context,
+	kind entity.LoginAttemptKind,
+	value string,
+	since time.Time,
+) (failedAttempts uint, err error) {
+	// The upsert is atomic, so the concurrent failures are all counted.
+	statement := `INSERT INTO login_attempts(kind, value, failed_attempts, last_failed_at)
+VALUES ($1, $2, 1, $3)
+ON CONFLICT (kind, value) DO UPDATE
+    SET failed_attempts = CASE
+                              WHEN greatest(login_attempts.last_failed_at, login_attempts.locked_until) < $4 THEN 1
+                              ELSE login_attempts.failed_attempts + 1
+        END,
+        last_failed_at  = excluded.last_failed_at
+RETURNING failed_attempts;`
+
+	row := l.conn(ctx).QueryRowContext(ctx, statement, loginAttemptKindToString(kind), value, time.Now(), since)
+	if dbErr := row.Scan(&failedAttempts); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (l *loginAttemptRepositoryImpl) Lock(
+	ctx context.Context,
+	kind entity.LoginAttemptKind,
+	value string,
+	until time.Time,
+) (err error) {
+	statement := "UPDATE login_attempts SET locked_until = $1 WHERE kind = $2 AND value = $3;"
+
+	result, dbErr := l.conn(ctx).ExecContext(ctx, statement, until, loginAttemptKindToString(kind), value)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count, dbErr := result.RowsAffected(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	} else {
+		if count < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+
+	return
+}
+
+func (l *loginAttemptRepositoryImpl) Delete(
+	ctx context.Context,
+	kind entity.LoginAttemptKind,
+	value string,
+) (err error) {
+	statement := "DELETE FROM login_attempts WHERE kind = $1 AND value = $2;"
+
+	result, dbErr := l.conn(ctx).ExecContext(ctx, statement, loginAttemptKindToString(kind), value)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count, dbErr := result.RowsAffected(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	} else {
+		if count < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+
+	return
+}
+
+func loginAttemptKindToString(kind entity.LoginAttemptKind) (value string) {
+	switch kind {
+	case entity.IPLoginAttempt:
+		value = "ip"
+	default:
+		value = "username"
+	}
+	return
+}
+
+func stringToLoginAttemptKind(value string) (kind entity.LoginAttemptKind) {
+	switch value {
+	case "ip":
+		kind = entity.IPLoginAttempt
+	default:
+		kind = entity.UsernameLoginAttempt
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package loginattempt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindLockedUntil(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo LoginAttemptRepository = NewLoginAttemptRepositoryImpl(db)
+
+	t.Run("it should return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectQuery(".*").WithArgs("erikrios", "10.0.0.1").WillReturnError(repository.ErrDatabase)
+
+		_, gotError := repo.FindLockedUntil(context.Background(), "erikrios", "10.0.0.1")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+
+	t.Run("it should return zero time, when neither the username nor the IP address is locked", func(t *testing.T) {
+		returnedRows := sqlmock.NewRows([]string{"max"})
+		returnedRows.AddRow(nil)
+		mock.ExpectQuery(".*").WithArgs("erikrios", "10.0.0.1").WillReturnRows(returnedRows)
+
+		gotLockedUntil, gotError := repo.FindLockedUntil(context.Background(), "erikrios", "10.0.0.1")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.True(t, gotLockedUntil.IsZero())
+	})
+
+	t.Run("it should return the latest lockout end, when the username or the IP address is locked", func(t *testing.T) {
+		lockedUntil := time.Now().Add(time.Minute)
+		returnedRows := sqlmock.NewRows([]string{"max"})
+		returnedRows.AddRow(lockedUntil)
+		mock.ExpectQuery(".*").WithArgs("erikrios", "10.0.0.1").WillReturnRows(returnedRows)
+
+		gotLockedUntil, gotError := repo.FindLockedUntil(context.Background(), "erikrios", "10.0.0.1")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.True(t, lockedUntil.Equal(gotLockedUntil))
+	})
+}
+
+func TestIncrementFailure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo LoginAttemptRepository = NewLoginAttemptRepositoryImpl(db)
+
+	t.Run("it should return the failed attempts count, when database successfully upsert the attempt", func(t *testing.T) {
+		since := time.Now().Add(-time.Minute * 15)
+		returnedRows := sqlmock.NewRows([]string{"failed_attempts"})
+		returnedRows.AddRow(3)
+		mock.ExpectQuery("INSERT INTO login_attempts").WithArgs(
+			"ip",
+			"10.0.0.1",
+			sqlmock.AnyArg(),
+			since,
+		).WillReturnRows(returnedRows)
+
+		gotFailedAttempts, gotError := repo.IncrementFailure(context.Background(), entity.IPLoginAttempt, "10.0.0.1", since)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.Equal(t, uint(3), gotFailedAttempts)
+	})
+}
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LoginAttemptRepository is an autogenerated mock type for the LoginAttemptRepository type
+type LoginAttemptRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, kind, value
+func (_m *LoginAttemptRepository) Delete(ctx context.Context, kind entity.LoginAttemptKind, value string) error {
+	ret := _m.Called(ctx, kind, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoginAttemptKind, string) error); ok {
+		r0 = rf(ctx, kind, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAllLocked provides a mock function with given fields: ctx, now
+func (_m *LoginAttemptRepository) FindAllLocked(ctx context.Context, now time.Time) ([]entity.LoginAttempt, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []entity.LoginAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.LoginAttempt); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoginAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLockedUntil provides a mock function with given fields: ctx, username, ipAddress
+func (_m *LoginAttemptRepository) FindLockedUntil(ctx context.Context, username string, ipAddress string) (time.Time, error) {
+	ret := _m.Called(ctx, username, ipAddress)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) time.Time); ok {
+		r0 = rf(ctx, username, ipAddress)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, ipAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementFailure provides a mock function with given fields: ctx, kind, value, since
+func (_m *LoginAttemptRepository) IncrementFailure(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) (uint, error) {
+	ret := _m.Called(ctx, kind, value, since)
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoginAttemptKind, string, time.Time) uint); ok {
+		r0 = rf(ctx, kind, value, since)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entity.LoginAttemptKind, string, time.Time) error); ok {
+		r1 = rf(ctx, kind, value, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lock provides a mock function with given fields: ctx, kind, value, until
+func (_m *LoginAttemptRepository) Lock(ctx context.Context, kind entity.LoginAttemptKind, value string, until time.Time) error {
+	ret := _m.Called(ctx, kind, value, until)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.LoginAttemptKind, string, time.Time) error); ok {
+		r0 = rf(ctx, kind, value, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewLoginAttemptRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewLoginAttemptRepository creates a new instance of LoginAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewLoginAttemptRepository(t mockConstructorTestingTNewLoginAttemptRepository) *LoginAttemptRepository {
+	mock := &LoginAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// NewInlineTxManager returns a TxManager that runs the function of WithinTx directly with the given context,
+// for the tests of the services that don't assert the transactions.
+func NewInlineTxManager() *TxManager {
+	mockTxManager := &TxManager{}
+	mockTxManager.On("WithinTx", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	return mockTxManager
+}
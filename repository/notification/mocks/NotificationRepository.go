@@ -0,0 +1,114 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CountUnreadByUserID provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) CountUnreadByUserID(ctx context.Context, userID string) (uint, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func(context.Context, string) uint); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllByUserIDWithPagination provides a mock function with given fields: ctx, userID, pageInfo
+func (_m *NotificationRepository) FindAllByUserIDWithPagination(ctx context.Context, userID string, pageInfo entity.PageInfo) (entity.Pagination[entity.Notification], error) {
+	ret := _m.Called(ctx, userID, pageInfo)
+
+	var r0 entity.Pagination[entity.Notification]
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.PageInfo) entity.Pagination[entity.Notification]); ok {
+		r0 = rf(ctx, userID, pageInfo)
+	} else {
+		r0 = ret.Get(0).(entity.Pagination[entity.Notification])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.PageInfo) error); ok {
+		r1 = rf(ctx, userID, pageInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertAll provides a mock function with given fields: ctx, notifications
+func (_m *NotificationRepository) InsertAll(ctx context.Context, notifications []entity.Notification) error {
+	ret := _m.Called(ctx, notifications)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Notification) error); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkAllAsRead provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) MarkAllAsRead(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkAsRead provides a mock function with given fields: ctx, ID, userID
+func (_m *NotificationRepository) MarkAsRead(ctx context.Context, ID string, userID string) error {
+	ret := _m.Called(ctx, ID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, ID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewNotificationRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNotificationRepository(t mockConstructorTestingTNewNotificationRepository) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type NotificationRepository interface {
+	InsertAll(
+		ctx context.Context,
+		notifications []entity.Notification,
+	) (err error)
+
+	FindAllByUserIDWithPagination(
+		ctx context.Context,
+		userID string,
+		pageInfo entity.PageInfo,
+	) (pagination entity.Pagination[entity.Notification], err error)
+
+	CountUnreadByUserID(
+		ctx context.Context,
+		userID string,
+	) (count uint, err error)
+
+	MarkAsRead(
+		ctx context.Context,
+		ID string,
+		userID string,
+	) (err error)
+
+	MarkAllAsRead(
+		ctx context.Context,
+		userID string,
+	) (err error)
+}
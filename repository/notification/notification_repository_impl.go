@@ -0,0 +1,268 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"math"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type notificationRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewNotificationRepositoryImpl(db *sql.DB) *notificationRepositoryImpl {
+	return &notificationRepositoryImpl{db: db}
+}
+
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (n *notificationRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, n.db)
+}
+
+func (n *notificationRepositoryImpl) InsertAll(
+	ctx context.Context,
+	notifications []entity.Notification,
+) (err error) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	tx, dbErr := repository.BeginTx(ctx, n.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	statement := "INSERT INTO notifications(id, user_id, actor_id, type, thread_id, comment_id) VALUES ($1, $2, $3, $4, $5, $6);"
+
+	for _, notification := range notifications {
+		if _, dbErr := tx.ExecContext(
+			ctx,
+			statement,
+			notification.ID,
+			notification.User.ID,
+			notification.Actor.ID,
+			notificationTypeToString(notification.Type),
+			nullString(notification.Thread.ID),
+			nullString(notification.Comment.ID),
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (n *notificationRepositoryImpl) FindAllByUserIDWithPagination(
+	ctx context.Context,
+	userID string,
+	pageInfo entity.PageInfo,
+) (pagination entity.Pagination[entity.Notification], err error) {
+	statement := `SELECT n.id,
+       n.type,
+       n.is_read,
+       n.created_at,
+       n.updated_at,
+       n.actor_id,
+       a.username               as actor_username,
+       a.name                   as actor_name,
+       coalesce(n.thread_id, '')  as thread_id,
+       coalesce(t.title, '')      as thread_title,
+       coalesce(n.comment_id, '') as comment_id
+FROM notifications n
+         INNER JOIN users a on a.id = n.actor_id
+         LEFT JOIN threads t on t.id = n.thread_id
+WHERE n.user_id = $1
+ORDER BY n.created_at DESC, n.id DESC
+OFFSET $2 LIMIT $3;`
+
+	rows, dbErr := n.conn(ctx).QueryContext(ctx, statement, userID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	pagination.List = make([]entity.Notification, 0)
+	for rows.Next() {
+		var notification entity.Notification
+		var notificationType string
+		if dbErr := rows.Scan(
+			&notification.ID,
+			&notificationType,
+			&notification.IsRead,
+			&notification.CreatedAt,
+			&notification.UpdatedAt,
+			&notification.Actor.ID,
+			&notification.Actor.Username,
+			&notification.Actor.Name,
+			&notification.Thread.ID,
+			&notification.Thread.Title,
+			&notification.Comment.ID,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		notification.User.ID = userID
+		notification.Type = stringToNotificationType(notificationType)
+		pagination.List = append(pagination.List, notification)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	countStatement := "SELECT count(id) FROM notifications WHERE user_id = $1;"
+
+	row := n.conn(ctx).QueryRowContext(ctx, countStatement, userID)
+
+	var count uint
+	switch dbErr := row.Scan(&count); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			pagination.PageInfo.Limit = pageInfo.Limit
+			pagination.PageInfo.Page = pageInfo.Page
+			pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
+			pagination.PageInfo.Total = count
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func (n *notificationRepositoryImpl) CountUnreadByUserID(
+	ctx context.Context,
+	userID string,
+) (count uint, err error) {
+	statement := "SELECT count(id) FROM notifications WHERE user_id = $1 AND is_read = false;"
+
+	row := n.conn(ctx).QueryRowContext(ctx, statement, userID)
+
+	switch dbErr := row.Scan(&count); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func (n *notificationRepositoryImpl) MarkAsRead(
+	ctx context.Context,
+	ID string,
+	userID string,
+) (err error) {
+	statement := "UPDATE notifications SET is_read = true, updated_at = current_timestamp WHERE id = $1 AND user_id = $2;"
+
+	result, dbErr := n.conn(ctx).ExecContext(ctx, statement, ID, userID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	return
+}
+
+func (n *notificationRepositoryImpl) MarkAllAsRead(
+	ctx context.Context,
+	userID string,
+) (err error) {
+	statement := "UPDATE notifications SET is_read = true, updated_at = current_timestamp WHERE user_id = $1 AND is_read = false;"
+
+	if _, dbErr := n.conn(ctx).ExecContext(ctx, statement, userID); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func notificationTypeToString(notificationType entity.NotificationType) (value string) {
+	switch notificationType {
+	case entity.FollowNotification:
+		value = "follow"
+	case entity.LikeNotification:
+		value = "like"
+	case entity.ModeratorNotification:
+		value = "moderator"
+	default:
+		value = "comment"
+	}
+	return
+}
+
+func stringToNotificationType(value string) (notificationType entity.NotificationType) {
+	switch value {
+	case "follow":
+		notificationType = entity.FollowNotification
+	case "like":
+		notificationType = entity.LikeNotification
+	case "moderator":
+		notificationType = entity.ModeratorNotification
+	default:
+		notificationType = entity.CommentNotification
+	}
+	return
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
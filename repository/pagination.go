@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+// KeysetArgs returns the arguments of the keyset condition of a listing statement: the direction, which is empty in
+// page mode, "next" or "prev", and the sort key, the creation time and the ID of the cursor.
+func KeysetArgs(pageInfo entity.PageInfo) (direction string, key int64, createdAt sql.NullTime, ID string) {
+	if pageInfo.Cursor == nil {
+		return
+	}
+
+	direction = "next"
+	if pageInfo.Cursor.Backward {
+		direction = "prev"
+	}
+
+	key = pageInfo.Cursor.Key
+	createdAt = sql.NullTime{Time: pageInfo.Cursor.CreatedAt, Valid: true}
+	ID = pageInfo.Cursor.ID
+	return
+}
+
+// OffsetLimit returns the offset and the limit of a listing statement.
+// In keyset mode an extra item is fetched to know whether there is another page.
+func OffsetLimit(pageInfo entity.PageInfo) (offset uint, limit uint) {
+	if pageInfo.Cursor != nil {
+		return 0, pageInfo.Limit + 1
+	}
+
+	return (pageInfo.Page - 1) * pageInfo.Limit, pageInfo.Limit
+}
+
+// SetCursors sets the cursors of the pagination from its first and last items. In keyset mode it also drops the
+// extra item fetched by OffsetLimit and restores the order of a backward listing, which is fetched in reverse.
+// A nil cursorOf means the listing doesn't support cursors.
+func SetCursors[T entity.Entity](
+	pagination *entity.Pagination[T],
+	pageInfo entity.PageInfo,
+	cursorOf func(item T) entity.Cursor,
+) {
+	hasNext := pageInfo.Page < pagination.PageInfo.PageTotal
+	hasPrev := pageInfo.Page > 1
+
+	if pageInfo.Cursor != nil {
+		hasMore := uint(len(pagination.List)) > pageInfo.Limit
+		if hasMore {
+			pagination.List = pagination.List[:pageInfo.Limit]
+		}
+
+		if pageInfo.Cursor.Backward {
+			for i, j := 0, len(pagination.List)-1; i < j; i, j = i+1, j-1 {
+				pagination.List[i], pagination.List[j] = pagination.List[j], pagination.List[i]
+			}
+			hasNext, hasPrev = true, hasMore
+		} else {
+			hasNext, hasPrev = hasMore, true
+		}
+
+		pagination.PageInfo = entity.PageInfo{Limit: pageInfo.Limit}
+	}
+
+	if cursorOf == nil || len(pagination.List) == 0 {
+		return
+	}
+
+	if hasNext {
+		next := cursorOf(pagination.List[len(pagination.List)-1])
+		pagination.NextCursor = &next
+	}
+
+	if hasPrev {
+		prev := cursorOf(pagination.List[0])
+		prev.Backward = true
+		pagination.PrevCursor = &prev
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCursors(t *testing.T) {
+	now := time.Now()
+
+	cursorOf := func(comment entity.Comment) entity.Cursor {
+		return entity.Cursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+	}
+
+	comments := func(IDs ...string) []entity.Comment {
+		list := make([]entity.Comment, len(IDs))
+		for i, ID := range IDs {
+			list[i] = entity.Comment{ID: ID, CreatedAt: now}
+		}
+		return list
+	}
+
+	testCases := []struct {
+		name               string
+		inputPagination    entity.Pagination[entity.Comment]
+		inputPageInfo      entity.PageInfo
+		expectedPagination entity.Pagination[entity.Comment]
+	}{
+		{
+			name: "it should set both cursors, when the page is in the middle",
+			inputPagination: entity.Pagination[entity.Comment]{
+				List:     comments("c-1", "c-2"),
+				PageInfo: entity.PageInfo{Limit: 2, Page: 2, PageTotal: 3, Total: 6},
+			},
+			inputPageInfo: entity.PageInfo{Limit: 2, Page: 2},
+			expectedPagination: entity.Pagination[entity.Comment]{
+				List:       comments("c-1", "c-2"),
+				PageInfo:   entity.PageInfo{Limit: 2, Page: 2, PageTotal: 3, Total: 6},
+				NextCursor: &entity.Cursor{CreatedAt: now, ID: "c-2"},
+				PrevCursor: &entity.Cursor{CreatedAt: now, ID: "c-1", Backward: true},
+			},
+		},
+		{
+			name: "it should drop the extra item and set both cursors, when there are more items after the cursor",
+			inputPagination: entity.Pagination[entity.Comment]{
+				List: comments("c-3", "c-4", "c-5"),
+			},
+			inputPageInfo: entity.PageInfo{Limit: 2, Cursor: &entity.Cursor{CreatedAt: now, ID: "c-2"}},
+			expectedPagination: entity.Pagination[entity.Comment]{
+				List:       comments("c-3", "c-4"),
+				PageInfo:   entity.PageInfo{Limit: 2},
+				NextCursor: &entity.Cursor{CreatedAt: now, ID: "c-4"},
+				PrevCursor: &entity.Cursor{CreatedAt: now, ID: "c-3", Backward: true},
+			},
+		},
+		{
+			name: "it should restore the order and only set the next cursor, when the backward listing reaches the start",
+			inputPagination: entity.Pagination[entity.Comment]{
+				List: comments("c-2", "c-1"),
+			},
+			inputPageInfo: entity.PageInfo{Limit: 2, Cursor: &entity.Cursor{CreatedAt: now, ID: "c-3", Backward: true}},
+			expectedPagination: entity.Pagination[entity.Comment]{
+				List:       comments("c-1", "c-2"),
+				PageInfo:   entity.PageInfo{Limit: 2},
+				NextCursor: &entity.Cursor{CreatedAt: now, ID: "c-2"},
+			},
+		},
+		{
+			name: "it should not set any cursor, when the list is empty",
+			inputPagination: entity.Pagination[entity.Comment]{
+				List: comments(),
+			},
+			inputPageInfo: entity.PageInfo{Limit: 2, Cursor: &entity.Cursor{CreatedAt: now, ID: "c-9"}},
+			expectedPagination: entity.Pagination[entity.Comment]{
+				List:     comments(),
+				PageInfo: entity.PageInfo{Limit: 2},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pagination := testCase.inputPagination
+
+			SetCursors(&pagination, testCase.inputPageInfo, cursorOf)
+
+			assert.Equal(t, testCase.expectedPagination, pagination)
+		})
+	}
+}
@@ -14,6 +14,27 @@ type ReportRepository struct {
 	mock.Mock
 }
 
+// FindByID provides a mock function with given fields: ctx, ID
+func (_m *ReportRepository) FindByID(ctx context.Context, ID string) (entity.UserBanned, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 entity.UserBanned
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.UserBanned); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Get(0).(entity.UserBanned)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReportsWithPagination provides a mock function with given fields: ctx, pageInfo, reportStatus
 func (_m *ReportRepository) GetReportsWithPagination(ctx context.Context, pageInfo entity.PageInfo, reportStatus entity.ReportStatus) (entity.Pagination[entity.UserBanned], error) {
 	ret := _m.Called(ctx, pageInfo, reportStatus)
@@ -49,6 +70,20 @@ func (_m *ReportRepository) Insert(ctx context.Context, ID string, moderatorID s
 	return r0
 }
 
+// UpdateStatus provides a mock function with given fields: ctx, ID, reportStatus
+func (_m *ReportRepository) UpdateStatus(ctx context.Context, ID string, reportStatus entity.ReportStatus) error {
+	ret := _m.Called(ctx, ID, reportStatus)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.ReportStatus) error); ok {
+		r0 = rf(ctx, ID, reportStatus)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewReportRepository interface {
 	mock.TestingT
 	Cleanup(func())
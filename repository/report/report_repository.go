@@ -21,4 +21,15 @@ type ReportRepository interface {
 		commentID,
 		reason string,
 	) (err error)
+
+	FindByID(
+		ctx context.Context,
+		ID string,
+	) (userBanned entity.UserBanned, err error)
+
+	UpdateStatus(
+		ctx context.Context,
+		ID string,
+		reportStatus entity.ReportStatus,
+	) (err error)
 }
@@ -18,6 +18,11 @@ func NewReportRepositoryImpl(db *sql.DB) *reportRepositoryImpl {
 	return &reportRepositoryImpl{db: db}
 }
 
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (r *reportRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, r.db)
+}
+
 func (r *reportRepositoryImpl) GetReportsWithPagination(
 	ctx context.Context,
 	pageInfo entity.PageInfo,
@@ -53,14 +58,9 @@ FROM user_banneds t
 WHERE t.status = $1
 OFFSET $2 LIMIT $3;`
 
-	var status string
-	if reportStatus == entity.Accepted {
-		status = "accepted"
-	} else {
-		status = "review"
-	}
+	status := reportStatusToString(reportStatus)
 
-	rows, dbErr := r.db.QueryContext(ctx, statement, status, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1)
+	rows, dbErr := r.conn(ctx).QueryContext(ctx, statement, status, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -114,7 +114,7 @@ OFFSET $2 LIMIT $3;`
 
 	countStatement := "SELECT count(t.id) FROM user_banneds t WHERE status = $1;"
 
-	row := r.db.QueryRowContext(ctx, countStatement, status)
+	row := r.conn(ctx).QueryRowContext(ctx, countStatement, status)
 
 	var count uint
 	switch dbErr := row.Scan(&count); dbErr {
@@ -150,7 +150,7 @@ func (r *reportRepositoryImpl) Insert(
 ) (err error) {
 	statement := "INSERT INTO user_banneds (id, moderator_id, user_id, comment_id, reason) VALUES ($1, $2, $3, $4, $5);"
 
-	result, dbErr := r.db.ExecContext(ctx, statement, ID, moderatorID, userID, commentID, reason)
+	result, dbErr := r.conn(ctx).ExecContext(ctx, statement, ID, moderatorID, userID, commentID, reason)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -172,3 +172,124 @@ func (r *reportRepositoryImpl) Insert(
 
 	return
 }
+
+func (r *reportRepositoryImpl) FindByID(
+	ctx context.Context,
+	ID string,
+) (userBanned entity.UserBanned, err error) {
+	statement := `SELECT t.id           AS report_id,
+       t.moderator_id AS moderator_id,
+       m.user_id      AS moderator_user_id,
+       u1.username    AS moderator_username,
+       u1.name        AS moderator_name,
+       m.thread_id    AS moderator_thread_id,
+       m.created_at   AS moderator_created_at,
+       m.updated_at   AS moderator_updated_at,
+       t.user_id      AS reported_user_id,
+       u2.username    AS reported_username,
+       u2.name        AS reported_name,
+       th.id          AS thread_id,
+       th.title       AS thread_title,
+       c.id           AS comment_id,
+       c.comment      AS comment,
+       c.created_at   AS comment_created_at,
+       c.updated_at   AS comment_updated_at,
+       t.reason       AS reason,
+       t.status       AS status,
+       t.created_at   AS created_at,
+       t.updated_at   AS updated_at
+FROM user_banneds t
+         INNER JOIN moderators m on m.id = t.moderator_id
+         INNER JOIN users u1 on m.user_id = u1.id
+         INNER JOIN users u2 on t.user_id = u2.id
+         INNER JOIN comments c on c.id = t.comment_id
+         INNER JOIN threads th on c.thread_id = th.id
+WHERE t.id = $1;`
+
+	row := r.conn(ctx).QueryRowContext(ctx, statement, ID)
+
+	switch dbErr := row.Scan(
+		&userBanned.ID,
+		&userBanned.Moderator.ID,
+		&userBanned.Moderator.User.ID,
+		&userBanned.Moderator.User.Username,
+		&userBanned.Moderator.User.Name,
+		&userBanned.Moderator.ThreadID,
+		&userBanned.Moderator.CreatedAt,
+		&userBanned.Moderator.UpdatedAt,
+		&userBanned.User.ID,
+		&userBanned.User.Username,
+		&userBanned.User.Name,
+		&userBanned.Thread.ID,
+		&userBanned.Thread.Title,
+		&userBanned.Comment.ID,
+		&userBanned.Comment.Comment,
+		&userBanned.Comment.CreatedAt,
+		&userBanned.Comment.UpdatedAt,
+		&userBanned.Reason,
+		&userBanned.Status,
+		&userBanned.CreatedAt,
+		&userBanned.UpdatedAt,
+	); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func (r *reportRepositoryImpl) UpdateStatus(
+	ctx context.Context,
+	ID string,
+	reportStatus entity.ReportStatus,
+) (err error) {
+	statement := `UPDATE user_banneds
+SET status     = $2,
+    updated_at = current_timestamp
+WHERE id = $1
+  AND status = 'review';`
+
+	result, dbErr := r.conn(ctx).ExecContext(ctx, statement, ID, reportStatusToString(reportStatus))
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	return
+}
+
+func reportStatusToString(reportStatus entity.ReportStatus) (status string) {
+	switch reportStatus {
+	case entity.Accepted:
+		status = "accepted"
+	case entity.Rejected:
+		status = "rejected"
+	default:
+		status = "review"
+	}
+	return
+}
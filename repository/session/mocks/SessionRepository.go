@@ -0,0 +1,116 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SessionRepository is an autogenerated mock type for the SessionRepository type
+type SessionRepository struct {
+	mock.Mock
+}
+
+// FindByID provides a mock function with given fields: ctx, ID
+func (_m *SessionRepository) FindByID(ctx context.Context, ID string) (entity.Session, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 entity.Session
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Session); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Get(0).(entity.Session)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByRefreshTokenHash provides a mock function with given fields: ctx, refreshTokenHash
+func (_m *SessionRepository) FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (entity.Session, error) {
+	ret := _m.Called(ctx, refreshTokenHash)
+
+	var r0 entity.Session
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Session); ok {
+		r0 = rf(ctx, refreshTokenHash)
+	} else {
+		r0 = ret.Get(0).(entity.Session)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshTokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1
+func (_m *SessionRepository) Insert(ctx context.Context, _a1 entity.Session) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Session) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Revoke provides a mock function with given fields: ctx, ID
+func (_m *SessionRepository) Revoke(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Rotate provides a mock function with given fields: ctx, ID, refreshTokenHash, newRefreshTokenHash, expiresAt
+func (_m *SessionRepository) Rotate(ctx context.Context, ID string, refreshTokenHash string, newRefreshTokenHash string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, ID, refreshTokenHash, newRefreshTokenHash, expiresAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
+		r0 = rf(ctx, ID, refreshTokenHash, newRefreshTokenHash, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewSessionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSessionRepository creates a new instance of SessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSessionRepository(t mockConstructorTestingTNewSessionRepository) *SessionRepository {
+	mock := &SessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type SessionRepository interface {
+	Insert(ctx context.Context, session entity.Session) (err error)
+
+	FindByID(ctx context.Context, ID string) (session entity.Session, err error)
+
+	FindByRefreshTokenHash(
+		ctx context.Context,
+		refreshTokenHash string,
+	) (session entity.Session, err error)
+
+	Rotate(
+		ctx context.Context,
+		ID string,
+		refreshTokenHash string,
+		newRefreshTokenHash string,
+		expiresAt time.Time,
+	) (err error)
+
+	Revoke(ctx context.Context, ID string) (err error)
+}
@@ -0,0 +1,251 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type sessionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewSessionRepositoryImpl(db *sql.DB) *sessionRepositoryImpl {
+	return &sessionRepositoryImpl{db: db}
+}
+
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (s *sessionRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, s.db)
+}
+
+func (s *sessionRepositoryImpl) Insert(ctx context.Context, session entity.Session) (err error) {
+	tx, dbErr := repository.BeginTx(ctx, s.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	// Only one session is kept alive per device, so the previous session of the same device is revoked.
+	if _, dbErr := tx.ExecContext(
+		ctx,
+		`UPDATE sessions
+SET revoked_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE user_id = $1
+  AND device = $2
+  AND revoked_at IS NULL;`,
+		session.User.ID,
+		session.Device,
+	); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	result, dbErr := tx.ExecContext(
+		ctx,
+		"INSERT INTO sessions(id, user_id, device, refresh_token_hash, token_version, expires_at) VALUES ($1, $2, $3, $4, $5, $6);",
+		session.ID,
+		session.User.ID,
+		session.Device,
+		session.RefreshTokenHash,
+		session.TokenVersion,
+		session.ExpiresAt,
+	)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrDatabase
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (s *sessionRepositoryImpl) FindByID(ctx context.Context, ID string) (session entity.Session, err error) {
+	statement := `SELECT s.id,
+       s.user_id,
+       s.device,
+       s.refresh_token_hash,
+       s.previous_refresh_token_hash,
+       s.token_version,
+       s.expires_at,
+       s.revoked_at,
+       s.created_at,
+       s.updated_at,
+       u.username,
+       u.role,
+       u.is_active,
+       u.token_version
+FROM sessions s
+         INNER JOIN users u on u.id = s.user_id
+WHERE s.id = $1;`
+
+	row := s.conn(ctx).QueryRowContext(ctx, statement, ID)
+
+	session, err = s.scan(row)
+	return
+}
+
+func (s *sessionRepositoryImpl) FindByRefreshTokenHash(
+	ctx context.Context,
+	refreshTokenHash string,
+) (session entity.Session, err error) {
+	// The previous hash is matched too, so the reuse of a rotated refresh token can be detected.
+	statement := `SELECT s.id,
+       s.user_id,
+       s.device,
+       s.refresh_token_hash,
+       s.previous_refresh_token_hash,
+       s.token_version,
+       s.expires_at,
+       s.revoked_at,
+       s.created_at,
+       s.updated_at,
+       u.username,
+       u.role,
+       u.is_active,
+       u.token_version
+FROM sessions s
+         INNER JOIN users u on u.id = s.user_id
+WHERE s.refresh_token_hash = $1
+   OR s.previous_refresh_token_hash = $1
+LIMIT 1;`
+
+	row := s.conn(ctx).QueryRowContext(ctx, statement, refreshTokenHash)
+
+	session, err = s.scan(row)
+	return
+}
+
+func (s *sessionRepositoryImpl) Rotate(
+	ctx context.Context,
+	ID string,
+	refreshTokenHash string,
+	newRefreshTokenHash string,
+	expiresAt time.Time,
+) (err error) {
+	statement := `UPDATE sessions
+SET previous_refresh_token_hash = refresh_token_hash,
+    refresh_token_hash          = $3,
+    expires_at                  = $4,
+    updated_at                  = current_timestamp
+WHERE id = $1
+  AND refresh_token_hash = $2
+  AND revoked_at IS NULL;`
+
+	result, dbErr := s.conn(ctx).ExecContext(ctx, statement, ID, refreshTokenHash, newRefreshTokenHash, expiresAt)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	return
+}
+
+func (s *sessionRepositoryImpl) Revoke(ctx context.Context, ID string) (err error) {
+	statement := `UPDATE sessions
+SET revoked_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE id = $1
+  AND revoked_at IS NULL;`
+
+	result, dbErr := s.conn(ctx).ExecContext(ctx, statement, ID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	return
+}
+
+func (s *sessionRepositoryImpl) scan(row *sql.Row) (session entity.Session, err error) {
+	var previousRefreshTokenHash sql.NullString
+	var revokedAt sql.NullTime
+
+	switch dbErr := row.Scan(
+		&session.ID,
+		&session.User.ID,
+		&session.Device,
+		&session.RefreshTokenHash,
+		&previousRefreshTokenHash,
+		&session.TokenVersion,
+		&session.ExpiresAt,
+		&revokedAt,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+		&session.User.Username,
+		&session.User.Role,
+		&session.User.IsActive,
+		&session.User.TokenVersion,
+	); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			session.PreviousRefreshTokenHash = previousRefreshTokenHash.String
+			session.RevokedAt = revokedAt.Time
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ThreadRepository is an autogenerated mock type for the ThreadRepository type
@@ -28,6 +30,20 @@ func (_m *ThreadRepository) Delete(ctx context.Context, ID string) error {
 	return r0
 }
 
+// DeleteComment provides a mock function with given fields: ctx, ID
+func (_m *ThreadRepository) DeleteComment(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteFollowThread provides a mock function with given fields: ctx, threadFollow
 func (_m *ThreadRepository) DeleteFollowThread(ctx context.Context, threadFollow entity.ThreadFollow) error {
 	ret := _m.Called(ctx, threadFollow)
@@ -70,20 +86,55 @@ func (_m *ThreadRepository) DeleteModerator(ctx context.Context, moderator entit
 	return r0
 }
 
-// FindAllByCategoryIDWithPagination provides a mock function with given fields: ctx, accessorUserID, categoryID, pageInfo
-func (_m *ThreadRepository) FindAllByCategoryIDWithPagination(ctx context.Context, accessorUserID string, categoryID string, pageInfo entity.PageInfo) (entity.Pagination[entity.Thread], error) {
-	ret := _m.Called(ctx, accessorUserID, categoryID, pageInfo)
+// DeleteMute provides a mock function with given fields: ctx, threadID, userID, now, moderationLog
+func (_m *ThreadRepository) DeleteMute(ctx context.Context, threadID string, userID string, now time.Time, moderationLog entity.ModerationLog) error {
+	ret := _m.Called(ctx, threadID, userID, now, moderationLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, entity.ModerationLog) error); ok {
+		r0 = rf(ctx, threadID, userID, now, moderationLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindActiveMute provides a mock function with given fields: ctx, threadID, userID, now
+func (_m *ThreadRepository) FindActiveMute(ctx context.Context, threadID string, userID string, now time.Time) (entity.ThreadMute, error) {
+	ret := _m.Called(ctx, threadID, userID, now)
+
+	var r0 entity.ThreadMute
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) entity.ThreadMute); ok {
+		r0 = rf(ctx, threadID, userID, now)
+	} else {
+		r0 = ret.Get(0).(entity.ThreadMute)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, threadID, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllByCategoryIDWithPagination provides a mock function with given fields: ctx, accessorUserID, categoryID, sort, filter, pageInfo
+func (_m *ThreadRepository) FindAllByCategoryIDWithPagination(ctx context.Context, accessorUserID string, categoryID string, sort entity.ThreadSort, filter entity.ThreadFilter, pageInfo entity.PageInfo) (entity.Pagination[entity.Thread], error) {
+	ret := _m.Called(ctx, accessorUserID, categoryID, sort, filter, pageInfo)
 
 	var r0 entity.Pagination[entity.Thread]
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.PageInfo) entity.Pagination[entity.Thread]); ok {
-		r0 = rf(ctx, accessorUserID, categoryID, pageInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.ThreadSort, entity.ThreadFilter, entity.PageInfo) entity.Pagination[entity.Thread]); ok {
+		r0 = rf(ctx, accessorUserID, categoryID, sort, filter, pageInfo)
 	} else {
 		r0 = ret.Get(0).(entity.Pagination[entity.Thread])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.PageInfo) error); ok {
-		r1 = rf(ctx, accessorUserID, categoryID, pageInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.ThreadSort, entity.ThreadFilter, entity.PageInfo) error); ok {
+		r1 = rf(ctx, accessorUserID, categoryID, sort, filter, pageInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -91,20 +142,20 @@ func (_m *ThreadRepository) FindAllByCategoryIDWithPagination(ctx context.Contex
 	return r0, r1
 }
 
-// FindAllByUserIDWithPagination provides a mock function with given fields: ctx, accessorUserID, UserID, pageInfo
-func (_m *ThreadRepository) FindAllByUserIDWithPagination(ctx context.Context, accessorUserID string, UserID string, pageInfo entity.PageInfo) (entity.Pagination[entity.Thread], error) {
-	ret := _m.Called(ctx, accessorUserID, UserID, pageInfo)
+// FindAllByUserIDWithPagination provides a mock function with given fields: ctx, accessorUserID, UserID, sort, filter, pageInfo
+func (_m *ThreadRepository) FindAllByUserIDWithPagination(ctx context.Context, accessorUserID string, UserID string, sort entity.ThreadSort, filter entity.ThreadFilter, pageInfo entity.PageInfo) (entity.Pagination[entity.Thread], error) {
+	ret := _m.Called(ctx, accessorUserID, UserID, sort, filter, pageInfo)
 
 	var r0 entity.Pagination[entity.Thread]
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.PageInfo) entity.Pagination[entity.Thread]); ok {
-		r0 = rf(ctx, accessorUserID, UserID, pageInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.ThreadSort, entity.ThreadFilter, entity.PageInfo) entity.Pagination[entity.Thread]); ok {
+		r0 = rf(ctx, accessorUserID, UserID, sort, filter, pageInfo)
 	} else {
 		r0 = ret.Get(0).(entity.Pagination[entity.Thread])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.PageInfo) error); ok {
-		r1 = rf(ctx, accessorUserID, UserID, pageInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.ThreadSort, entity.ThreadFilter, entity.PageInfo) error); ok {
+		r1 = rf(ctx, accessorUserID, UserID, sort, filter, pageInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -133,6 +184,50 @@ func (_m *ThreadRepository) FindAllCommentByThreadID(ctx context.Context, thread
 	return r0, r1
 }
 
+// FindAllFollowerIDByThreadID provides a mock function with given fields: ctx, threadID
+func (_m *ThreadRepository) FindAllFollowerIDByThreadID(ctx context.Context, threadID string) ([]string, error) {
+	ret := _m.Called(ctx, threadID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, threadID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllModerationLogByThreadID provides a mock function with given fields: ctx, threadID, pageInfo
+func (_m *ThreadRepository) FindAllModerationLogByThreadID(ctx context.Context, threadID string, pageInfo entity.PageInfo) (entity.Pagination[entity.ModerationLog], error) {
+	ret := _m.Called(ctx, threadID, pageInfo)
+
+	var r0 entity.Pagination[entity.ModerationLog]
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.PageInfo) entity.Pagination[entity.ModerationLog]); ok {
+		r0 = rf(ctx, threadID, pageInfo)
+	} else {
+		r0 = ret.Get(0).(entity.Pagination[entity.ModerationLog])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.PageInfo) error); ok {
+		r1 = rf(ctx, threadID, pageInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindAllModeratorByThreadID provides a mock function with given fields: ctx, threadID
 func (_m *ThreadRepository) FindAllModeratorByThreadID(ctx context.Context, threadID string) ([]entity.Moderator, error) {
 	ret := _m.Called(ctx, threadID)
@@ -156,20 +251,64 @@ func (_m *ThreadRepository) FindAllModeratorByThreadID(ctx context.Context, thre
 	return r0, r1
 }
 
-// FindAllWithQueryAndPagination provides a mock function with given fields: ctx, accessorUserID, query, pageInfo
-func (_m *ThreadRepository) FindAllWithQueryAndPagination(ctx context.Context, accessorUserID string, query string, pageInfo entity.PageInfo) (entity.Pagination[entity.Thread], error) {
-	ret := _m.Called(ctx, accessorUserID, query, pageInfo)
+// FindAllReplyByRootCommentIDs provides a mock function with given fields: ctx, rootCommentIDs, depth
+func (_m *ThreadRepository) FindAllReplyByRootCommentIDs(ctx context.Context, rootCommentIDs []string, depth uint) ([]entity.Comment, error) {
+	ret := _m.Called(ctx, rootCommentIDs, depth)
+
+	var r0 []entity.Comment
+	if rf, ok := ret.Get(0).(func(context.Context, []string, uint) []entity.Comment); ok {
+		r0 = rf(ctx, rootCommentIDs, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Comment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, uint) error); ok {
+		r1 = rf(ctx, rootCommentIDs, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllRootCommentByThreadID provides a mock function with given fields: ctx, threadID, pageInfo
+func (_m *ThreadRepository) FindAllRootCommentByThreadID(ctx context.Context, threadID string, pageInfo entity.PageInfo) (entity.Pagination[entity.Comment], error) {
+	ret := _m.Called(ctx, threadID, pageInfo)
+
+	var r0 entity.Pagination[entity.Comment]
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.PageInfo) entity.Pagination[entity.Comment]); ok {
+		r0 = rf(ctx, threadID, pageInfo)
+	} else {
+		r0 = ret.Get(0).(entity.Pagination[entity.Comment])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.PageInfo) error); ok {
+		r1 = rf(ctx, threadID, pageInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllWithQueryAndPagination provides a mock function with given fields: ctx, accessorUserID, query, sort, filter, pageInfo
+func (_m *ThreadRepository) FindAllWithQueryAndPagination(ctx context.Context, accessorUserID string, query string, sort entity.ThreadSort, filter entity.ThreadFilter, pageInfo entity.PageInfo) (entity.Pagination[entity.Thread], error) {
+	ret := _m.Called(ctx, accessorUserID, query, sort, filter, pageInfo)
 
 	var r0 entity.Pagination[entity.Thread]
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.PageInfo) entity.Pagination[entity.Thread]); ok {
-		r0 = rf(ctx, accessorUserID, query, pageInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.ThreadSort, entity.ThreadFilter, entity.PageInfo) entity.Pagination[entity.Thread]); ok {
+		r0 = rf(ctx, accessorUserID, query, sort, filter, pageInfo)
 	} else {
 		r0 = ret.Get(0).(entity.Pagination[entity.Thread])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.PageInfo) error); ok {
-		r1 = rf(ctx, accessorUserID, query, pageInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.ThreadSort, entity.ThreadFilter, entity.PageInfo) error); ok {
+		r1 = rf(ctx, accessorUserID, query, sort, filter, pageInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,13 +358,13 @@ func (_m *ThreadRepository) FindCommentByID(ctx context.Context, ID string) (ent
 	return r0, r1
 }
 
-// IncrementTotalViewer provides a mock function with given fields: ID
-func (_m *ThreadRepository) IncrementTotalViewer(ID string) error {
-	ret := _m.Called(ID)
+// IncrementTotalViewers provides a mock function with given fields: ctx, views
+func (_m *ThreadRepository) IncrementTotalViewers(ctx context.Context, views map[string]uint64) error {
+	ret := _m.Called(ctx, views)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(ID)
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]uint64) error); ok {
+		r0 = rf(ctx, views)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -317,6 +456,62 @@ func (_m *ThreadRepository) Update(ctx context.Context, ID string, _a2 entity.Th
 	return r0
 }
 
+// UpdateComment provides a mock function with given fields: ctx, ID, comment
+func (_m *ThreadRepository) UpdateComment(ctx context.Context, ID string, comment entity.Comment) error {
+	ret := _m.Called(ctx, ID, comment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Comment) error); ok {
+		r0 = rf(ctx, ID, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLock provides a mock function with given fields: ctx, ID, lockedAt, moderationLog
+func (_m *ThreadRepository) UpdateLock(ctx context.Context, ID string, lockedAt time.Time, moderationLog entity.ModerationLog) error {
+	ret := _m.Called(ctx, ID, lockedAt, moderationLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, entity.ModerationLog) error); ok {
+		r0 = rf(ctx, ID, lockedAt, moderationLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePinnedComment provides a mock function with given fields: ctx, ID, commentID, moderationLog
+func (_m *ThreadRepository) UpdatePinnedComment(ctx context.Context, ID string, commentID string, moderationLog entity.ModerationLog) error {
+	ret := _m.Called(ctx, ID, commentID, moderationLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.ModerationLog) error); ok {
+		r0 = rf(ctx, ID, commentID, moderationLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertMute provides a mock function with given fields: ctx, mute, moderationLog
+func (_m *ThreadRepository) UpsertMute(ctx context.Context, mute entity.ThreadMute, moderationLog entity.ModerationLog) error {
+	ret := _m.Called(ctx, mute, moderationLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ThreadMute, entity.ModerationLog) error); ok {
+		r0 = rf(ctx, mute, moderationLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewThreadRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -2,6 +2,7 @@ package thread
 
 import (
 	"context"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 )
@@ -13,6 +14,8 @@ type ThreadRepository interface {
 		ctx context.Context,
 		accessorUserID string,
 		query string,
+		sort entity.ThreadSort,
+		filter entity.ThreadFilter,
 		pageInfo entity.PageInfo,
 	) (pagination entity.Pagination[entity.Thread], err error)
 
@@ -20,6 +23,8 @@ type ThreadRepository interface {
 		ctx context.Context,
 		accessorUserID string,
 		categoryID string,
+		sort entity.ThreadSort,
+		filter entity.ThreadFilter,
 		pageInfo entity.PageInfo,
 	) (pagination entity.Pagination[entity.Thread], err error)
 
@@ -27,6 +32,8 @@ type ThreadRepository interface {
 		ctx context.Context,
 		accessorUserID string,
 		UserID string,
+		sort entity.ThreadSort,
+		filter entity.ThreadFilter,
 		pageInfo entity.PageInfo,
 	) (pagination entity.Pagination[entity.Thread], err error)
 
@@ -52,17 +59,45 @@ type ThreadRepository interface {
 		threadID string,
 	) (moderators []entity.Moderator, err error)
 
+	FindAllFollowerIDByThreadID(
+		ctx context.Context,
+		threadID string,
+	) (userIDs []string, err error)
+
 	FindAllCommentByThreadID(
 		ctx context.Context,
 		threadID string,
 		pageInfo entity.PageInfo,
 	) (pagination entity.Pagination[entity.Comment], err error)
 
+	FindAllRootCommentByThreadID(
+		ctx context.Context,
+		threadID string,
+		pageInfo entity.PageInfo,
+	) (pagination entity.Pagination[entity.Comment], err error)
+
+	FindAllReplyByRootCommentIDs(
+		ctx context.Context,
+		rootCommentIDs []string,
+		depth uint,
+	) (replies []entity.Comment, err error)
+
 	InsertComment(
 		ctx context.Context,
 		comment entity.Comment,
 	) (err error)
 
+	UpdateComment(
+		ctx context.Context,
+		ID string,
+		comment entity.Comment,
+	) (err error)
+
+	DeleteComment(
+		ctx context.Context,
+		ID string,
+	) (err error)
+
 	InsertFollowThread(
 		ctx context.Context,
 		threadFollow entity.ThreadFollow,
@@ -93,12 +128,56 @@ type ThreadRepository interface {
 		moderator entity.Moderator,
 	) (err error)
 
-	IncrementTotalViewer(
-		ID string,
+	// IncrementTotalViewers adds the views to the total viewer of the threads in a single statement,
+	// views maps the thread ID to its number of new views. The deleted threads are skipped.
+	IncrementTotalViewers(
+		ctx context.Context,
+		views map[string]uint64,
 	) (err error)
 
 	FindCommentByID(
 		ctx context.Context,
 		ID string,
 	) (comment entity.Comment, err error)
+
+	UpdateLock(
+		ctx context.Context,
+		ID string,
+		lockedAt time.Time,
+		moderationLog entity.ModerationLog,
+	) (err error)
+
+	UpdatePinnedComment(
+		ctx context.Context,
+		ID string,
+		commentID string,
+		moderationLog entity.ModerationLog,
+	) (err error)
+
+	UpsertMute(
+		ctx context.Context,
+		mute entity.ThreadMute,
+		moderationLog entity.ModerationLog,
+	) (err error)
+
+	DeleteMute(
+		ctx context.Context,
+		threadID string,
+		userID string,
+		now time.Time,
+		moderationLog entity.ModerationLog,
+	) (err error)
+
+	FindActiveMute(
+		ctx context.Context,
+		threadID string,
+		userID string,
+		now time.Time,
+	) (mute entity.ThreadMute, err error)
+
+	FindAllModerationLogByThreadID(
+		ctx context.Context,
+		threadID string,
+		pageInfo entity.PageInfo,
+	) (pagination entity.Pagination[entity.ModerationLog], err error)
 }
@@ -3,12 +3,13 @@ package thread
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"log"
 	"math"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/lib/pq"
 )
 
 type threadRepositoryImpl struct {
@@ -19,29 +20,22 @@ func NewThreadRepositoryImpl(db *sql.DB) *threadRepositoryImpl {
 	return &threadRepositoryImpl{db: db}
 }
 
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (t *threadRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, t.db)
+}
+
 func (t *threadRepositoryImpl) Insert(ctx context.Context, thread entity.Thread) (err error) {
 	statement := "INSERT INTO threads(id, title, description, creator_id, category_id) VALUES ($1, $2, $3, $4, $5);"
 
-	result, dbErr := t.db.ExecContext(ctx, statement, thread.ID, thread.Title, thread.Description, thread.Creator.ID, thread.Category.ID)
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
-	}
-
-	count, dbErr := result.RowsAffected()
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
-	}
-
-	if count < 1 {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
-	}
-
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{thread.ID, thread.Title, thread.Description, thread.Creator.ID, thread.Category.ID},
+		"UPDATE users SET total_thread = total_thread + 1 WHERE id = $1;",
+		thread.Creator.ID,
+	)
 	return
 }
 
@@ -49,13 +43,19 @@ func (t *threadRepositoryImpl) FindAllWithQueryAndPagination(
 	ctx context.Context,
 	accessorUserID string,
 	query string,
+	sort entity.ThreadSort,
+	filter entity.ThreadFilter,
 	pageInfo entity.PageInfo,
 ) (pagination entity.Pagination[entity.Thread], err error) {
-	var rows *sql.Rows
-	var dbErr error
+	if query != "" {
+		pagination, err = t.search(ctx, accessorUserID, query, sort, filter, pageInfo)
+		return
+	}
 
-	if query == "" {
-		statement := `SELECT t.id                                                                        as thread_id,
+	// In keyset mode the threads are compared with the cursor by the sort key, the creation time and the ID,
+	// a backward listing is fetched in reverse and restored by repository.SetCursors.
+	statement := `SELECT th.*
+FROM (SELECT t.id                                                                        as thread_id,
        t.title                                                                                     as thread_title,
        t.description                                                                               as thread_description,
        t.total_viewer,
@@ -83,69 +83,325 @@ func (t *threadRepositoryImpl) FindAllWithQueryAndPagination(
         FROM thread_follows
         WHERE thread_follows.user_id = $1
           AND thread_follows.thread_id = t.id)                                                     as is_followed,
-       (SELECT count(thread_follows.id) FROM thread_follows WHERE thread_follows.thread_id = t.id) as total_follower,
-       (SELECT count(likes.id) FROM likes WHERE likes.thread_id = t.id)                            as total_like,
-       (SELECT count(comments.id) FROM comments WHERE comments.thread_id = t.id)                   as total_comment
+       t.total_follower,
+       t.total_like,
+       t.total_comment
 FROM threads as t
          INNER JOIN categories c
                     on c.id = t.category_id
          INNER JOIN users u on t.creator_id = u.id
-ORDER BY t.created_at DESC
+WHERE ($5::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($6::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $1))
+  AND ($7::timestamp IS NULL OR t.created_at >= $7)
+  AND ($8::timestamp IS NULL OR t.created_at < $8)
+  AND ($9::varchar = '' OR t.category_id = $9)) as th
+         CROSS JOIN LATERAL (SELECT CASE $4
+                                        WHEN 'liked' THEN th.total_like
+                                        WHEN 'viewed' THEN th.total_viewer
+                                        WHEN 'commented' THEN th.total_comment
+                                        ELSE 0 END AS sort_key) k
+WHERE $10::varchar = ''
+   OR ($10 = 'next' AND (k.sort_key, th.thread_created_at, th.thread_id) < ($11::bigint, $12::timestamp, $13::varchar))
+   OR ($10 = 'prev' AND (k.sort_key, th.thread_created_at, th.thread_id) > ($11::bigint, $12::timestamp, $13::varchar))
+ORDER BY CASE WHEN $10 = 'prev' THEN k.sort_key END,
+         CASE WHEN $10 = 'prev' THEN th.thread_created_at END,
+         CASE WHEN $10 = 'prev' THEN th.thread_id END,
+         CASE WHEN $4 = 'liked' THEN th.total_like END DESC,
+         CASE WHEN $4 = 'viewed' THEN th.total_viewer END DESC,
+         CASE WHEN $4 = 'commented' THEN th.total_comment END DESC,
+         CASE
+             WHEN $4 = 'trending' THEN
+                     (th.total_like * 2 + th.total_comment * 3 + th.total_follower * 2 + th.total_viewer * 0.1) /
+                     power(extract(EPOCH FROM localtimestamp - th.thread_created_at) / 3600 + 2, 1.5)
+             END DESC,
+         th.thread_created_at DESC,
+         th.thread_id DESC
 OFFSET $2 LIMIT $3;`
 
-		rows, dbErr = t.db.QueryContext(ctx, statement, accessorUserID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1)
-		if dbErr != nil {
+	offset, limit := repository.OffsetLimit(pageInfo)
+	direction, key, createdAt, ID := repository.KeysetArgs(pageInfo)
+
+	rows, dbErr := t.conn(ctx).QueryContext(
+		ctx,
+		statement,
+		accessorUserID,
+		offset,
+		limit,
+		threadSortToString(sort),
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		filter.CategoryID,
+		direction,
+		key,
+		createdAt,
+		ID,
+	)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	pagination.List = make([]entity.Thread, 0)
+	for rows.Next() {
+		var thread entity.Thread
+		if dbErr := rows.Scan(
+			&thread.ID,
+			&thread.Title,
+			&thread.Description,
+			&thread.TotalViewer,
+			&thread.Creator.ID,
+			&thread.Creator.Username,
+			&thread.Creator.Email,
+			&thread.Creator.Name,
+			&thread.Creator.Password,
+			&thread.Creator.Role,
+			&thread.Creator.IsActive,
+			&thread.Creator.CreatedAt,
+			&thread.Creator.UpdatedAt,
+			&thread.Category.ID,
+			&thread.Category.Name,
+			&thread.Category.Description,
+			&thread.Category.CreatedAt,
+			&thread.Category.UpdatedAt,
+			&thread.CreatedAt,
+			&thread.UpdatedAt,
+			&thread.IsLiked,
+			&thread.IsFollowed,
+			&thread.TotalFollower,
+			&thread.TotalLike,
+			&thread.TotalComment,
+		); dbErr != nil {
 			log.Println(dbErr)
 			err = repository.ErrDatabase
 			return
 		}
-	} else {
-		statement := `SELECT t.id                                                                        as thread_id,
-       t.title                                                                                     as thread_title,
-       t.description                                                                               as thread_description,
-       t.total_viewer,
-       t.creator_id,
-       u.username                                                                                  as creator_username,
-       u.email                                                                                     as creator_email,
-       u.name                                                                                      as creator_name,
-       u.password                                                                                  as creator_password,
-       u.role                                                                                      as creator_rule,
-       u.is_active                                                                                 as creator_is_active,
-       u.created_at                                                                                as creator_created_at,
-       u.updated_at                                                                                as creator_updated_at,
-       t.category_id,
-       c.name                                                                                      as category_name,
-       c.description                                                                               as category_description,
-       c.created_at                                                                                as category_created_at,
-       c.updated_at                                                                                as category_updated_at,
-       t.created_at                                                                                as thread_created_at,
-       t.updated_at                                                                                as thread_updated_at,
-       (SELECT CASE WHEN count(likes.id) > 0 THEN true ELSE false END
-        FROM likes
-        WHERE likes.user_id = $1
-          AND likes.thread_id = t.id)                                                              as is_liked,
-       (SELECT CASE WHEN count(thread_follows.id) > 0 THEN true ELSE false END
-        FROM thread_follows
-        WHERE thread_follows.user_id = $1
-          AND thread_follows.thread_id = t.id)                                                     as is_followed,
-       (SELECT count(thread_follows.id) FROM thread_follows WHERE thread_follows.thread_id = t.id) as total_follower,
-       (SELECT count(likes.id) FROM likes WHERE likes.thread_id = t.id)                            as total_like,
-       (SELECT count(comments.id) FROM comments WHERE comments.thread_id = t.id)                   as total_comment
-FROM threads as t
-         INNER JOIN categories c
-                    on c.id = t.category_id
-         INNER JOIN users u on t.creator_id = u.id
-WHERE t.title ILIKE $4
-ORDER BY t.created_at DESC
-OFFSET $2 LIMIT $3;`
+		pagination.List = append(pagination.List, thread)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	cursorOf := threadCursorOf(sort)
+
+	if pageInfo.Cursor != nil {
+		repository.SetCursors(&pagination, pageInfo, cursorOf)
+		return
+	}
+
+	countStatement := `SELECT count(t.id)
+FROM threads t
+WHERE ($2::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($3::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $1))
+  AND ($4::timestamp IS NULL OR t.created_at >= $4)
+  AND ($5::timestamp IS NULL OR t.created_at < $5)
+  AND ($6::varchar = '' OR t.category_id = $6);`
+
+	row := t.conn(ctx).QueryRowContext(
+		ctx,
+		countStatement,
+		accessorUserID,
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		filter.CategoryID,
+	)
 
-		rows, dbErr = t.db.QueryContext(ctx, statement, accessorUserID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1, fmt.Sprintf("%%%s%%", query))
-		if dbErr != nil {
+	var count uint
+	switch dbErr := row.Scan(&count); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			pagination.PageInfo.Limit = pageInfo.Limit
+			pagination.PageInfo.Page = pageInfo.Page
+			pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
+			pagination.PageInfo.Total = count
+			repository.SetCursors(&pagination, pageInfo, cursorOf)
+			return
+		}
+	default:
+		{
 			log.Println(dbErr)
 			err = repository.ErrDatabase
 			return
 		}
 	}
+}
+
+// search ranks the threads by their title and description, and by their best matching comment.
+// The query follows the web search syntax, e.g. "quoted phrase" and -exclusion. The matching threads and comments are
+// found through their search vector indexes first, and the highlights are built from the HTML escaped text.
+func (t *threadRepositoryImpl) search(
+	ctx context.Context,
+	accessorUserID string,
+	query string,
+	sort entity.ThreadSort,
+	filter entity.ThreadFilter,
+	pageInfo entity.PageInfo,
+) (pagination entity.Pagination[entity.Thread], err error) {
+	statement := `WITH q AS (SELECT websearch_to_tsquery('english', $4) AS query),
+     matched_comments AS (SELECT DISTINCT ON (cm.thread_id) cm.thread_id,
+                                                            cm.id,
+                                                            cm.comment,
+                                                            ts_rank(cm.search_vector, q.query) as rank
+                          FROM comments cm
+                                   CROSS JOIN q
+                          WHERE cm.search_vector @@ q.query
+                            AND cm.deleted_at IS NULL
+                          ORDER BY cm.thread_id, rank DESC),
+     matched_threads AS (SELECT t.id
+                         FROM threads t
+                                  CROSS JOIN q
+                         WHERE t.search_vector @@ q.query
+                         UNION
+                         SELECT mc.thread_id
+                         FROM matched_comments mc)
+SELECT th.thread_id,
+       th.thread_title,
+       th.thread_description,
+       th.total_viewer,
+       th.creator_id,
+       th.creator_username,
+       th.creator_email,
+       th.creator_name,
+       th.creator_password,
+       th.creator_rule,
+       th.creator_is_active,
+       th.creator_created_at,
+       th.creator_updated_at,
+       th.category_id,
+       th.category_name,
+       th.category_description,
+       th.category_created_at,
+       th.category_updated_at,
+       th.thread_created_at,
+       th.thread_updated_at,
+       th.is_liked,
+       th.is_followed,
+       th.total_follower,
+       th.total_like,
+       th.total_comment,
+       th.rank,
+       ts_headline('english', html_escape(th.thread_title), q.query, 'HighlightAll=true')               as title_highlight,
+       ts_headline('english', html_escape(th.thread_description), q.query,
+                   'MaxFragments=2, MinWords=10, MaxWords=30')                                          as description_highlight,
+       th.comment_id,
+       CASE
+           WHEN th.comment_id = '' THEN ''
+           ELSE ts_headline('english', html_escape(th.comment), q.query,
+                            'MaxFragments=1, MinWords=10, MaxWords=30') END                            as comment_highlight
+FROM (SELECT t.id                                                                    as thread_id,
+             t.title                                                                 as thread_title,
+             t.description                                                           as thread_description,
+             t.total_viewer,
+             t.creator_id,
+             u.username                                                              as creator_username,
+             u.email                                                                 as creator_email,
+             u.name                                                                  as creator_name,
+             u.password                                                              as creator_password,
+             u.role                                                                  as creator_rule,
+             u.is_active                                                             as creator_is_active,
+             u.created_at                                                            as creator_created_at,
+             u.updated_at                                                            as creator_updated_at,
+             t.category_id,
+             c.name                                                                  as category_name,
+             c.description                                                           as category_description,
+             c.created_at                                                            as category_created_at,
+             c.updated_at                                                            as category_updated_at,
+             t.created_at                                                            as thread_created_at,
+             t.updated_at                                                            as thread_updated_at,
+             (SELECT CASE WHEN count(likes.id) > 0 THEN true ELSE false END
+              FROM likes
+              WHERE likes.user_id = $1
+                AND likes.thread_id = t.id)                                          as is_liked,
+             (SELECT CASE WHEN count(thread_follows.id) > 0 THEN true ELSE false END
+              FROM thread_follows
+              WHERE thread_follows.user_id = $1
+                AND thread_follows.thread_id = t.id)                                 as is_followed,
+             t.total_follower,
+             t.total_like,
+             t.total_comment,
+             greatest(ts_rank(t.search_vector, q.query), coalesce(mc.rank, 0) * 0.5) as rank,
+             coalesce(mc.id, '')                                                     as comment_id,
+             coalesce(mc.comment, '')                                                as comment
+      FROM matched_threads m
+               INNER JOIN threads t on t.id = m.id
+               CROSS JOIN q
+               INNER JOIN categories c
+                          on c.id = t.category_id
+               INNER JOIN users u on t.creator_id = u.id
+               LEFT JOIN matched_comments mc on mc.thread_id = t.id
+      WHERE ($6::bool = false OR NOT EXISTS(SELECT 1
+                                            FROM comments cm
+                                            WHERE cm.thread_id = t.id
+                                              AND cm.deleted_at IS NULL))
+        AND ($7::bool = false OR EXISTS(SELECT 1
+                                        FROM thread_follows tf
+                                        WHERE tf.thread_id = t.id
+                                          AND tf.user_id = $1))
+        AND ($8::timestamp IS NULL OR t.created_at >= $8)
+        AND ($9::timestamp IS NULL OR t.created_at < $9)
+        AND ($10::varchar = '' OR t.category_id = $10)) as th
+         CROSS JOIN q
+ORDER BY CASE WHEN $5 = 'relevance' THEN th.rank END DESC,
+         CASE WHEN $5 = 'liked' THEN th.total_like END DESC,
+         CASE WHEN $5 = 'viewed' THEN th.total_viewer END DESC,
+         CASE WHEN $5 = 'commented' THEN th.total_comment END DESC,
+         CASE
+             WHEN $5 = 'trending' THEN
+                     (th.total_like * 2 + th.total_comment * 3 + th.total_follower * 2 + th.total_viewer * 0.1) /
+                     power(extract(EPOCH FROM localtimestamp - th.thread_created_at) / 3600 + 2, 1.5)
+             END DESC,
+         th.thread_created_at DESC
+OFFSET $2 LIMIT $3;`
+
+	rows, dbErr := t.conn(ctx).QueryContext(
+		ctx,
+		statement,
+		accessorUserID,
+		(pageInfo.Page-1)*pageInfo.Limit,
+		pageInfo.Limit*1,
+		query,
+		threadSortToString(sort),
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		filter.CategoryID,
+	)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
 
 	defer func(rows *sql.Rows) {
 		if dbErr := rows.Close(); dbErr != nil {
@@ -182,6 +438,11 @@ OFFSET $2 LIMIT $3;`
 			&thread.TotalFollower,
 			&thread.TotalLike,
 			&thread.TotalComment,
+			&thread.Highlight.Rank,
+			&thread.Highlight.Title,
+			&thread.Highlight.Description,
+			&thread.Highlight.CommentID,
+			&thread.Highlight.Comment,
 		); dbErr != nil {
 			log.Println(dbErr)
 			err = repository.ErrDatabase
@@ -196,9 +457,43 @@ OFFSET $2 LIMIT $3;`
 		return
 	}
 
-	countStatement := "SELECT count(threads.id) FROM threads WHERE title ILIKE $1;"
-
-	row := t.db.QueryRowContext(ctx, countStatement, fmt.Sprintf("%%%s%%", query))
+	countStatement := `WITH q AS (SELECT websearch_to_tsquery('english', $1) AS query),
+     matched_threads AS (SELECT t.id
+                         FROM threads t
+                                  CROSS JOIN q
+                         WHERE t.search_vector @@ q.query
+                         UNION
+                         SELECT cm.thread_id
+                         FROM comments cm
+                                  CROSS JOIN q
+                         WHERE cm.search_vector @@ q.query
+                           AND cm.deleted_at IS NULL)
+SELECT count(t.id)
+FROM matched_threads m
+         INNER JOIN threads t on t.id = m.id
+WHERE ($3::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($4::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $2))
+  AND ($5::timestamp IS NULL OR t.created_at >= $5)
+  AND ($6::timestamp IS NULL OR t.created_at < $6)
+  AND ($7::varchar = '' OR t.category_id = $7);`
+
+	row := t.conn(ctx).QueryRowContext(
+		ctx,
+		countStatement,
+		query,
+		accessorUserID,
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		filter.CategoryID,
+	)
 
 	var count uint
 	switch dbErr := row.Scan(&count); dbErr {
@@ -228,9 +523,13 @@ func (t *threadRepositoryImpl) FindAllByCategoryIDWithPagination(
 	ctx context.Context,
 	accessorUserID string,
 	categoryID string,
+	sort entity.ThreadSort,
+	filter entity.ThreadFilter,
 	pageInfo entity.PageInfo,
 ) (pagination entity.Pagination[entity.Thread], err error) {
-	statement := `SELECT t.id                                                                        as thread_id,
+	// The keyset condition and the order are the same as in FindAllWithQueryAndPagination.
+	statement := `SELECT th.*
+FROM (SELECT t.id                                                                        as thread_id,
        t.title                                                                                     as thread_title,
        t.description                                                                               as thread_description,
        t.total_viewer,
@@ -258,18 +557,67 @@ func (t *threadRepositoryImpl) FindAllByCategoryIDWithPagination(
         FROM thread_follows
         WHERE thread_follows.user_id = $1
           AND thread_follows.thread_id = t.id)                                                     as is_followed,
-       (SELECT count(thread_follows.id) FROM thread_follows WHERE thread_follows.thread_id = t.id) as total_follower,
-       (SELECT count(likes.id) FROM likes WHERE likes.thread_id = t.id)                            as total_like,
-       (SELECT count(comments.id) FROM comments WHERE comments.thread_id = t.id)                   as total_comment
+       t.total_follower,
+       t.total_like,
+       t.total_comment
 FROM threads as t
          INNER JOIN categories c
                     on c.id = t.category_id
          INNER JOIN users u on t.creator_id = u.id
 WHERE t.category_id = $4
-ORDER BY t.created_at DESC
+  AND ($6::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($7::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $1))
+  AND ($8::timestamp IS NULL OR t.created_at >= $8)
+  AND ($9::timestamp IS NULL OR t.created_at < $9)) as th
+         CROSS JOIN LATERAL (SELECT CASE $5
+                                        WHEN 'liked' THEN th.total_like
+                                        WHEN 'viewed' THEN th.total_viewer
+                                        WHEN 'commented' THEN th.total_comment
+                                        ELSE 0 END AS sort_key) k
+WHERE $10::varchar = ''
+   OR ($10 = 'next' AND (k.sort_key, th.thread_created_at, th.thread_id) < ($11::bigint, $12::timestamp, $13::varchar))
+   OR ($10 = 'prev' AND (k.sort_key, th.thread_created_at, th.thread_id) > ($11::bigint, $12::timestamp, $13::varchar))
+ORDER BY CASE WHEN $10 = 'prev' THEN k.sort_key END,
+         CASE WHEN $10 = 'prev' THEN th.thread_created_at END,
+         CASE WHEN $10 = 'prev' THEN th.thread_id END,
+         CASE WHEN $5 = 'liked' THEN th.total_like END DESC,
+         CASE WHEN $5 = 'viewed' THEN th.total_viewer END DESC,
+         CASE WHEN $5 = 'commented' THEN th.total_comment END DESC,
+         CASE
+             WHEN $5 = 'trending' THEN
+                     (th.total_like * 2 + th.total_comment * 3 + th.total_follower * 2 + th.total_viewer * 0.1) /
+                     power(extract(EPOCH FROM localtimestamp - th.thread_created_at) / 3600 + 2, 1.5)
+             END DESC,
+         th.thread_created_at DESC,
+         th.thread_id DESC
 OFFSET $2 LIMIT $3;`
 
-	rows, dbErr := t.db.QueryContext(ctx, statement, accessorUserID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1, categoryID)
+	offset, limit := repository.OffsetLimit(pageInfo)
+	direction, key, createdAt, ID := repository.KeysetArgs(pageInfo)
+
+	rows, dbErr := t.conn(ctx).QueryContext(
+		ctx,
+		statement,
+		accessorUserID,
+		offset,
+		limit,
+		categoryID,
+		threadSortToString(sort),
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		direction,
+		key,
+		createdAt,
+		ID,
+	)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -325,9 +673,37 @@ OFFSET $2 LIMIT $3;`
 		return
 	}
 
-	countStatement := "SELECT count(threads.id) FROM threads WHERE category_id = $1;"
+	cursorOf := threadCursorOf(sort)
+
+	if pageInfo.Cursor != nil {
+		repository.SetCursors(&pagination, pageInfo, cursorOf)
+		return
+	}
 
-	row := t.db.QueryRowContext(ctx, countStatement, categoryID)
+	countStatement := `SELECT count(t.id)
+FROM threads t
+WHERE t.category_id = $1
+  AND ($3::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($4::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $2))
+  AND ($5::timestamp IS NULL OR t.created_at >= $5)
+  AND ($6::timestamp IS NULL OR t.created_at < $6);`
+
+	row := t.conn(ctx).QueryRowContext(
+		ctx,
+		countStatement,
+		categoryID,
+		accessorUserID,
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+	)
 
 	var count uint
 	switch dbErr := row.Scan(&count); dbErr {
@@ -342,6 +718,7 @@ OFFSET $2 LIMIT $3;`
 			pagination.PageInfo.Page = pageInfo.Page
 			pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
 			pagination.PageInfo.Total = count
+			repository.SetCursors(&pagination, pageInfo, cursorOf)
 			return
 		}
 	default:
@@ -357,9 +734,13 @@ func (t *threadRepositoryImpl) FindAllByUserIDWithPagination(
 	ctx context.Context,
 	accessorUserID string,
 	userID string,
+	sort entity.ThreadSort,
+	filter entity.ThreadFilter,
 	pageInfo entity.PageInfo,
 ) (pagination entity.Pagination[entity.Thread], err error) {
-	statement := `SELECT t.id                                                                        as thread_id,
+	// The keyset condition and the order are the same as in FindAllWithQueryAndPagination.
+	statement := `SELECT th.*
+FROM (SELECT t.id                                                                        as thread_id,
        t.title                                                                                     as thread_title,
        t.description                                                                               as thread_description,
        t.total_viewer,
@@ -387,18 +768,69 @@ func (t *threadRepositoryImpl) FindAllByUserIDWithPagination(
         FROM thread_follows
         WHERE thread_follows.user_id = $1
           AND thread_follows.thread_id = t.id)                                                     as is_followed,
-       (SELECT count(thread_follows.id) FROM thread_follows WHERE thread_follows.thread_id = t.id) as total_follower,
-       (SELECT count(likes.id) FROM likes WHERE likes.thread_id = t.id)                            as total_like,
-       (SELECT count(comments.id) FROM comments WHERE comments.thread_id = t.id)                   as total_comment
+       t.total_follower,
+       t.total_like,
+       t.total_comment
 FROM threads as t
          INNER JOIN categories c
                     on c.id = t.category_id
          INNER JOIN users u on t.creator_id = u.id
 WHERE t.creator_id = $4
-ORDER BY t.created_at DESC
+  AND ($6::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($7::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $1))
+  AND ($8::timestamp IS NULL OR t.created_at >= $8)
+  AND ($9::timestamp IS NULL OR t.created_at < $9)
+  AND ($10::varchar = '' OR t.category_id = $10)) as th
+         CROSS JOIN LATERAL (SELECT CASE $5
+                                        WHEN 'liked' THEN th.total_like
+                                        WHEN 'viewed' THEN th.total_viewer
+                                        WHEN 'commented' THEN th.total_comment
+                                        ELSE 0 END AS sort_key) k
+WHERE $11::varchar = ''
+   OR ($11 = 'next' AND (k.sort_key, th.thread_created_at, th.thread_id) < ($12::bigint, $13::timestamp, $14::varchar))
+   OR ($11 = 'prev' AND (k.sort_key, th.thread_created_at, th.thread_id) > ($12::bigint, $13::timestamp, $14::varchar))
+ORDER BY CASE WHEN $11 = 'prev' THEN k.sort_key END,
+         CASE WHEN $11 = 'prev' THEN th.thread_created_at END,
+         CASE WHEN $11 = 'prev' THEN th.thread_id END,
+         CASE WHEN $5 = 'liked' THEN th.total_like END DESC,
+         CASE WHEN $5 = 'viewed' THEN th.total_viewer END DESC,
+         CASE WHEN $5 = 'commented' THEN th.total_comment END DESC,
+         CASE
+             WHEN $5 = 'trending' THEN
+                     (th.total_like * 2 + th.total_comment * 3 + th.total_follower * 2 + th.total_viewer * 0.1) /
+                     power(extract(EPOCH FROM localtimestamp - th.thread_created_at) / 3600 + 2, 1.5)
+             END DESC,
+         th.thread_created_at DESC,
+         th.thread_id DESC
 OFFSET $2 LIMIT $3;`
 
-	rows, dbErr := t.db.QueryContext(ctx, statement, accessorUserID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1, userID)
+	offset, limit := repository.OffsetLimit(pageInfo)
+	direction, key, createdAt, ID := repository.KeysetArgs(pageInfo)
+
+	rows, dbErr := t.conn(ctx).QueryContext(
+		ctx,
+		statement,
+		accessorUserID,
+		offset,
+		limit,
+		userID,
+		threadSortToString(sort),
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		filter.CategoryID,
+		direction,
+		key,
+		createdAt,
+		ID,
+	)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -454,9 +886,39 @@ OFFSET $2 LIMIT $3;`
 		return
 	}
 
-	countStatement := "SELECT count(id) FROM threads WHERE creator_id = $1;"
+	cursorOf := threadCursorOf(sort)
+
+	if pageInfo.Cursor != nil {
+		repository.SetCursors(&pagination, pageInfo, cursorOf)
+		return
+	}
 
-	row := t.db.QueryRowContext(ctx, countStatement, userID)
+	countStatement := `SELECT count(t.id)
+FROM threads t
+WHERE t.creator_id = $1
+  AND ($3::bool = false OR NOT EXISTS(SELECT 1
+                                      FROM comments cm
+                                      WHERE cm.thread_id = t.id
+                                        AND cm.deleted_at IS NULL))
+  AND ($4::bool = false OR EXISTS(SELECT 1
+                                  FROM thread_follows tf
+                                  WHERE tf.thread_id = t.id
+                                    AND tf.user_id = $2))
+  AND ($5::timestamp IS NULL OR t.created_at >= $5)
+  AND ($6::timestamp IS NULL OR t.created_at < $6)
+  AND ($7::varchar = '' OR t.category_id = $7);`
+
+	row := t.conn(ctx).QueryRowContext(
+		ctx,
+		countStatement,
+		userID,
+		accessorUserID,
+		filter.Unanswered,
+		filter.FollowedOnly,
+		nullTime(filter.CreatedAfter),
+		nullTime(filter.CreatedBefore),
+		filter.CategoryID,
+	)
 
 	var count uint
 	switch dbErr := row.Scan(&count); dbErr {
@@ -471,6 +933,7 @@ OFFSET $2 LIMIT $3;`
 			pagination.PageInfo.Page = pageInfo.Page
 			pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
 			pagination.PageInfo.Total = count
+			repository.SetCursors(&pagination, pageInfo, cursorOf)
 			return
 		}
 	default:
@@ -515,16 +978,20 @@ func (t *threadRepositoryImpl) FindByID(
         FROM thread_follows
         WHERE thread_follows.user_id = $1
           AND thread_follows.thread_id = t.id)                                                     as is_followed,
-       (SELECT count(thread_follows.id) FROM thread_follows WHERE thread_follows.thread_id = t.id) as total_follower,
-       (SELECT count(likes.id) FROM likes WHERE likes.thread_id = t.id)                            as total_like,
-       (SELECT count(comments.id) FROM comments WHERE comments.thread_id = t.id)                   as total_comment
+       t.total_follower,
+       t.total_like,
+       t.total_comment,
+       t.locked_at,
+       coalesce(t.pinned_comment_id, '')                                                           as pinned_comment_id
 FROM threads as t
          INNER JOIN categories c
                     on c.id = t.category_id
          INNER JOIN users u on t.creator_id = u.id
 WHERE t.id = $2;`
 
-	row := t.db.QueryRowContext(ctx, statement, accessorUserID, ID)
+	var lockedAt sql.NullTime
+
+	row := t.conn(ctx).QueryRowContext(ctx, statement, accessorUserID, ID)
 
 	switch dbErr := row.Scan(
 		&thread.ID,
@@ -552,6 +1019,8 @@ WHERE t.id = $2;`
 		&thread.TotalFollower,
 		&thread.TotalLike,
 		&thread.TotalComment,
+		&lockedAt,
+		&thread.PinnedCommentID,
 	); dbErr {
 	case sql.ErrNoRows:
 		{
@@ -560,6 +1029,7 @@ WHERE t.id = $2;`
 		}
 	case nil:
 		{
+			thread.LockedAt = lockedAt.Time
 			return
 		}
 	default:
@@ -583,7 +1053,7 @@ SET title       = $2,
     updated_at  = current_timestamp
 WHERE id = $1;`
 
-	result, dbErr := t.db.ExecContext(ctx, statement, ID, thread.Title, thread.Description, thread.Category.ID)
+	result, dbErr := t.conn(ctx).ExecContext(ctx, statement, ID, thread.Title, thread.Description, thread.Category.ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -609,9 +1079,27 @@ func (t *threadRepositoryImpl) Delete(
 	ctx context.Context,
 	ID string,
 ) (err error) {
-	statement := "DELETE FROM threads WHERE id = $1;"
+	tx, dbErr := repository.BeginTx(ctx, t.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	// The creator is decremented first, as it can't be looked up once the thread is deleted.
+	if _, dbErr := tx.ExecContext(
+		ctx,
+		"UPDATE users SET total_thread = total_thread - 1 WHERE id = (SELECT creator_id FROM threads WHERE id = $1);",
+		ID,
+	); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
 
-	result, dbErr := t.db.ExecContext(ctx, statement, ID)
+	result, dbErr := tx.ExecContext(ctx, "DELETE FROM threads WHERE id = $1;", ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -630,6 +1118,12 @@ func (t *threadRepositoryImpl) Delete(
 		return
 	}
 
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
 	return
 }
 
@@ -653,7 +1147,7 @@ FROM moderators as m
          INNER JOIN users u on u.id = m.user_id
 WHERE m.thread_id = $1;`
 
-	rows, dbErr := t.db.QueryContext(ctx, statement, threadID)
+	rows, dbErr := t.conn(ctx).QueryContext(ctx, statement, threadID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -707,9 +1201,12 @@ func (t *threadRepositoryImpl) FindAllCommentByThreadID(
 	statement := `SELECT c.id as comment_id,
        c.user_id   as user_id,
        t.id        as thread_id,
+       c.parent_id,
        c.comment,
        c.created_at,
        c.updated_at,
+       c.edited_at,
+       c.deleted_at,
        u.username  as user_username,
        u.email     as user_email,
        u.name      as user_name,
@@ -719,10 +1216,19 @@ FROM comments c
          INNER JOIN users u on c.user_id = u.id
          INNER JOIN threads t on t.id = c.thread_id
 WHERE c.thread_id = $1
-ORDER BY c.created_at DESC
+  AND c.id IS DISTINCT FROM t.pinned_comment_id
+  AND ($4::varchar = ''
+    OR ($4 = 'next' AND (c.created_at, c.id) < ($5::timestamp, $6::varchar))
+    OR ($4 = 'prev' AND (c.created_at, c.id) > ($5::timestamp, $6::varchar)))
+ORDER BY CASE WHEN $4 = 'prev' THEN c.created_at END,
+         CASE WHEN $4 = 'prev' THEN c.id END,
+         c.created_at DESC, c.id DESC
 OFFSET $2 LIMIT $3;`
 
-	rows, dbErr := t.db.QueryContext(ctx, statement, threadID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1)
+	offset, limit := repository.OffsetLimit(pageInfo)
+	direction, _, createdAt, ID := repository.KeysetArgs(pageInfo)
+
+	rows, dbErr := t.conn(ctx).QueryContext(ctx, statement, threadID, offset, limit, direction, createdAt, ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -738,13 +1244,18 @@ OFFSET $2 LIMIT $3;`
 	pagination.List = make([]entity.Comment, 0)
 	for rows.Next() {
 		var comment entity.Comment
+		var parentID sql.NullString
+		var editedAt, deletedAt sql.NullTime
 		if dbErr := rows.Scan(
 			&comment.ID,
 			&comment.User.ID,
 			&comment.Thread.ID,
+			&parentID,
 			&comment.Comment,
 			&comment.CreatedAt,
 			&comment.UpdatedAt,
+			&editedAt,
+			&deletedAt,
 			&comment.User.Username,
 			&comment.User.Email,
 			&comment.User.Name,
@@ -755,6 +1266,9 @@ OFFSET $2 LIMIT $3;`
 			err = repository.ErrDatabase
 			return
 		}
+		comment.ParentID = parentID.String
+		comment.EditedAt = editedAt.Time
+		comment.DeletedAt = deletedAt.Time
 		pagination.List = append(pagination.List, comment)
 	}
 
@@ -764,12 +1278,27 @@ OFFSET $2 LIMIT $3;`
 		return
 	}
 
-	countStatement := "SELECT count(c.id) FROM comments c WHERE c.thread_id = $1;"
+	if pageInfo.Cursor != nil {
+		repository.SetCursors(&pagination, pageInfo, commentCursorOf)
+		return
+	}
 
-	row := t.db.QueryRowContext(ctx, countStatement, threadID)
+	var pinned []entity.Comment
+	if pageInfo.Page == 1 {
+		if pinned, err = t.findPinnedComment(ctx, threadID, false); err != nil {
+			return
+		}
+	}
 
-	var count uint
-	switch dbErr := row.Scan(&count); dbErr {
+	countStatement := `SELECT count(c.id), count(c.id) FILTER (WHERE c.id = t.pinned_comment_id)
+FROM comments c
+         INNER JOIN threads t on t.id = c.thread_id
+WHERE c.thread_id = $1;`
+
+	row := t.conn(ctx).QueryRowContext(ctx, countStatement, threadID)
+
+	var count, pinnedCount uint
+	switch dbErr := row.Scan(&count, &pinnedCount); dbErr {
 	case sql.ErrNoRows:
 		{
 			err = repository.ErrRecordNotFound
@@ -779,8 +1308,10 @@ OFFSET $2 LIMIT $3;`
 		{
 			pagination.PageInfo.Limit = pageInfo.Limit
 			pagination.PageInfo.Page = pageInfo.Page
-			pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
+			pagination.PageInfo.PageTotal = commentPageTotal(count, pinnedCount, pageInfo.Limit)
 			pagination.PageInfo.Total = count
+			repository.SetCursors(&pagination, pageInfo, commentCursorOf)
+			pagination.List = append(pinned, pagination.List...)
 			return
 		}
 	default:
@@ -792,97 +1323,321 @@ OFFSET $2 LIMIT $3;`
 	}
 }
 
-func (t *threadRepositoryImpl) InsertComment(
+func (t *threadRepositoryImpl) FindAllRootCommentByThreadID(
 	ctx context.Context,
-	comment entity.Comment,
-) (err error) {
-	statement := "INSERT INTO comments(id, user_id, thread_id, comment) VALUES ($1, $2, $3, $4);"
+	threadID string,
+	pageInfo entity.PageInfo,
+) (pagination entity.Pagination[entity.Comment], err error) {
+	statement := `SELECT c.id as comment_id,
+       c.user_id   as user_id,
+       t.id        as thread_id,
+       c.comment,
+       c.created_at,
+       c.updated_at,
+       c.edited_at,
+       c.deleted_at,
+       u.username  as user_username,
+       u.email     as user_email,
+       u.name      as user_name,
+       u.role      as user_role,
+       u.is_active as user_is_active
+FROM comments c
+         INNER JOIN users u on c.user_id = u.id
+         INNER JOIN threads t on t.id = c.thread_id
+WHERE c.thread_id = $1
+  AND c.parent_id IS NULL
+  AND c.id IS DISTINCT FROM t.pinned_comment_id
+  AND ($4::varchar = ''
+    OR ($4 = 'next' AND (c.created_at, c.id) < ($5::timestamp, $6::varchar))
+    OR ($4 = 'prev' AND (c.created_at, c.id) > ($5::timestamp, $6::varchar)))
+ORDER BY CASE WHEN $4 = 'prev' THEN c.created_at END,
+         CASE WHEN $4 = 'prev' THEN c.id END,
+         c.created_at DESC, c.id DESC
+OFFSET $2 LIMIT $3;`
 
-	result, dbErr := t.db.ExecContext(ctx, statement, comment.ID, comment.User.ID, comment.Thread.ID, comment.Comment)
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
-	}
+	offset, limit := repository.OffsetLimit(pageInfo)
+	direction, _, createdAt, ID := repository.KeysetArgs(pageInfo)
 
-	count, dbErr := result.RowsAffected()
+	rows, dbErr := t.conn(ctx).QueryContext(ctx, statement, threadID, offset, limit, direction, createdAt, ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	if count < 1 {
-		err = repository.ErrDatabase
-		return
-	}
-
-	return
-}
-
-func (t *threadRepositoryImpl) InsertFollowThread(
-	ctx context.Context,
-	threadFollow entity.ThreadFollow,
-) (err error) {
-	statement := "INSERT INTO thread_follows(id, user_id, thread_id) VALUES ($1, $2, $3);"
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
 
-	result, dbErr := t.db.ExecContext(ctx, statement, threadFollow.ID, threadFollow.User.ID, threadFollow.Thread.ID)
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
+	pagination.List = make([]entity.Comment, 0)
+	for rows.Next() {
+		var comment entity.Comment
+		var editedAt, deletedAt sql.NullTime
+		if dbErr := rows.Scan(
+			&comment.ID,
+			&comment.User.ID,
+			&comment.Thread.ID,
+			&comment.Comment,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+			&editedAt,
+			&deletedAt,
+			&comment.User.Username,
+			&comment.User.Email,
+			&comment.User.Name,
+			&comment.User.Role,
+			&comment.User.IsActive,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		comment.EditedAt = editedAt.Time
+		comment.DeletedAt = deletedAt.Time
+		pagination.List = append(pagination.List, comment)
 	}
 
-	count, dbErr := result.RowsAffected()
-	if dbErr != nil {
+	if dbErr := rows.Err(); dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	if count < 1 {
-		err = repository.ErrDatabase
+	if pageInfo.Cursor != nil {
+		repository.SetCursors(&pagination, pageInfo, commentCursorOf)
 		return
 	}
 
-	return
+	var pinned []entity.Comment
+	if pageInfo.Page == 1 {
+		if pinned, err = t.findPinnedComment(ctx, threadID, true); err != nil {
+			return
+		}
+	}
+
+	countStatement := `SELECT count(c.id), count(c.id) FILTER (WHERE c.id = t.pinned_comment_id)
+FROM comments c
+         INNER JOIN threads t on t.id = c.thread_id
+WHERE c.thread_id = $1 AND c.parent_id IS NULL;`
+
+	row := t.conn(ctx).QueryRowContext(ctx, countStatement, threadID)
+
+	var count, pinnedCount uint
+	switch dbErr := row.Scan(&count, &pinnedCount); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			pagination.PageInfo.Limit = pageInfo.Limit
+			pagination.PageInfo.Page = pageInfo.Page
+			pagination.PageInfo.PageTotal = commentPageTotal(count, pinnedCount, pageInfo.Limit)
+			pagination.PageInfo.Total = count
+			repository.SetCursors(&pagination, pageInfo, commentCursorOf)
+			pagination.List = append(pinned, pagination.List...)
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
 }
 
-func (t *threadRepositoryImpl) DeleteFollowThread(
+// findPinnedComment returns the pinned comment of the thread, if there is one. The comment listings leave it out of
+// their keyset and show it on top of their first page, so the cursors stay valid when a comment is pinned or unpinned.
+func (t *threadRepositoryImpl) findPinnedComment(
 	ctx context.Context,
-	threadFollow entity.ThreadFollow,
-) (err error) {
-	statement := "DELETE FROM thread_follows WHERE user_id = $1  AND thread_id = $2;"
+	threadID string,
+	rootOnly bool,
+) (pinned []entity.Comment, err error) {
+	statement := `SELECT c.id as comment_id,
+       c.user_id   as user_id,
+       t.id        as thread_id,
+       c.parent_id,
+       c.comment,
+       c.created_at,
+       c.updated_at,
+       c.edited_at,
+       c.deleted_at,
+       u.username  as user_username,
+       u.email     as user_email,
+       u.name      as user_name,
+       u.role      as user_role,
+       u.is_active as user_is_active
+FROM comments c
+         INNER JOIN users u on c.user_id = u.id
+         INNER JOIN threads t on t.id = c.thread_id
+WHERE t.id = $1
+  AND c.id = t.pinned_comment_id
+  AND (NOT $2 OR c.parent_id IS NULL);`
 
-	result, dbErr := t.db.ExecContext(ctx, statement, threadFollow.User.ID, threadFollow.Thread.ID)
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
+	row := t.conn(ctx).QueryRowContext(ctx, statement, threadID, rootOnly)
+
+	var comment entity.Comment
+	var parentID sql.NullString
+	var editedAt, deletedAt sql.NullTime
+	switch dbErr := row.Scan(
+		&comment.ID,
+		&comment.User.ID,
+		&comment.Thread.ID,
+		&parentID,
+		&comment.Comment,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&editedAt,
+		&deletedAt,
+		&comment.User.Username,
+		&comment.User.Email,
+		&comment.User.Name,
+		&comment.User.Role,
+		&comment.User.IsActive,
+	); dbErr {
+	case sql.ErrNoRows:
+		{
+			pinned = make([]entity.Comment, 0)
+			return
+		}
+	case nil:
+		{
+			comment.ParentID = parentID.String
+			comment.EditedAt = editedAt.Time
+			comment.DeletedAt = deletedAt.Time
+			comment.IsPinned = true
+			pinned = []entity.Comment{comment}
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
 	}
+}
 
-	count, dbErr := result.RowsAffected()
+func (t *threadRepositoryImpl) FindAllReplyByRootCommentIDs(
+	ctx context.Context,
+	rootCommentIDs []string,
+	depth uint,
+) (replies []entity.Comment, err error) {
+	// depth 0 means the replies are not limited by their depth.
+	statement := `WITH RECURSIVE replies AS (SELECT c.id, c.user_id, c.thread_id, c.parent_id, c.comment, c.created_at, c.updated_at, c.edited_at, c.deleted_at, 1 AS depth
+                         FROM comments c
+                         WHERE c.parent_id = ANY ($1)
+                         UNION ALL
+                         SELECT c.id, c.user_id, c.thread_id, c.parent_id, c.comment, c.created_at, c.updated_at, c.edited_at, c.deleted_at, r.depth + 1
+                         FROM comments c
+                                  INNER JOIN replies r on c.parent_id = r.id
+                         WHERE $2 = 0
+                            OR r.depth < $2)
+SELECT r.id        as comment_id,
+       r.user_id   as user_id,
+       r.thread_id as thread_id,
+       r.parent_id,
+       r.comment,
+       r.created_at,
+       r.updated_at,
+       r.edited_at,
+       r.deleted_at,
+       u.username  as user_username,
+       u.email     as user_email,
+       u.name      as user_name,
+       u.role      as user_role,
+       u.is_active as user_is_active
+FROM replies r
+         INNER JOIN users u on r.user_id = u.id
+ORDER BY r.created_at;`
+
+	rows, dbErr := t.conn(ctx).QueryContext(ctx, statement, pq.Array(rootCommentIDs), depth)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	if count < 1 {
-		err = repository.ErrRecordNotFound
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	replies = make([]entity.Comment, 0)
+	for rows.Next() {
+		var reply entity.Comment
+		var editedAt, deletedAt sql.NullTime
+		if dbErr := rows.Scan(
+			&reply.ID,
+			&reply.User.ID,
+			&reply.Thread.ID,
+			&reply.ParentID,
+			&reply.Comment,
+			&reply.CreatedAt,
+			&reply.UpdatedAt,
+			&editedAt,
+			&deletedAt,
+			&reply.User.Username,
+			&reply.User.Email,
+			&reply.User.Name,
+			&reply.User.Role,
+			&reply.User.IsActive,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		reply.EditedAt = editedAt.Time
+		reply.DeletedAt = deletedAt.Time
+		replies = append(replies, reply)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
 		return
 	}
 
 	return
 }
 
-func (t *threadRepositoryImpl) InsertLike(
+func (t *threadRepositoryImpl) InsertComment(
 	ctx context.Context,
-	like entity.Like,
+	comment entity.Comment,
 ) (err error) {
-	statement := "INSERT INTO likes(id, user_id, thread_id) VALUES ($1, $2, $3);"
+	statement := "INSERT INTO comments(id, user_id, thread_id, parent_id, comment) VALUES ($1, $2, $3, $4, $5);"
+
+	parentID := sql.NullString{String: comment.ParentID, Valid: comment.ParentID != ""}
+
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{comment.ID, comment.User.ID, comment.Thread.ID, parentID, comment.Comment},
+		"UPDATE threads SET total_comment = total_comment + 1 WHERE id = $1;",
+		comment.Thread.ID,
+	)
+	return
+}
 
-	result, dbErr := t.db.ExecContext(ctx, statement, like.ID, like.User.ID, like.Thread.ID)
+func (t *threadRepositoryImpl) UpdateComment(
+	ctx context.Context,
+	ID string,
+	comment entity.Comment,
+) (err error) {
+	statement := `UPDATE comments
+SET comment    = $2,
+    edited_at  = current_timestamp,
+    updated_at = current_timestamp
+WHERE id = $1
+  AND deleted_at IS NULL;`
+
+	result, dbErr := t.conn(ctx).ExecContext(ctx, statement, ID, comment.Comment)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -897,38 +1652,104 @@ func (t *threadRepositoryImpl) InsertLike(
 	}
 
 	if count < 1 {
-		err = repository.ErrDatabase
+		err = repository.ErrRecordNotFound
 		return
 	}
 
 	return
 }
 
-func (t *threadRepositoryImpl) DeleteLike(
+func (t *threadRepositoryImpl) DeleteComment(
 	ctx context.Context,
-	like entity.Like,
+	ID string,
 ) (err error) {
-	statement := "DELETE FROM likes WHERE user_id = $1 AND thread_id = $2;"
+	// The comment is kept as a tombstone, so the reports and the replies that reference it are not cascade deleted.
+	// The pin is cleared along with the counter, as ON DELETE SET NULL doesn't fire for a tombstone.
+	statement := `UPDATE comments
+SET deleted_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE id = $1
+  AND deleted_at IS NULL;`
+
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{ID},
+		`UPDATE threads
+SET total_comment     = total_comment - 1,
+    pinned_comment_id = nullif(pinned_comment_id, $1)
+WHERE id = (SELECT thread_id FROM comments WHERE id = $1);`,
+		ID,
+	)
+	return
+}
 
-	result, dbErr := t.db.ExecContext(ctx, statement, like.User.ID, like.Thread.ID)
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
-	}
+func (t *threadRepositoryImpl) InsertFollowThread(
+	ctx context.Context,
+	threadFollow entity.ThreadFollow,
+) (err error) {
+	statement := "INSERT INTO thread_follows(id, user_id, thread_id) VALUES ($1, $2, $3);"
 
-	count, dbErr := result.RowsAffected()
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
-	}
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{threadFollow.ID, threadFollow.User.ID, threadFollow.Thread.ID},
+		"UPDATE threads SET total_follower = total_follower + 1 WHERE id = $1;",
+		threadFollow.Thread.ID,
+	)
+	return
+}
 
-	if count < 1 {
-		err = repository.ErrRecordNotFound
-		return
-	}
+func (t *threadRepositoryImpl) DeleteFollowThread(
+	ctx context.Context,
+	threadFollow entity.ThreadFollow,
+) (err error) {
+	statement := "DELETE FROM thread_follows WHERE user_id = $1  AND thread_id = $2;"
+
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{threadFollow.User.ID, threadFollow.Thread.ID},
+		"UPDATE threads SET total_follower = total_follower - 1 WHERE id = $1;",
+		threadFollow.Thread.ID,
+	)
+	return
+}
+
+func (t *threadRepositoryImpl) InsertLike(
+	ctx context.Context,
+	like entity.Like,
+) (err error) {
+	statement := "INSERT INTO likes(id, user_id, thread_id) VALUES ($1, $2, $3);"
 
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{like.ID, like.User.ID, like.Thread.ID},
+		"UPDATE threads SET total_like = total_like + 1 WHERE id = $1;",
+		like.Thread.ID,
+	)
+	return
+}
+
+func (t *threadRepositoryImpl) DeleteLike(
+	ctx context.Context,
+	like entity.Like,
+) (err error) {
+	statement := "DELETE FROM likes WHERE user_id = $1 AND thread_id = $2;"
+
+	err = repository.ExecWithCounter(
+		ctx,
+		t.db,
+		statement,
+		[]any{like.User.ID, like.Thread.ID},
+		"UPDATE threads SET total_like = total_like - 1 WHERE id = $1;",
+		like.Thread.ID,
+	)
 	return
 }
 
@@ -938,7 +1759,7 @@ func (t *threadRepositoryImpl) InsertModerator(
 ) (err error) {
 	statement := "INSERT INTO moderators(id, user_id, thread_id) VALUES ($1, $2, $3);"
 
-	result, dbErr := t.db.ExecContext(ctx, statement, moderator.ID, moderator.User.ID, moderator.ThreadID)
+	result, dbErr := t.conn(ctx).ExecContext(ctx, statement, moderator.ID, moderator.User.ID, moderator.ThreadID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -966,7 +1787,7 @@ func (t *threadRepositoryImpl) DeleteModerator(
 ) (err error) {
 	statement := "DELETE FROM moderators WHERE user_id = $1 AND thread_id = $2;"
 
-	result, dbErr := t.db.ExecContext(ctx, statement, moderator.User.ID, moderator.ThreadID)
+	result, dbErr := t.conn(ctx).ExecContext(ctx, statement, moderator.User.ID, moderator.ThreadID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -988,27 +1809,29 @@ func (t *threadRepositoryImpl) DeleteModerator(
 	return
 }
 
-func (t *threadRepositoryImpl) IncrementTotalViewer(
-	ID string,
+func (t *threadRepositoryImpl) IncrementTotalViewers(
+	ctx context.Context,
+	views map[string]uint64,
 ) (err error) {
-	statement := "UPDATE threads SET total_viewer = total_viewer + 1 WHERE id = $1;"
-
-	result, dbErr := t.db.Exec(statement, ID)
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
+	if len(views) == 0 {
 		return
 	}
 
-	count, dbErr := result.RowsAffected()
-	if dbErr != nil {
-		log.Println(dbErr)
-		err = repository.ErrDatabase
-		return
+	IDs := make([]string, 0, len(views))
+	counts := make([]int64, 0, len(views))
+	for ID, count := range views {
+		IDs = append(IDs, ID)
+		counts = append(counts, int64(count))
 	}
 
-	if count < 1 {
-		err = repository.ErrRecordNotFound
+	statement := `UPDATE threads t
+SET total_viewer = t.total_viewer + v.count
+FROM unnest($1::varchar[], $2::bigint[]) AS v(id, count)
+WHERE t.id = v.id;`
+
+	if _, dbErr := t.conn(ctx).ExecContext(ctx, statement, pq.Array(IDs), pq.Array(counts)); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
 		return
 	}
 
@@ -1019,18 +1842,37 @@ func (t *threadRepositoryImpl) FindCommentByID(
 	ctx context.Context,
 	ID string,
 ) (comment entity.Comment, err error) {
-	statement := `SELECT id, user_id, thread_id, comment, created_at, updated_at
-FROM comments WHERE id = $1;`
+	statement := `SELECT c.id,
+       c.user_id,
+       u.username,
+       u.name,
+       c.thread_id,
+       c.parent_id,
+       c.comment,
+       c.created_at,
+       c.updated_at,
+       c.edited_at,
+       c.deleted_at
+FROM comments c
+         INNER JOIN users u on u.id = c.user_id
+WHERE c.id = $1;`
 
-	row := t.db.QueryRowContext(ctx, statement, ID)
+	row := t.conn(ctx).QueryRowContext(ctx, statement, ID)
 
+	var parentID sql.NullString
+	var editedAt, deletedAt sql.NullTime
 	switch dbErr := row.Scan(
 		&comment.ID,
 		&comment.User.ID,
+		&comment.User.Username,
+		&comment.User.Name,
 		&comment.Thread.ID,
+		&parentID,
 		&comment.Comment,
 		&comment.CreatedAt,
 		&comment.UpdatedAt,
+		&editedAt,
+		&deletedAt,
 	); dbErr {
 	case sql.ErrNoRows:
 		{
@@ -1039,6 +1881,9 @@ FROM comments WHERE id = $1;`
 		}
 	case nil:
 		{
+			comment.ParentID = parentID.String
+			comment.EditedAt = editedAt.Time
+			comment.DeletedAt = deletedAt.Time
 			return
 		}
 	default:
@@ -1049,3 +1894,376 @@ FROM comments WHERE id = $1;`
 		}
 	}
 }
+
+func (t *threadRepositoryImpl) FindAllFollowerIDByThreadID(
+	ctx context.Context,
+	threadID string,
+) (userIDs []string, err error) {
+	statement := "SELECT user_id FROM thread_follows WHERE thread_id = $1;"
+
+	rows, dbErr := t.conn(ctx).QueryContext(ctx, statement, threadID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	userIDs = make([]string, 0)
+	for rows.Next() {
+		var userID string
+		if dbErr := rows.Scan(&userID); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+
+	return
+}
+
+func threadSortToString(sort entity.ThreadSort) (key string) {
+	switch sort {
+	case entity.MostLikedSort:
+		key = "liked"
+	case entity.MostViewedSort:
+		key = "viewed"
+	case entity.MostCommentedSort:
+		key = "commented"
+	case entity.TrendingSort:
+		key = "trending"
+	case entity.RelevanceSort:
+		key = "relevance"
+	default:
+		key = "newest"
+	}
+	return
+}
+
+// threadCursorOf returns the cursor of a thread in a listing sorted by sort, or nil if the listing doesn't support cursors.
+func threadCursorOf(sort entity.ThreadSort) func(thread entity.Thread) entity.Cursor {
+	var keyOf func(thread entity.Thread) uint64
+	switch sort {
+	case entity.NewestSort:
+		keyOf = func(thread entity.Thread) uint64 { return 0 }
+	case entity.MostLikedSort:
+		keyOf = func(thread entity.Thread) uint64 { return thread.TotalLike }
+	case entity.MostViewedSort:
+		keyOf = func(thread entity.Thread) uint64 { return thread.TotalViewer }
+	case entity.MostCommentedSort:
+		keyOf = func(thread entity.Thread) uint64 { return thread.TotalComment }
+	default:
+		// The trending score changes over time and the relevance depends on the query, they can't be resumed.
+		return nil
+	}
+
+	return func(thread entity.Thread) entity.Cursor {
+		return entity.Cursor{Key: int64(keyOf(thread)), CreatedAt: thread.CreatedAt, ID: thread.ID}
+	}
+}
+
+// commentCursorOf returns the cursor of a comment, the pinned comment is listed first.
+// commentCursorOf leaves the pinned comment out of the position, it is listed apart from the other comments.
+func commentCursorOf(comment entity.Comment) entity.Cursor {
+	return entity.Cursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+}
+
+// commentPageTotal counts the pages of the comments besides the pinned comment, which is added on top of the first page.
+func commentPageTotal(count, pinnedCount, limit uint) uint {
+	pageTotal := uint(math.Ceil(float64(count-pinnedCount) / float64(limit)))
+	if pageTotal == 0 && pinnedCount > 0 {
+		pageTotal = 1
+	}
+
+	return pageTotal
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func (t *threadRepositoryImpl) UpdateLock(
+	ctx context.Context,
+	ID string,
+	lockedAt time.Time,
+	moderationLog entity.ModerationLog,
+) (err error) {
+	err = t.moderate(ctx, moderationLog, "UPDATE threads SET locked_at = $1 WHERE id = $2;", nullTime(lockedAt), ID)
+	return
+}
+
+func (t *threadRepositoryImpl) UpdatePinnedComment(
+	ctx context.Context,
+	ID string,
+	commentID string,
+	moderationLog entity.ModerationLog,
+) (err error) {
+	err = t.moderate(ctx, moderationLog, "UPDATE threads SET pinned_comment_id = $1 WHERE id = $2;", nullString(commentID), ID)
+	return
+}
+
+func (t *threadRepositoryImpl) UpsertMute(
+	ctx context.Context,
+	mute entity.ThreadMute,
+	moderationLog entity.ModerationLog,
+) (err error) {
+	statement := `INSERT INTO thread_mutes (thread_id, user_id, moderator_id, reason, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (thread_id, user_id) DO UPDATE SET moderator_id = excluded.moderator_id,
+                                               reason       = excluded.reason,
+                                               expires_at   = excluded.expires_at,
+                                               created_at   = current_timestamp;`
+
+	err = t.moderate(
+		ctx,
+		moderationLog,
+		statement,
+		mute.Thread.ID,
+		mute.User.ID,
+		nullString(mute.Moderator.ID),
+		mute.Reason,
+		mute.ExpiresAt,
+	)
+	return
+}
+
+func (t *threadRepositoryImpl) DeleteMute(
+	ctx context.Context,
+	threadID string,
+	userID string,
+	now time.Time,
+	moderationLog entity.ModerationLog,
+) (err error) {
+	err = t.moderate(
+		ctx,
+		moderationLog,
+		"DELETE FROM thread_mutes WHERE thread_id = $1 AND user_id = $2 AND expires_at > $3;",
+		threadID,
+		userID,
+		now,
+	)
+	return
+}
+
+func (t *threadRepositoryImpl) FindActiveMute(
+	ctx context.Context,
+	threadID string,
+	userID string,
+	now time.Time,
+) (mute entity.ThreadMute, err error) {
+	statement := `SELECT thread_id, user_id, coalesce(moderator_id, ''), reason, expires_at, created_at
+FROM thread_mutes
+WHERE thread_id = $1
+  AND user_id = $2
+  AND expires_at > $3;`
+
+	row := t.conn(ctx).QueryRowContext(ctx, statement, threadID, userID, now)
+
+	switch dbErr := row.Scan(
+		&mute.Thread.ID,
+		&mute.User.ID,
+		&mute.Moderator.ID,
+		&mute.Reason,
+		&mute.ExpiresAt,
+		&mute.CreatedAt,
+	); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func (t *threadRepositoryImpl) FindAllModerationLogByThreadID(
+	ctx context.Context,
+	threadID string,
+	pageInfo entity.PageInfo,
+) (pagination entity.Pagination[entity.ModerationLog], err error) {
+	statement := `SELECT l.id,
+       l.thread_id,
+       l.action,
+       l.reason,
+       l.expires_at,
+       l.created_at,
+       coalesce(l.comment_id, '')  AS comment_id,
+       coalesce(a.id, '')          AS actor_id,
+       coalesce(a.username, '')    AS actor_username,
+       coalesce(u.id, '')          AS target_user_id,
+       coalesce(u.username, '')    AS target_user_username
+FROM moderation_logs l
+         LEFT JOIN users a ON l.actor_id = a.id
+         LEFT JOIN users u ON l.target_user_id = u.id
+WHERE l.thread_id = $1
+ORDER BY l.created_at DESC
+OFFSET $2 LIMIT $3;`
+
+	rows, dbErr := t.conn(ctx).QueryContext(ctx, statement, threadID, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	pagination.List = make([]entity.ModerationLog, 0)
+	for rows.Next() {
+		var moderationLog entity.ModerationLog
+		var action string
+		var expiresAt sql.NullTime
+		if dbErr := rows.Scan(
+			&moderationLog.ID,
+			&moderationLog.Thread.ID,
+			&action,
+			&moderationLog.Reason,
+			&expiresAt,
+			&moderationLog.CreatedAt,
+			&moderationLog.Comment.ID,
+			&moderationLog.Actor.ID,
+			&moderationLog.Actor.Username,
+			&moderationLog.TargetUser.ID,
+			&moderationLog.TargetUser.Username,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		moderationLog.Action = stringToModerationAction(action)
+		moderationLog.ExpiresAt = expiresAt.Time
+		pagination.List = append(pagination.List, moderationLog)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	countStatement := "SELECT count(l.id) FROM moderation_logs l WHERE l.thread_id = $1;"
+
+	row := t.conn(ctx).QueryRowContext(ctx, countStatement, threadID)
+
+	var count uint
+	switch dbErr := row.Scan(&count); dbErr {
+	case nil:
+		pagination.PageInfo.Limit = pageInfo.Limit
+		pagination.PageInfo.Page = pageInfo.Page
+		pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
+		pagination.PageInfo.Total = count
+	default:
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+
+	return
+}
+
+// moderate runs the moderation statement and records it in the moderation log within a single transaction.
+// It returns repository.ErrRecordNotFound if the statement doesn't affect any row.
+func (t *threadRepositoryImpl) moderate(
+	ctx context.Context,
+	moderationLog entity.ModerationLog,
+	statement string,
+	args ...any,
+) (err error) {
+	tx, dbErr := repository.BeginTx(ctx, t.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	result, dbErr := tx.ExecContext(ctx, statement, args...)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	if _, dbErr := tx.ExecContext(
+		ctx,
+		"INSERT INTO moderation_logs (id, thread_id, actor_id, action, target_user_id, comment_id, reason, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);",
+		moderationLog.ID,
+		moderationLog.Thread.ID,
+		nullString(moderationLog.Actor.ID),
+		moderationLog.Action.String(),
+		nullString(moderationLog.TargetUser.ID),
+		nullString(moderationLog.Comment.ID),
+		moderationLog.Reason,
+		nullTime(moderationLog.ExpiresAt),
+	); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func stringToModerationAction(value string) (action entity.ModerationAction) {
+	switch value {
+	case "unlock":
+		action = entity.UnlockAction
+	case "pin":
+		action = entity.PinAction
+	case "unpin":
+		action = entity.UnpinAction
+	case "mute":
+		action = entity.MuteAction
+	case "unmute":
+		action = entity.UnmuteAction
+	default:
+		action = entity.LockAction
+	}
+	return
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync/atomic"
+)
+
+// DBTX is the subset of *sql.DB and *sql.Tx used by the repositories to run statements.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Tx is a transaction started by BeginTx. It is either a database transaction
+// or a savepoint within the transaction carried by the context.
+type Tx interface {
+	DBTX
+	Commit() error
+	Rollback() error
+}
+
+// TxManager runs a function within a single database transaction.
+type TxManager interface {
+	// WithinTx runs fn with a context carrying the transaction. The transaction is committed if fn returns nil,
+	// otherwise it's rolled back and the error of fn is returned.
+	// If ctx already carries a transaction, fn joins it instead of starting a new one.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type txKey struct{}
+
+type txManagerImpl struct {
+	db *sql.DB
+}
+
+func NewTxManagerImpl(db *sql.DB) *txManagerImpl {
+	return &txManagerImpl{db: db}
+}
+
+func (t *txManagerImpl) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		err = fn(ctx)
+		return
+	}
+
+	tx, dbErr := t.db.BeginTx(ctx, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	return
+}
+
+// Executor returns the transaction carried by ctx, or db if there is none.
+func Executor(ctx context.Context, db *sql.DB) DBTX {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+var savepointSeq uint64
+
+// BeginTx starts a transaction on db. If ctx already carries a transaction, a savepoint is created in it instead,
+// so the statements run by the caller are still part of the outer transaction but can be rolled back on their own.
+// opts is ignored for savepoints.
+func BeginTx(ctx context.Context, db *sql.DB, opts *sql.TxOptions) (Tx, error) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	if !ok {
+		return db.BeginTx(ctx, opts)
+	}
+
+	name := fmt.Sprintf("sp_%d", atomic.AddUint64(&savepointSeq, 1))
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name+";"); err != nil {
+		return nil, err
+	}
+
+	return &savepointTx{Tx: tx, ctx: ctx, name: name}, nil
+}
+
+type savepointTx struct {
+	*sql.Tx
+	ctx  context.Context
+	name string
+	done bool
+}
+
+func (s *savepointTx) Commit() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+
+	s.done = true
+	_, err := s.Tx.ExecContext(s.ctx, "RELEASE SAVEPOINT "+s.name+";")
+	return err
+}
+
+func (s *savepointTx) Rollback() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+
+	s.done = true
+	_, err := s.Tx.ExecContext(s.ctx, "ROLLBACK TO SAVEPOINT "+s.name+";")
+	return err
+}
+
+// ExecWithCounter runs the statement and then the counter statement within a single transaction, so the counter
+// columns stay in sync with the rows they count. It returns ErrRecordNotFound if the statement doesn't affect any row.
+func ExecWithCounter(
+	ctx context.Context,
+	db *sql.DB,
+	statement string,
+	args []any,
+	counterStatement string,
+	counterArgs ...any,
+) (err error) {
+	tx, dbErr := BeginTx(ctx, db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	result, dbErr := tx.ExecContext(ctx, statement, args...)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = ErrRecordNotFound
+		return
+	}
+
+	if _, dbErr := tx.ExecContext(ctx, counterStatement, counterArgs...); dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = ErrDatabase
+		return
+	}
+
+	return
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var txManager TxManager = NewTxManagerImpl(db)
+
+	t.Run("it should commit the transaction, when the function return no error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO threads").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		gotError := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			_, err := Executor(ctx, db).ExecContext(ctx, "INSERT INTO threads(id) VALUES ($1);", "t-aBcDeF")
+			return err
+		})
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+
+	t.Run("it should roll back the transaction and return the error, when the function return an error", func(t *testing.T) {
+		expectedError := errors.New("something wrong")
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		gotError := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			return expectedError
+		})
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, expectedError, gotError)
+	})
+
+	t.Run("it should return ErrDatabase, when the transaction can't be started", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(sql.ErrConnDone)
+
+		gotError := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			return nil
+		})
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, ErrDatabase, gotError)
+	})
+
+	t.Run("it should join the outer transaction, when the context already carries one", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		gotError := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			return txManager.WithinTx(ctx, func(ctx context.Context) error {
+				return nil
+			})
+		})
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+}
+
+func TestBeginTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var txManager TxManager = NewTxManagerImpl(db)
+
+	t.Run("it should start a transaction, when the context carries none", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		tx, gotError := BeginTx(context.Background(), db, nil)
+		assert.NoError(t, gotError)
+		assert.NoError(t, tx.Commit())
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("it should roll back to a savepoint, when the context carries a transaction", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("SAVEPOINT sp_").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("ROLLBACK TO SAVEPOINT sp_").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO reports").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		gotError := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			tx, err := BeginTx(ctx, db, nil)
+			if err != nil {
+				return err
+			}
+
+			defer tx.Rollback()
+
+			if err := tx.Rollback(); err != nil {
+				return err
+			}
+
+			_, err = Executor(ctx, db).ExecContext(ctx, "INSERT INTO reports(id) VALUES ($1);", "r-aBcDeF")
+			return err
+		})
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+
+	t.Run("it should release the savepoint, when the nested transaction is committed", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("SAVEPOINT sp_").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("RELEASE SAVEPOINT sp_").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		gotError := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			tx, err := BeginTx(ctx, db, nil)
+			if err != nil {
+				return err
+			}
+
+			defer tx.Rollback()
+
+			return tx.Commit()
+		})
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+}
+
+func TestExecWithCounter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	statement := "DELETE FROM likes WHERE user_id = $1 AND thread_id = $2;"
+	counterStatement := "UPDATE threads SET total_like = total_like - 1 WHERE id = $1;"
+
+	t.Run("it should update the counter and commit, when the statement affects a row", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM likes").WithArgs("u-abcdef", "t-abcdef").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE threads").WithArgs("t-abcdef").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		gotError := ExecWithCounter(context.Background(), db, statement, []any{"u-abcdef", "t-abcdef"}, counterStatement, "t-abcdef")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+
+	t.Run("it should return ErrRecordNotFound without updating the counter, when the statement affects no row", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM likes").WithArgs("u-abcdef", "t-abcdef").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		gotError := ExecWithCounter(context.Background(), db, statement, []any{"u-abcdef", "t-abcdef"}, counterStatement, "t-abcdef")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, ErrRecordNotFound, gotError)
+	})
+
+	t.Run("it should roll back and return ErrDatabase, when the counter can't be updated", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM likes").WithArgs("u-abcdef", "t-abcdef").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE threads").WithArgs("t-abcdef").WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		gotError := ExecWithCounter(context.Background(), db, statement, []any{"u-abcdef", "t-abcdef"}, counterStatement, "t-abcdef")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, ErrDatabase, gotError)
+	})
+}
@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserRepository is an autogenerated mock type for the UserRepository type
@@ -14,13 +16,27 @@ type UserRepository struct {
 	mock.Mock
 }
 
-// BannedUser provides a mock function with given fields: ctx, userID
-func (_m *UserRepository) BannedUser(ctx context.Context, userID string) error {
-	ret := _m.Called(ctx, userID)
+// Anonymize provides a mock function with given fields: ctx, userID, deletedAt
+func (_m *UserRepository) Anonymize(ctx context.Context, userID string, deletedAt time.Time) error {
+	ret := _m.Called(ctx, userID, deletedAt)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, userID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BannedUser provides a mock function with given fields: ctx, ban
+func (_m *UserRepository) BannedUser(ctx context.Context, ban entity.Ban) error {
+	ret := _m.Called(ctx, ban)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Ban) error); ok {
+		r0 = rf(ctx, ban)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,6 +44,50 @@ func (_m *UserRepository) BannedUser(ctx context.Context, userID string) error {
 	return r0
 }
 
+// FindActiveBanByUserID provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) FindActiveBanByUserID(ctx context.Context, userID string) (entity.Ban, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 entity.Ban
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Ban); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.Ban)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllBanByUserID provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) FindAllBanByUserID(ctx context.Context, userID string) ([]entity.Ban, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []entity.Ban
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Ban); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Ban)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindAllWithStatusAndPagination provides a mock function with given fields: ctx, accessorUserID, orderBy, userStatus, pageInfo, keyword
 func (_m *UserRepository) FindAllWithStatusAndPagination(ctx context.Context, accessorUserID string, orderBy entity.UserOrderBy, userStatus entity.UserStatus, pageInfo entity.PageInfo, keyword string) (entity.Pagination[entity.User], error) {
 	ret := _m.Called(ctx, accessorUserID, orderBy, userStatus, pageInfo, keyword)
@@ -49,6 +109,27 @@ func (_m *UserRepository) FindAllWithStatusAndPagination(ctx context.Context, ac
 	return r0, r1
 }
 
+// FindByEmail provides a mock function with given fields: ctx, email
+func (_m *UserRepository) FindByEmail(ctx context.Context, email string) (entity.User, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 entity.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByUsername provides a mock function with given fields: ctx, username
 func (_m *UserRepository) FindByUsername(ctx context.Context, username string) (entity.User, error) {
 	ret := _m.Called(ctx, username)
@@ -91,6 +172,27 @@ func (_m *UserRepository) FindByUsernameWithAccessor(ctx context.Context, access
 	return r0, r1
 }
 
+// FindPersonalData provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) FindPersonalData(ctx context.Context, userID string) (entity.PersonalData, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 entity.PersonalData
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.PersonalData); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.PersonalData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FollowUser provides a mock function with given fields: ctx, ID, accessorUserID, userID
 func (_m *UserRepository) FollowUser(ctx context.Context, ID string, accessorUserID string, userID string) error {
 	ret := _m.Called(ctx, ID, accessorUserID, userID)
@@ -119,13 +221,50 @@ func (_m *UserRepository) Insert(ctx context.Context, _a1 entity.User) error {
 	return r0
 }
 
-// UnbannedUser provides a mock function with given fields: ctx, userID
-func (_m *UserRepository) UnbannedUser(ctx context.Context, userID string) error {
-	ret := _m.Called(ctx, userID)
+// MarkVerified provides a mock function with given fields: ctx, ID
+func (_m *UserRepository) MarkVerified(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, userID)
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnbannedExpiredUsers provides a mock function with given fields: ctx, now
+func (_m *UserRepository) UnbannedExpiredUsers(ctx context.Context, now time.Time) ([]string, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnbannedUser provides a mock function with given fields: ctx, userID, lifterID, liftedAt
+func (_m *UserRepository) UnbannedUser(ctx context.Context, userID string, lifterID string, liftedAt time.Time) error {
+	ret := _m.Called(ctx, userID, lifterID, liftedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, userID, lifterID, liftedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -147,6 +286,48 @@ func (_m *UserRepository) UnfollowUser(ctx context.Context, accessorUserID strin
 	return r0
 }
 
+// Update provides a mock function with given fields: ctx, ID, _a2
+func (_m *UserRepository) Update(ctx context.Context, ID string, _a2 entity.User) error {
+	ret := _m.Called(ctx, ID, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.User) error); ok {
+		r0 = rf(ctx, ID, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, ID, password
+func (_m *UserRepository) UpdatePassword(ctx context.Context, ID string, password string) error {
+	ret := _m.Called(ctx, ID, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, ID, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRole provides a mock function with given fields: ctx, ID, role
+func (_m *UserRepository) UpdateRole(ctx context.Context, ID string, role string) error {
+	ret := _m.Called(ctx, ID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, ID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUserRepository interface {
 	mock.TestingT
 	Cleanup(func())
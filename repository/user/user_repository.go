@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 )
@@ -11,6 +12,8 @@ type UserRepository interface {
 
 	FindByUsername(ctx context.Context, username string) (user entity.User, err error)
 
+	FindByEmail(ctx context.Context, email string) (user entity.User, err error)
+
 	FindAllWithStatusAndPagination(
 		ctx context.Context,
 		accessorUserID string,
@@ -28,14 +31,31 @@ type UserRepository interface {
 
 	BannedUser(
 		ctx context.Context,
-		userID string,
+		ban entity.Ban,
 	) (err error)
 
 	UnbannedUser(
 		ctx context.Context,
 		userID string,
+		lifterID string,
+		liftedAt time.Time,
 	) (err error)
 
+	UnbannedExpiredUsers(
+		ctx context.Context,
+		now time.Time,
+	) (userIDs []string, err error)
+
+	FindActiveBanByUserID(
+		ctx context.Context,
+		userID string,
+	) (ban entity.Ban, err error)
+
+	FindAllBanByUserID(
+		ctx context.Context,
+		userID string,
+	) (bans []entity.Ban, err error)
+
 	FollowUser(
 		ctx context.Context,
 		ID string,
@@ -48,4 +68,42 @@ type UserRepository interface {
 		accessorUserID string,
 		userID string,
 	) (err error)
+
+	Update(
+		ctx context.Context,
+		ID string,
+		user entity.User,
+	) (err error)
+
+	UpdatePassword(
+		ctx context.Context,
+		ID string,
+		password string,
+	) (err error)
+
+	UpdateRole(
+		ctx context.Context,
+		ID string,
+		role string,
+	) (err error)
+
+	MarkVerified(
+		ctx context.Context,
+		ID string,
+	) (err error)
+
+	// FindPersonalData returns the profile of the user with their threads, comments, likes and follows, read from a
+	// single snapshot of the database.
+	FindPersonalData(
+		ctx context.Context,
+		userID string,
+	) (data entity.PersonalData, err error)
+
+	// Anonymize replaces the personal data of the user with placeholders and removes their likes, follows, sessions,
+	// tokens and notifications. The threads and comments are kept, so the discussions of the other users stay intact.
+	Anonymize(
+		ctx context.Context,
+		userID string,
+		deletedAt time.Time,
+	) (err error)
 }
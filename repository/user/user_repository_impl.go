@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
@@ -20,10 +21,15 @@ func NewUserRepositoryImpl(db *sql.DB) *userRepositoryImpl {
 	return &userRepositoryImpl{db: db}
 }
 
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (u *userRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, u.db)
+}
+
 func (u *userRepositoryImpl) Insert(ctx context.Context, user entity.User) (err error) {
 	statement := "INSERT INTO users (id, username, email, name, password, role) VALUES ($1, $2, $3, $4, $5, $6);"
 
-	result, dbErr := u.db.ExecContext(ctx, statement, user.ID, user.Username, user.Email, user.Name, user.Password, user.Role)
+	result, dbErr := u.conn(ctx).ExecContext(ctx, statement, user.ID, user.Username, user.Email, user.Name, user.Password, user.Role)
 	if dbErr != nil {
 		switch e := dbErr.(type) {
 		case *pq.Error:
@@ -66,12 +72,14 @@ func (u *userRepositoryImpl) FindByUsername(ctx context.Context, username string
        					 password,
        					 role,
        					 is_active,
+       					 is_verified,
+       					 token_version,
        					 created_at,
        					 updated_at
 							 FROM users
 							 WHERE username = $1;`
 
-	row := u.db.QueryRowContext(ctx, statement, username)
+	row := u.conn(ctx).QueryRowContext(ctx, statement, username)
 
 	switch dbErr := row.Scan(
 		&user.ID,
@@ -81,6 +89,56 @@ func (u *userRepositoryImpl) FindByUsername(ctx context.Context, username string
 		&user.Password,
 		&user.Role,
 		&user.IsActive,
+		&user.IsVerified,
+		&user.TokenVersion,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func (u *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (user entity.User, err error) {
+	statement := `SELECT id,
+       username,
+       email,
+       name,
+       password,
+       role,
+       is_active,
+       is_verified,
+       token_version,
+       created_at,
+       updated_at
+FROM users
+WHERE email = $1;`
+
+	row := u.conn(ctx).QueryRowContext(ctx, statement, email)
+
+	switch dbErr := row.Scan(
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.Name,
+		&user.Password,
+		&user.Role,
+		&user.IsActive,
+		&user.IsVerified,
+		&user.TokenVersion,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	); dbErr {
@@ -110,34 +168,60 @@ func (u *userRepositoryImpl) FindAllWithStatusAndPagination(
 	pageInfo entity.PageInfo,
 	keyword string,
 ) (pagination entity.Pagination[entity.User], err error) {
-	var userOrderBy string
+	// The users are ordered by the sort key, then by the creation time and the ID, so a cursor can resume the listing.
+	var sortKey string
 	if orderBy == entity.Ranking {
-		userOrderBy = "total_follower"
+		sortKey = "u.total_follower"
 	} else {
-		userOrderBy = "u.created_at"
+		sortKey = "0"
 	}
 
 	statement := fmt.Sprintf(`SELECT u.id,
        u.username,
        u.email,
        u.name,
+       u.bio,
+       u.avatar_url,
+       u.location,
        u.role,
        u.is_active,
        u.created_at,
        u.updated_at,
-       (SELECT count(t.id) FROM threads t WHERE t.creator_id = u.id)           AS total_thread,
-       (SELECT count(uf.id) FROM user_follows uf WHERE uf.following_id = u.id) AS total_follower,
-       (SELECT count(uf.id) FROM user_follows uf WHERE uf.user_id = u.id) AS total_following,
+       u.total_thread,
+       u.total_follower,
+       u.total_following,
        (SELECT CASE WHEN count(uf.id) > 0 THEN true ELSE false END
         FROM user_follows uf
         WHERE uf.user_id = $1
           AND uf.following_id = u.id)                                          AS is_followed
 FROM users u
-WHERE is_active = $2 AND u.role = 'user' AND u.username ILIKE $5
-ORDER BY %s
-OFFSET $3 LIMIT $4;`, userOrderBy)
+         CROSS JOIN LATERAL (SELECT %s::bigint AS sort_key) k
+WHERE is_active = $2 AND u.role = 'user' AND u.deleted_at IS NULL AND u.username ILIKE $5
+  AND ($6::varchar = ''
+    OR ($6 = 'next' AND (k.sort_key, u.created_at, u.id) > ($7::bigint, $8::timestamp, $9::varchar))
+    OR ($6 = 'prev' AND (k.sort_key, u.created_at, u.id) < ($7::bigint, $8::timestamp, $9::varchar)))
+ORDER BY CASE WHEN $6 = 'prev' THEN k.sort_key END DESC,
+         CASE WHEN $6 = 'prev' THEN u.created_at END DESC,
+         CASE WHEN $6 = 'prev' THEN u.id END DESC,
+         k.sort_key, u.created_at, u.id
+OFFSET $3 LIMIT $4;`, sortKey)
+
+	offset, limit := repository.OffsetLimit(pageInfo)
+	direction, key, createdAt, ID := repository.KeysetArgs(pageInfo)
 
-	rows, dbErr := u.db.QueryContext(ctx, statement, accessorUserID, userStatus, (pageInfo.Page-1)*pageInfo.Limit, pageInfo.Limit*1, fmt.Sprintf("%%%s%%", keyword))
+	rows, dbErr := u.conn(ctx).QueryContext(
+		ctx,
+		statement,
+		accessorUserID,
+		userStatus,
+		offset,
+		limit,
+		fmt.Sprintf("%%%s%%", keyword),
+		direction,
+		key,
+		createdAt,
+		ID,
+	)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -158,6 +242,9 @@ OFFSET $3 LIMIT $4;`, userOrderBy)
 			&user.Username,
 			&user.Email,
 			&user.Name,
+			&user.Bio,
+			&user.AvatarURL,
+			&user.Location,
 			&user.Role,
 			&user.IsActive,
 			&user.CreatedAt,
@@ -180,9 +267,22 @@ OFFSET $3 LIMIT $4;`, userOrderBy)
 		return
 	}
 
-	countStatement := "SELECT count(u.id) FROM users u WHERE is_active = $1 AND u.role = 'user' AND u.username ILIKE $2;"
+	cursorOf := func(user entity.User) entity.Cursor {
+		cursor := entity.Cursor{CreatedAt: user.CreatedAt, ID: user.ID}
+		if orderBy == entity.Ranking {
+			cursor.Key = int64(user.TotalFollower)
+		}
+		return cursor
+	}
+
+	if pageInfo.Cursor != nil {
+		repository.SetCursors(&pagination, pageInfo, cursorOf)
+		return
+	}
+
+	countStatement := "SELECT count(u.id) FROM users u WHERE is_active = $1 AND u.role = 'user' AND u.deleted_at IS NULL AND u.username ILIKE $2;"
 
-	row := u.db.QueryRowContext(ctx, countStatement, userStatus, fmt.Sprintf("%%%s%%", keyword))
+	row := u.conn(ctx).QueryRowContext(ctx, countStatement, userStatus, fmt.Sprintf("%%%s%%", keyword))
 
 	var count uint
 	switch dbErr := row.Scan(&count); dbErr {
@@ -197,6 +297,7 @@ OFFSET $3 LIMIT $4;`, userOrderBy)
 			pagination.PageInfo.Page = pageInfo.Page
 			pagination.PageInfo.PageTotal = uint(math.Ceil(float64(count) / float64(pageInfo.Limit)))
 			pagination.PageInfo.Total = count
+			repository.SetCursors(&pagination, pageInfo, cursorOf)
 			return
 		}
 	default:
@@ -217,13 +318,16 @@ func (u *userRepositoryImpl) FindByUsernameWithAccessor(
        u.username,
        u.email,
        u.name,
+       u.bio,
+       u.avatar_url,
+       u.location,
        u.role,
        u.is_active,
        u.created_at,
        u.updated_at,
-       (SELECT count(t.id) FROM threads t WHERE t.creator_id = u.id)           AS total_thread,
-       (SELECT count(uf.id) FROM user_follows uf WHERE uf.following_id = u.id) AS total_follower,
-       (SELECT count(uf.id) FROM user_follows uf WHERE uf.user_id = u.id) AS total_following,
+       u.total_thread,
+       u.total_follower,
+       u.total_following,
        (SELECT CASE WHEN count(uf.id) > 0 THEN true ELSE false END
         FROM user_follows uf
         WHERE uf.user_id = $1
@@ -232,13 +336,16 @@ FROM users u
 WHERE u.username = $2
   AND role = 'user';`
 
-	row := u.db.QueryRowContext(ctx, statement, accessorUserID, username)
+	row := u.conn(ctx).QueryRowContext(ctx, statement, accessorUserID, username)
 
 	switch dbErr := row.Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
 		&user.Name,
+		&user.Bio,
+		&user.AvatarURL,
+		&user.Location,
 		&user.Role,
 		&user.IsActive,
 		&user.CreatedAt,
@@ -268,9 +375,9 @@ WHERE u.username = $2
 
 func (u *userRepositoryImpl) BannedUser(
 	ctx context.Context,
-	userID string,
+	ban entity.Ban,
 ) (err error) {
-	tx, dbErr := u.db.BeginTx(ctx, nil)
+	tx, dbErr := repository.BeginTx(ctx, u.db, nil)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -279,33 +386,40 @@ func (u *userRepositoryImpl) BannedUser(
 
 	defer tx.Rollback()
 
-	result, dbErr := tx.ExecContext(ctx, "UPDATE user_banneds SET status = 'accepted', updated_at = current_timestamp WHERE user_id = $1;", userID)
-	if dbErr != nil {
+	// The reports of the user that are still in review are settled by the ban.
+	if _, dbErr := tx.ExecContext(ctx, "UPDATE user_banneds SET status = 'accepted', updated_at = current_timestamp WHERE user_id = $1 AND status = 'review';", ban.User.ID); dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	count, dbErr := result.RowsAffected()
-	if dbErr != nil {
+	if _, dbErr := tx.ExecContext(
+		ctx,
+		"INSERT INTO bans (id, user_id, issuer_id, reason, expires_at) VALUES ($1, $2, $3, $4, $5);",
+		ban.ID,
+		ban.User.ID,
+		nullString(ban.Issuer.ID),
+		ban.Reason,
+		nullTime(ban.ExpiresAt),
+	); dbErr != nil {
+		if e, ok := dbErr.(*pq.Error); ok && e.Code == "23505" {
+			err = repository.ErrRecordAlreadyExists
+			return
+		}
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	if count < 1 {
-		err = repository.ErrRecordAlreadyExists
-		return
-	}
-
-	result, dbErr = tx.ExecContext(ctx, "UPDATE users SET is_active = false, updated_at = current_timestamp WHERE id = $1;", userID)
+	// Bumping the token version invalidates all the issued access tokens of the user.
+	result, dbErr := tx.ExecContext(ctx, "UPDATE users SET is_active = false, token_version = token_version + 1, updated_at = current_timestamp WHERE id = $1;", ban.User.ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	count, dbErr = result.RowsAffected()
+	count, dbErr := result.RowsAffected()
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -313,7 +427,7 @@ func (u *userRepositoryImpl) BannedUser(
 	}
 
 	if count < 1 {
-		err = repository.ErrRecordAlreadyExists
+		err = repository.ErrRecordNotFound
 		return
 	}
 
@@ -329,8 +443,10 @@ func (u *userRepositoryImpl) BannedUser(
 func (u *userRepositoryImpl) UnbannedUser(
 	ctx context.Context,
 	userID string,
+	lifterID string,
+	liftedAt time.Time,
 ) (err error) {
-	tx, dbErr := u.db.BeginTx(ctx, nil)
+	tx, dbErr := repository.BeginTx(ctx, u.db, nil)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -339,7 +455,7 @@ func (u *userRepositoryImpl) UnbannedUser(
 
 	defer tx.Rollback()
 
-	result, dbErr := tx.ExecContext(ctx, "DELETE FROM user_banneds WHERE user_id = $1;", userID)
+	result, dbErr := tx.ExecContext(ctx, "UPDATE bans SET lifted_at = $1, lifter_id = $2 WHERE user_id = $3 AND lifted_at IS NULL;", liftedAt, nullString(lifterID), userID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -358,22 +474,63 @@ func (u *userRepositoryImpl) UnbannedUser(
 		return
 	}
 
-	result, dbErr = tx.ExecContext(ctx, "UPDATE users SET is_active  = true, updated_at = current_timestamp WHERE id = $1;", userID)
+	if err = activateUsers(ctx, tx, []string{userID}); err != nil {
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (u *userRepositoryImpl) UnbannedExpiredUsers(
+	ctx context.Context,
+	now time.Time,
+) (userIDs []string, err error) {
+	tx, dbErr := repository.BeginTx(ctx, u.db, nil)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	count, dbErr = result.RowsAffected()
+	defer tx.Rollback()
+
+	rows, dbErr := tx.QueryContext(ctx, "UPDATE bans SET lifted_at = $1 WHERE lifted_at IS NULL AND expires_at <= $1 RETURNING user_id;", now)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
 	}
 
-	if count < 1 {
-		err = repository.ErrRecordAlreadyExists
+	userIDs = make([]string, 0)
+
+	for rows.Next() {
+		var userID string
+		if dbErr := rows.Scan(&userID); dbErr != nil {
+			rows.Close()
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if dbErr := rows.Close(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if len(userIDs) < 1 {
+		return
+	}
+
+	if err = activateUsers(ctx, tx, userIDs); err != nil {
 		return
 	}
 
@@ -386,6 +543,146 @@ func (u *userRepositoryImpl) UnbannedUser(
 	return
 }
 
+func (u *userRepositoryImpl) FindActiveBanByUserID(
+	ctx context.Context,
+	userID string,
+) (ban entity.Ban, err error) {
+	statement := `SELECT b.id,
+       b.user_id,
+       b.reason,
+       b.expires_at,
+       b.created_at,
+       coalesce(i.id, '')       AS issuer_id,
+       coalesce(i.username, '') AS issuer_username
+FROM bans b
+         LEFT JOIN users i ON b.issuer_id = i.id
+WHERE b.user_id = $1
+  AND b.lifted_at IS NULL;`
+
+	var expiresAt sql.NullTime
+
+	row := u.conn(ctx).QueryRowContext(ctx, statement, userID)
+
+	switch dbErr := row.Scan(
+		&ban.ID,
+		&ban.User.ID,
+		&ban.Reason,
+		&expiresAt,
+		&ban.CreatedAt,
+		&ban.Issuer.ID,
+		&ban.Issuer.Username,
+	); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			ban.ExpiresAt = expiresAt.Time
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func (u *userRepositoryImpl) FindAllBanByUserID(
+	ctx context.Context,
+	userID string,
+) (bans []entity.Ban, err error) {
+	statement := `SELECT b.id,
+       b.user_id,
+       b.reason,
+       b.expires_at,
+       b.lifted_at,
+       b.created_at,
+       coalesce(i.id, '')       AS issuer_id,
+       coalesce(i.username, '') AS issuer_username,
+       coalesce(l.id, '')       AS lifter_id,
+       coalesce(l.username, '') AS lifter_username
+FROM bans b
+         LEFT JOIN users i ON b.issuer_id = i.id
+         LEFT JOIN users l ON b.lifter_id = l.id
+WHERE b.user_id = $1
+ORDER BY b.created_at DESC;`
+
+	rows, dbErr := u.conn(ctx).QueryContext(ctx, statement, userID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer rows.Close()
+
+	bans = make([]entity.Ban, 0)
+
+	for rows.Next() {
+		var ban entity.Ban
+		var expiresAt, liftedAt sql.NullTime
+
+		if dbErr := rows.Scan(
+			&ban.ID,
+			&ban.User.ID,
+			&ban.Reason,
+			&expiresAt,
+			&liftedAt,
+			&ban.CreatedAt,
+			&ban.Issuer.ID,
+			&ban.Issuer.Username,
+			&ban.Lifter.ID,
+			&ban.Lifter.Username,
+		); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+
+		ban.ExpiresAt = expiresAt.Time
+		ban.LiftedAt = liftedAt.Time
+
+		bans = append(bans, ban)
+	}
+
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+// activateUsers lets the users sign in again and be reported again, as their reports were settled by the lifted bans.
+func activateUsers(ctx context.Context, tx repository.DBTX, userIDs []string) (err error) {
+	if _, dbErr := tx.ExecContext(ctx, "DELETE FROM user_banneds WHERE user_id = ANY($1);", pq.Array(userIDs)); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if _, dbErr := tx.ExecContext(ctx, "UPDATE users SET is_active = true, updated_at = current_timestamp WHERE id = ANY($1);", pq.Array(userIDs)); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullTime(value time.Time) sql.NullTime {
+	return sql.NullTime{Time: value, Valid: !value.IsZero()}
+}
+
 func (u *userRepositoryImpl) FollowUser(
 	ctx context.Context,
 	ID string,
@@ -394,8 +691,64 @@ func (u *userRepositoryImpl) FollowUser(
 ) (err error) {
 	statement := "INSERT INTO user_follows (id, user_id, following_id) VALUES ($1, $2, $3);"
 
-	result, dbErr := u.db.ExecContext(ctx, statement, ID, accessorUserID, userID)
+	err = repository.ExecWithCounter(
+		ctx,
+		u.db,
+		statement,
+		[]any{ID, accessorUserID, userID},
+		`UPDATE users
+SET total_following = total_following + CASE WHEN id = $1 THEN 1 ELSE 0 END,
+    total_follower  = total_follower + CASE WHEN id = $2 THEN 1 ELSE 0 END
+WHERE id IN ($1, $2);`,
+		accessorUserID,
+		userID,
+	)
+	return
+}
+
+func (u *userRepositoryImpl) UnfollowUser(
+	ctx context.Context,
+	accessorUserID string,
+	userID string,
+) (err error) {
+	statement := "DELETE FROM user_follows WHERE user_id = $1 AND following_id = $2;"
+
+	err = repository.ExecWithCounter(
+		ctx,
+		u.db,
+		statement,
+		[]any{accessorUserID, userID},
+		`UPDATE users
+SET total_following = total_following - CASE WHEN id = $1 THEN 1 ELSE 0 END,
+    total_follower  = total_follower - CASE WHEN id = $2 THEN 1 ELSE 0 END
+WHERE id IN ($1, $2);`,
+		accessorUserID,
+		userID,
+	)
+	return
+}
+
+func (u *userRepositoryImpl) Update(
+	ctx context.Context,
+	ID string,
+	user entity.User,
+) (err error) {
+	statement := `UPDATE users
+SET is_verified = is_verified AND email = $1,
+    email       = $1,
+    name        = $2,
+    bio         = $3,
+    avatar_url  = $4,
+    location    = $5,
+    updated_at  = current_timestamp
+WHERE id = $6;`
+
+	result, dbErr := u.conn(ctx).ExecContext(ctx, statement, user.Email, user.Name, user.Bio, user.AvatarURL, user.Location, ID)
 	if dbErr != nil {
+		if e, ok := dbErr.(*pq.Error); ok && e.Code == "23505" {
+			err = repository.ErrRecordAlreadyExists
+			return
+		}
 		log.Println(dbErr)
 		err = repository.ErrDatabase
 		return
@@ -406,21 +759,80 @@ func (u *userRepositoryImpl) FollowUser(
 		err = repository.ErrDatabase
 	} else {
 		if count < 1 {
-			err = repository.ErrDatabase
+			err = repository.ErrRecordNotFound
 		}
 	}
 
 	return
 }
 
-func (u *userRepositoryImpl) UnfollowUser(
+func (u *userRepositoryImpl) UpdatePassword(
 	ctx context.Context,
-	accessorUserID string,
-	userID string,
+	ID string,
+	password string,
 ) (err error) {
-	statement := "DELETE FROM user_follows WHERE user_id = $1 AND following_id = $2;"
+	// Bumping the token version invalidates all the issued access tokens and sessions of the user.
+	statement := "UPDATE users SET password = $1, token_version = token_version + 1, updated_at = current_timestamp WHERE id = $2;"
+
+	result, dbErr := u.conn(ctx).ExecContext(ctx, statement, password, ID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	return
+}
+
+func (u *userRepositoryImpl) UpdateRole(
+	ctx context.Context,
+	ID string,
+	role string,
+) (err error) {
+	// Bumping the token version makes the user sign in again, so the tokens carry the new role.
+	statement := "UPDATE users SET role = $1, token_version = token_version + 1, updated_at = current_timestamp WHERE id = $2;"
+
+	result, dbErr := u.conn(ctx).ExecContext(ctx, statement, role, ID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	return
+}
+
+func (u *userRepositoryImpl) MarkVerified(
+	ctx context.Context,
+	ID string,
+) (err error) {
+	statement := "UPDATE users SET is_verified = true, updated_at = current_timestamp WHERE id = $1;"
 
-	result, dbErr := u.db.ExecContext(ctx, statement, accessorUserID, userID)
+	result, dbErr := u.conn(ctx).ExecContext(ctx, statement, ID)
 	if dbErr != nil {
 		log.Println(dbErr)
 		err = repository.ErrDatabase
@@ -432,9 +844,320 @@ func (u *userRepositoryImpl) UnfollowUser(
 		err = repository.ErrDatabase
 	} else {
 		if count < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+
+	return
+}
+
+func (u *userRepositoryImpl) FindPersonalData(
+	ctx context.Context,
+	userID string,
+) (data entity.PersonalData, err error) {
+	tx, dbErr := repository.BeginTx(ctx, u.db, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	userStatement := `SELECT u.id,
+       u.username,
+       u.email,
+       u.name,
+       u.bio,
+       u.avatar_url,
+       u.location,
+       u.role,
+       u.is_active,
+       u.is_verified,
+       u.created_at,
+       u.updated_at
+FROM users u
+WHERE u.id = $1
+  AND u.deleted_at IS NULL;`
+
+	row := tx.QueryRowContext(ctx, userStatement, userID)
+
+	switch dbErr := row.Scan(
+		&data.User.ID,
+		&data.User.Username,
+		&data.User.Email,
+		&data.User.Name,
+		&data.User.Bio,
+		&data.User.AvatarURL,
+		&data.User.Location,
+		&data.User.Role,
+		&data.User.IsActive,
+		&data.User.IsVerified,
+		&data.User.CreatedAt,
+		&data.User.UpdatedAt,
+	); dbErr {
+	case sql.ErrNoRows:
+		err = repository.ErrRecordNotFound
+		return
+	case nil:
+	default:
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	threadsStatement := `SELECT t.id, t.title, t.description, c.id, c.name, t.created_at, t.updated_at
+FROM threads t
+         INNER JOIN categories c ON c.id = t.category_id
+WHERE t.creator_id = $1
+ORDER BY t.created_at;`
+
+	data.Threads = make([]entity.Thread, 0)
+	if err = queryAll(ctx, tx, threadsStatement, userID, func(rows *sql.Rows) error {
+		var thread entity.Thread
+		if dbErr := rows.Scan(
+			&thread.ID,
+			&thread.Title,
+			&thread.Description,
+			&thread.Category.ID,
+			&thread.Category.Name,
+			&thread.CreatedAt,
+			&thread.UpdatedAt,
+		); dbErr != nil {
+			return dbErr
+		}
+		data.Threads = append(data.Threads, thread)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	// The deleted comments are part of the export too, with their deletion time.
+	commentsStatement := `SELECT c.id, t.id, t.title, c.parent_id, c.comment, c.created_at, c.edited_at, c.deleted_at
+FROM comments c
+         INNER JOIN threads t ON t.id = c.thread_id
+WHERE c.user_id = $1
+ORDER BY c.created_at;`
+
+	data.Comments = make([]entity.Comment, 0)
+	if err = queryAll(ctx, tx, commentsStatement, userID, func(rows *sql.Rows) error {
+		var comment entity.Comment
+		var parentID sql.NullString
+		var editedAt, deletedAt sql.NullTime
+		if dbErr := rows.Scan(
+			&comment.ID,
+			&comment.Thread.ID,
+			&comment.Thread.Title,
+			&parentID,
+			&comment.Comment,
+			&comment.CreatedAt,
+			&editedAt,
+			&deletedAt,
+		); dbErr != nil {
+			return dbErr
+		}
+		comment.ParentID = parentID.String
+		comment.EditedAt = editedAt.Time
+		comment.DeletedAt = deletedAt.Time
+		data.Comments = append(data.Comments, comment)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	likesStatement := `SELECT l.id, t.id, t.title, l.created_at
+FROM likes l
+         INNER JOIN threads t ON t.id = l.thread_id
+WHERE l.user_id = $1
+ORDER BY l.created_at;`
+
+	data.Likes = make([]entity.Like, 0)
+	if err = queryAll(ctx, tx, likesStatement, userID, func(rows *sql.Rows) error {
+		var like entity.Like
+		if dbErr := rows.Scan(&like.ID, &like.Thread.ID, &like.Thread.Title, &like.CreatedAt); dbErr != nil {
+			return dbErr
+		}
+		data.Likes = append(data.Likes, like)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	followingStatement := `SELECT uf.id, u.id, u.username, uf.created_at
+FROM user_follows uf
+         INNER JOIN users u ON u.id = uf.following_id
+WHERE uf.user_id = $1
+ORDER BY uf.created_at;`
+
+	data.Following = make([]entity.UserFollow, 0)
+	if err = queryAll(ctx, tx, followingStatement, userID, func(rows *sql.Rows) error {
+		var follow entity.UserFollow
+		if dbErr := rows.Scan(&follow.ID, &follow.Following.ID, &follow.Following.Username, &follow.CreatedAt); dbErr != nil {
+			return dbErr
+		}
+		data.Following = append(data.Following, follow)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	followersStatement := `SELECT uf.id, u.id, u.username, uf.created_at
+FROM user_follows uf
+         INNER JOIN users u ON u.id = uf.user_id
+WHERE uf.following_id = $1
+ORDER BY uf.created_at;`
+
+	data.Followers = make([]entity.UserFollow, 0)
+	if err = queryAll(ctx, tx, followersStatement, userID, func(rows *sql.Rows) error {
+		var follow entity.UserFollow
+		if dbErr := rows.Scan(&follow.ID, &follow.User.ID, &follow.User.Username, &follow.CreatedAt); dbErr != nil {
+			return dbErr
+		}
+		data.Followers = append(data.Followers, follow)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	followedThreadsStatement := `SELECT tf.id, t.id, t.title, tf.created_at
+FROM thread_follows tf
+         INNER JOIN threads t ON t.id = tf.thread_id
+WHERE tf.user_id = $1
+ORDER BY tf.created_at;`
+
+	data.FollowedThreads = make([]entity.ThreadFollow, 0)
+	if err = queryAll(ctx, tx, followedThreadsStatement, userID, func(rows *sql.Rows) error {
+		var follow entity.ThreadFollow
+		if dbErr := rows.Scan(&follow.ID, &follow.Thread.ID, &follow.Thread.Title, &follow.CreatedAt); dbErr != nil {
+			return dbErr
+		}
+		data.FollowedThreads = append(data.FollowedThreads, follow)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+func (u *userRepositoryImpl) Anonymize(
+	ctx context.Context,
+	userID string,
+	deletedAt time.Time,
+) (err error) {
+	tx, dbErr := repository.BeginTx(ctx, u.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	// The likes and the follows are deleted along with the counters that count them.
+	statements := []string{
+		`WITH deleted AS (DELETE FROM likes WHERE user_id = $1 RETURNING thread_id)
+UPDATE threads t
+SET total_like = t.total_like - d.total
+FROM (SELECT thread_id, count(*) AS total FROM deleted GROUP BY thread_id) d
+WHERE t.id = d.thread_id;`,
+		`WITH deleted AS (DELETE FROM user_follows WHERE user_id = $1 OR following_id = $1 RETURNING user_id, following_id)
+UPDATE users u
+SET total_follower  = u.total_follower - (SELECT count(*) FROM deleted d WHERE d.following_id = u.id),
+    total_following = u.total_following - (SELECT count(*) FROM deleted d WHERE d.user_id = u.id)
+WHERE u.id IN (SELECT user_id FROM deleted UNION SELECT following_id FROM deleted);`,
+		`WITH deleted AS (DELETE FROM thread_follows WHERE user_id = $1 RETURNING thread_id)
+UPDATE threads t
+SET total_follower = t.total_follower - d.total
+FROM (SELECT thread_id, count(*) AS total FROM deleted GROUP BY thread_id) d
+WHERE t.id = d.thread_id;`,
+		"DELETE FROM notifications WHERE user_id = $1;",
+		"DELETE FROM user_tokens WHERE user_id = $1;",
+		"DELETE FROM sessions WHERE user_id = $1;",
+	}
+
+	for _, statement := range statements {
+		if _, dbErr := tx.ExecContext(ctx, statement, userID); dbErr != nil {
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+
+	// The placeholders are derived from the ID to stay unique, the empty password never matches a bcrypt hash and
+	// bumping the token version invalidates all the issued access tokens of the user.
+	statement := `UPDATE users
+SET username      = 'deleted_' || substr(id, 3),
+    email         = id || '@deleted.invalid',
+    name          = 'Deleted User',
+    password      = '',
+    bio           = '',
+    avatar_url    = '',
+    location      = '',
+    role          = 'user',
+    is_verified   = false,
+    token_version = token_version + 1,
+    deleted_at    = $2,
+    updated_at    = $2
+WHERE id = $1
+  AND deleted_at IS NULL;`
+
+	result, dbErr := tx.ExecContext(ctx, statement, userID, deletedAt)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+// queryAll runs the statement with userID as $1 in the transaction and calls scan for every row.
+func queryAll(ctx context.Context, tx repository.DBTX, statement, userID string, scan func(rows *sql.Rows) error) (err error) {
+	rows, dbErr := tx.QueryContext(ctx, statement, userID)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer func(rows *sql.Rows) {
+		if dbErr := rows.Close(); dbErr != nil {
+			log.Println(dbErr)
+		}
+	}(rows)
+
+	for rows.Next() {
+		if dbErr := scan(rows); dbErr != nil {
+			log.Println(dbErr)
 			err = repository.ErrDatabase
+			return
 		}
 	}
 
+	if dbErr := rows.Err(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
 	return
 }
@@ -213,8 +213,8 @@ func TestFindByUsername(t *testing.T) {
 			},
 			expectedError: nil,
 			mockBehaviour: func() {
-				returnedRows := sqlmock.NewRows([]string{"id", "username", "email", "name", "password", "role", "is_active", "created_at", "updated_at"})
-				returnedRows.AddRow("u-gXyZpw", "erikrios", "erikriosetiawan15@gmail.com", "Erik Rio Setiawan", "erikriosetiawan", "user", true, time.Now(), time.Now())
+				returnedRows := sqlmock.NewRows([]string{"id", "username", "email", "name", "password", "role", "is_active", "is_verified", "token_version", "created_at", "updated_at"})
+				returnedRows.AddRow("u-gXyZpw", "erikrios", "erikriosetiawan15@gmail.com", "Erik Rio Setiawan", "erikriosetiawan", "user", true, true, 0, time.Now(), time.Now())
 				mock.ExpectQuery(".*").WithArgs(
 					sqlmock.AnyArg(),
 				).WillReturnRows(returnedRows)
@@ -394,3 +394,173 @@ func TestFindByUsername(t *testing.T) {
 // 		t.Logf("Successfully unfollow a user with ID %s", userID)
 // 	}
 // }
+
+func TestUnbannedExpiredUsers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo UserRepository = NewUserRepositoryImpl(db)
+
+	now := time.Now()
+
+	t.Run("it should return an empty slice without activating anyone, when no ban is expired", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE bans").WithArgs(now).WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+		mock.ExpectRollback()
+
+		gotUserIDs, gotError := repo.UnbannedExpiredUsers(context.Background(), now)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.Empty(t, gotUserIDs)
+	})
+
+	t.Run("it should activate the users of the expired bans, when there is no error", func(t *testing.T) {
+		returnedRows := sqlmock.NewRows([]string{"user_id"})
+		returnedRows.AddRow("u-abcdef")
+		returnedRows.AddRow("u-ghijkl")
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE bans").WithArgs(now).WillReturnRows(returnedRows)
+		mock.ExpectExec("DELETE FROM user_banneds").WithArgs(pq.Array([]string{"u-abcdef", "u-ghijkl"})).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE users").WithArgs(pq.Array([]string{"u-abcdef", "u-ghijkl"})).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		gotUserIDs, gotError := repo.UnbannedExpiredUsers(context.Background(), now)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.Equal(t, []string{"u-abcdef", "u-ghijkl"}, gotUserIDs)
+	})
+
+	t.Run("it should return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("UPDATE bans").WithArgs(now).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, gotError := repo.UnbannedExpiredUsers(context.Background(), now)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+}
+
+func TestAnonymize(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo UserRepository = NewUserRepositoryImpl(db)
+
+	now := time.Now()
+
+	expectDeletes := func() {
+		mock.ExpectExec("DELETE FROM likes").WithArgs("u-abcdef").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec("DELETE FROM user_follows").WithArgs("u-abcdef").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("DELETE FROM thread_follows").WithArgs("u-abcdef").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM notifications").WithArgs("u-abcdef").WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec("DELETE FROM user_tokens").WithArgs("u-abcdef").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM sessions").WithArgs("u-abcdef").WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("it should return ErrRecordNotFound, when the user doesn't exist or is already deleted", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectDeletes()
+		mock.ExpectExec("UPDATE users").WithArgs("u-abcdef", now).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		gotError := repo.Anonymize(context.Background(), "u-abcdef", now)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrRecordNotFound, gotError)
+	})
+
+	t.Run("it should anonymize the user, when there is no error", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectDeletes()
+		mock.ExpectExec("UPDATE users").WithArgs("u-abcdef", now).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		gotError := repo.Anonymize(context.Background(), "u-abcdef", now)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+
+	t.Run("it should return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM likes").WithArgs("u-abcdef").WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		gotError := repo.Anonymize(context.Background(), "u-abcdef", now)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+}
+
+func TestFollowUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo UserRepository = NewUserRepositoryImpl(db)
+
+	t.Run("it should update the counters of both users, when there is no error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO user_follows").WithArgs("f-abcdef", "u-abcdef", "u-ghijkl").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE users").WithArgs("u-abcdef", "u-ghijkl").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		gotError := repo.FollowUser(context.Background(), "f-abcdef", "u-abcdef", "u-ghijkl")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+
+	t.Run("it should roll back the follow and return ErrDatabase, when the counters can't be updated", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO user_follows").WithArgs("f-abcdef", "u-abcdef", "u-ghijkl").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE users").WithArgs("u-abcdef", "u-ghijkl").WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		gotError := repo.FollowUser(context.Background(), "f-abcdef", "u-abcdef", "u-ghijkl")
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+}
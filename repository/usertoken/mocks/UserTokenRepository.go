@@ -0,0 +1,65 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserTokenRepository is an autogenerated mock type for the UserTokenRepository type
+type UserTokenRepository struct {
+	mock.Mock
+}
+
+// Insert provides a mock function with given fields: ctx, userToken
+func (_m *UserTokenRepository) Insert(ctx context.Context, userToken entity.UserToken) error {
+	ret := _m.Called(ctx, userToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserToken) error); ok {
+		r0 = rf(ctx, userToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Use provides a mock function with given fields: ctx, tokenHash, purpose
+func (_m *UserTokenRepository) Use(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) (string, error) {
+	ret := _m.Called(ctx, tokenHash, purpose)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.UserTokenPurpose) string); ok {
+		r0 = rf(ctx, tokenHash, purpose)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.UserTokenPurpose) error); ok {
+		r1 = rf(ctx, tokenHash, purpose)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUserTokenRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUserTokenRepository creates a new instance of UserTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUserTokenRepository(t mockConstructorTestingTNewUserTokenRepository) *UserTokenRepository {
+	mock := &UserTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
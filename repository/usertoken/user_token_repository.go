@@ -0,0 +1,19 @@
+package usertoken
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+type UserTokenRepository interface {
+	// Insert stores the token and invalidates the unused tokens of the user with the same purpose.
+	Insert(ctx context.Context, userToken entity.UserToken) (err error)
+
+	// Use marks the unused and unexpired token with the given hash as used, then returns its user ID.
+	Use(
+		ctx context.Context,
+		tokenHash string,
+		purpose entity.UserTokenPurpose,
+	) (userID string, err error)
+}
@@ -0,0 +1,129 @@
+package usertoken
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+)
+
+type userTokenRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewUserTokenRepositoryImpl(db *sql.DB) *userTokenRepositoryImpl {
+	return &userTokenRepositoryImpl{db: db}
+}
+
+// conn returns the transaction carried by ctx, or the database if there is none.
+func (u *userTokenRepositoryImpl) conn(ctx context.Context) repository.DBTX {
+	return repository.Executor(ctx, u.db)
+}
+
+func (u *userTokenRepositoryImpl) Insert(ctx context.Context, userToken entity.UserToken) (err error) {
+	tx, dbErr := repository.BeginTx(ctx, u.db, nil)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	defer tx.Rollback()
+
+	purpose := userTokenPurposeToString(userToken.Purpose)
+
+	// Only the latest token is valid, so the previously sent tokens can't be used anymore.
+	if _, dbErr := tx.ExecContext(
+		ctx,
+		"UPDATE user_tokens SET used_at = current_timestamp WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL;",
+		userToken.User.ID,
+		purpose,
+	); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	result, dbErr := tx.ExecContext(
+		ctx,
+		"INSERT INTO user_tokens(id, user_id, purpose, token_hash, expires_at) VALUES ($1, $2, $3, $4, $5);",
+		userToken.ID,
+		userToken.User.ID,
+		purpose,
+		userToken.TokenHash,
+		userToken.ExpiresAt,
+	)
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	count, dbErr := result.RowsAffected()
+	if dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrDatabase
+		return
+	}
+
+	if dbErr := tx.Commit(); dbErr != nil {
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	return
+}
+
+func (u *userTokenRepositoryImpl) Use(
+	ctx context.Context,
+	tokenHash string,
+	purpose entity.UserTokenPurpose,
+) (userID string, err error) {
+	// The update is atomic, so a token can't be used twice by the concurrent requests.
+	statement := `UPDATE user_tokens
+SET used_at = current_timestamp
+WHERE token_hash = $1
+  AND purpose = $2
+  AND used_at IS NULL
+  AND expires_at > $3
+RETURNING user_id;`
+
+	row := u.conn(ctx).QueryRowContext(ctx, statement, tokenHash, userTokenPurposeToString(purpose), time.Now())
+
+	switch dbErr := row.Scan(&userID); dbErr {
+	case sql.ErrNoRows:
+		{
+			err = repository.ErrRecordNotFound
+			return
+		}
+	case nil:
+		{
+			return
+		}
+	default:
+		{
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
+		}
+	}
+}
+
+func userTokenPurposeToString(purpose entity.UserTokenPurpose) (value string) {
+	switch purpose {
+	case entity.PasswordResetToken:
+		value = "password_reset"
+	default:
+		value = "email_verification"
+	}
+	return
+}
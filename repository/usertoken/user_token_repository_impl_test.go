@@ -0,0 +1,114 @@
+package usertoken
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo UserTokenRepository = NewUserTokenRepositoryImpl(db)
+
+	dummyUserToken := entity.UserToken{
+		ID:        "k-abcdefg",
+		User:      entity.User{ID: "u-abcdef"},
+		Purpose:   entity.PasswordResetToken,
+		TokenHash: "hashedtoken",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	t.Run("it should return ErrDatabase, when database return an error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE user_tokens").WithArgs("u-abcdef", "password_reset").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO user_tokens").WillReturnError(repository.ErrDatabase)
+		mock.ExpectRollback()
+
+		gotError := repo.Insert(context.Background(), dummyUserToken)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrDatabase, gotError)
+	})
+
+	t.Run("it should invalidate the previous tokens, then insert the token", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE user_tokens").WithArgs("u-abcdef", "password_reset").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO user_tokens").WithArgs(
+			"k-abcdefg",
+			"u-abcdef",
+			"password_reset",
+			"hashedtoken",
+			sqlmock.AnyArg(),
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		gotError := repo.Insert(context.Background(), dummyUserToken)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+	})
+}
+
+func TestUse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var repo UserTokenRepository = NewUserTokenRepositoryImpl(db)
+
+	t.Run("it should return ErrRecordNotFound, when the token is used, expired or unknown", func(t *testing.T) {
+		mock.ExpectQuery("UPDATE user_tokens").WithArgs(
+			"hashedtoken",
+			"email_verification",
+			sqlmock.AnyArg(),
+		).WillReturnError(sql.ErrNoRows)
+
+		_, gotError := repo.Use(context.Background(), "hashedtoken", entity.EmailVerificationToken)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, repository.ErrRecordNotFound, gotError)
+	})
+
+	t.Run("it should return the user ID, when the token is valid", func(t *testing.T) {
+		returnedRows := sqlmock.NewRows([]string{"user_id"})
+		returnedRows.AddRow("u-abcdef")
+		mock.ExpectQuery("UPDATE user_tokens").WithArgs(
+			"hashedtoken",
+			"email_verification",
+			sqlmock.AnyArg(),
+		).WillReturnRows(returnedRows)
+
+		gotUserID, gotError := repo.Use(context.Background(), "hashedtoken", entity.EmailVerificationToken)
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.Equal(t, "u-abcdef", gotUserID)
+	})
+}
@@ -2,10 +2,39 @@ package admin
 
 import (
 	"context"
+	"io"
 
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 )
 
 type AdminService interface {
 	GetDashboardInfo(ctx context.Context, accessorRole string) (r response.DashboardInfo, err error)
+
+	GetStats(ctx context.Context, accessorRole string, f payload.StatsFilter) (r response.Stats, err error)
+
+	// Export streams every row of the resource (users, threads, comments or reports) matching the filter to w.
+	// The payload is checked before anything is written, so w is untouched when a validation error is returned.
+	Export(ctx context.Context, accessorRole, resource string, f payload.ExportFilter, w io.Writer) (err error)
+
+	GetAllLockout(ctx context.Context, accessorRole string) (rs []response.Lockout, err error)
+
+	ClearLockout(ctx context.Context, accessorUserID, accessorRole, kind, value string) (err error)
+
+	GetAllRole(ctx context.Context, accessorRole string) (rs []response.Role, err error)
+
+	GrantRole(
+		ctx context.Context,
+		accessorUserID,
+		accessorRole,
+		username string,
+		p payload.GrantRole,
+	) (err error)
+
+	RevokeRole(
+		ctx context.Context,
+		accessorUserID,
+		accessorRole,
+		username string,
+	) (err error)
 }
@@ -2,23 +2,58 @@ package admin
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/admin"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/loginattempt"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
+	"gopkg.in/validator.v2"
+)
+
+const (
+	statsDateLayout = "2006-01-02"
+	// defaultStatsDays is the length of the period when the filter has no From.
+	defaultStatsDays = 30
+	// maxStatsBuckets keeps a daily series to about a year.
+	maxStatsBuckets   = 366
+	defaultStatsLimit = 5
+	maxStatsLimit     = 50
 )
 
 type adminServiceImpl struct {
-	adminRepository admin.AdminRepository
+	adminRepository        admin.AdminRepository
+	userRepository         user.UserRepository
+	loginAttemptRepository loginattempt.LoginAttemptRepository
+	auditService           audit.AuditService
+	txManager              repository.TxManager
 }
 
-func NewAdminServiceImpl(adminRepository admin.AdminRepository) *adminServiceImpl {
-	return &adminServiceImpl{adminRepository: adminRepository}
+func NewAdminServiceImpl(
+	adminRepository admin.AdminRepository,
+	userRepository user.UserRepository,
+	loginAttemptRepository loginattempt.LoginAttemptRepository,
+	auditService audit.AuditService,
+	txManager repository.TxManager,
+) *adminServiceImpl {
+	return &adminServiceImpl{
+		adminRepository:        adminRepository,
+		userRepository:         userRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		auditService:           auditService,
+		txManager:              txManager,
+	}
 }
 
 func (a *adminServiceImpl) GetDashboardInfo(ctx context.Context, accessorRole string) (r response.DashboardInfo, err error) {
-	if accessorRole != "admin" {
-		err = service.ErrAccessForbidden
+	if err = service.Authorize(accessorRole, service.PermissionViewDashboard); err != nil {
 		return
 	}
 
@@ -35,3 +70,416 @@ func (a *adminServiceImpl) GetDashboardInfo(ctx context.Context, accessorRole st
 
 	return
 }
+
+func (a *adminServiceImpl) GetStats(
+	ctx context.Context,
+	accessorRole string,
+	f payload.StatsFilter,
+) (r response.Stats, err error) {
+	if err = service.Authorize(accessorRole, service.PermissionViewDashboard); err != nil {
+		return
+	}
+
+	period, limit, err := newStatsPeriod(f, time.Now())
+	if err != nil {
+		return
+	}
+
+	buckets, repoErr := a.adminRepository.FindStatsSeries(ctx, period)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	categories, repoErr := a.adminRepository.FindTopCategories(ctx, period, limit)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	threads, repoErr := a.adminRepository.FindTopThreads(ctx, period, limit)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	users, repoErr := a.adminRepository.FindMostActiveUsers(ctx, period, limit)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	r.From = period.From.Format(statsDateLayout)
+	r.To = period.To.AddDate(0, 0, -1).Format(statsDateLayout)
+	r.Interval = period.Interval.String()
+
+	r.Series = make([]response.StatsBucket, len(buckets))
+	for i, bucket := range buckets {
+		r.Series[i] = response.StatsBucket{
+			Start:      bucket.Start.Format(statsDateLayout),
+			NewUser:    uint(bucket.NewUser),
+			NewThread:  uint(bucket.NewThread),
+			NewComment: uint(bucket.NewComment),
+			NewLike:    uint(bucket.NewLike),
+			NewReport:  uint(bucket.NewReport),
+		}
+	}
+
+	r.TopCategories = make([]response.CategoryStats, len(categories))
+	for i, category := range categories {
+		r.TopCategories[i] = response.CategoryStats{
+			ID:        category.Category.ID,
+			Name:      category.Category.Name,
+			NewThread: uint(category.NewThread),
+		}
+	}
+
+	r.TopThreads = make([]response.ThreadStats, len(threads))
+	for i, thread := range threads {
+		r.TopThreads[i] = response.ThreadStats{
+			ID:              thread.Thread.ID,
+			Title:           thread.Thread.Title,
+			CreatorID:       thread.Thread.Creator.ID,
+			CreatorUsername: thread.Thread.Creator.Username,
+			CategoryID:      thread.Thread.Category.ID,
+			CategoryName:    thread.Thread.Category.Name,
+			PublishedOn:     thread.Thread.CreatedAt.Format(time.RFC822),
+			NewComment:      uint(thread.NewComment),
+			NewLike:         uint(thread.NewLike),
+		}
+	}
+
+	r.MostActiveUsers = make([]response.UserStats, len(users))
+	for i, user := range users {
+		r.MostActiveUsers[i] = response.UserStats{
+			UserID:     user.User.ID,
+			Username:   user.User.Username,
+			Name:       user.User.Name,
+			Role:       user.User.Role,
+			NewThread:  uint(user.NewThread),
+			NewComment: uint(user.NewComment),
+		}
+	}
+
+	return
+}
+
+// newStatsPeriod converts the filter to a half-open period, the To of the filter is inclusive.
+func newStatsPeriod(f payload.StatsFilter, now time.Time) (period entity.StatsPeriod, limit uint, err error) {
+	switch f.Interval {
+	case "", "day":
+		period.Interval = entity.DailyInterval
+	case "week":
+		period.Interval = entity.WeeklyInterval
+	case "month":
+		period.Interval = entity.MonthlyInterval
+	default:
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if f.To != "" {
+		if to, err = time.Parse(statsDateLayout, f.To); err != nil {
+			err = service.ErrInvalidPayload
+			return
+		}
+	}
+
+	from := to.AddDate(0, 0, -defaultStatsDays)
+	if f.From != "" {
+		if from, err = time.Parse(statsDateLayout, f.From); err != nil {
+			err = service.ErrInvalidPayload
+			return
+		}
+	}
+
+	if from.After(to) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	period.From = from
+	period.To = to.AddDate(0, 0, 1)
+
+	if countStatsBuckets(period) > maxStatsBuckets {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	limit = f.Limit
+	if limit <= 0 {
+		limit = defaultStatsLimit
+	}
+
+	if limit > maxStatsLimit {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	return
+}
+
+func countStatsBuckets(period entity.StatsPeriod) int {
+	days := int(period.To.Sub(period.From).Hours() / 24)
+
+	switch period.Interval {
+	case entity.WeeklyInterval:
+		return days/7 + 1
+	case entity.MonthlyInterval:
+		return (period.To.Year()-period.From.Year())*12 + int(period.To.Month()-period.From.Month()) + 1
+	default:
+		return days
+	}
+}
+
+func (a *adminServiceImpl) Export(
+	ctx context.Context,
+	accessorRole,
+	resource string,
+	f payload.ExportFilter,
+	w io.Writer,
+) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionExportData); err != nil {
+		return
+	}
+
+	header, ok := exportHeaders[resource]
+	if !ok {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	format := f.Format
+	if format == "" {
+		format = CSVExportFormat
+	}
+
+	if format != CSVExportFormat && format != NDJSONExportFormat {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	var filter entity.ExportFilter
+
+	if f.CreatedAfter != "" {
+		if filter.CreatedAfter, err = time.Parse(statsDateLayout, f.CreatedAfter); err != nil {
+			err = service.ErrInvalidPayload
+			return
+		}
+	}
+
+	if f.CreatedBefore != "" {
+		if filter.CreatedBefore, err = time.Parse(statsDateLayout, f.CreatedBefore); err != nil {
+			err = service.ErrInvalidPayload
+			return
+		}
+	}
+
+	out := newExportWriter(format, header, w)
+
+	// writeErr tells a failed write to the client apart from a failed query, the repository returns both as is.
+	var writeErr error
+	write := func(value any, record []string) error {
+		writeErr = out.Write(value, record)
+		return writeErr
+	}
+
+	var repoErr error
+	switch resource {
+	case UsersExport:
+		repoErr = a.adminRepository.StreamUsers(ctx, filter, func(user entity.User) error {
+			return write(newUserExport(user))
+		})
+	case ThreadsExport:
+		repoErr = a.adminRepository.StreamThreads(ctx, filter, func(thread entity.Thread) error {
+			return write(newThreadExport(thread))
+		})
+	case CommentsExport:
+		repoErr = a.adminRepository.StreamComments(ctx, filter, func(comment entity.Comment) error {
+			return write(newCommentExport(comment))
+		})
+	case ReportsExport:
+		repoErr = a.adminRepository.StreamReports(ctx, filter, func(report entity.UserBanned) error {
+			return write(newReportExport(report))
+		})
+	}
+
+	if repoErr != nil {
+		if writeErr != nil {
+			err = writeErr
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	err = out.Flush()
+	return
+}
+
+func (a *adminServiceImpl) GetAllLockout(ctx context.Context, accessorRole string) (rs []response.Lockout, err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageLockouts); err != nil {
+		return
+	}
+
+	attempts, repoErr := a.loginAttemptRepository.FindAllLocked(ctx, time.Now())
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs = make([]response.Lockout, len(attempts))
+
+	for i, attempt := range attempts {
+		kind := "username"
+		if attempt.Kind == entity.IPLoginAttempt {
+			kind = "ip"
+		}
+
+		rs[i] = response.Lockout{
+			Kind:           kind,
+			Value:          attempt.Value,
+			FailedAttempts: attempt.FailedAttempts,
+			LastFailedOn:   attempt.LastFailedAt.Format(time.RFC822),
+			LockedUntil:    attempt.LockedUntil.Format(time.RFC822),
+		}
+	}
+
+	return
+}
+
+func (a *adminServiceImpl) ClearLockout(ctx context.Context, accessorUserID, accessorRole, kind, value string) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageLockouts); err != nil {
+		return
+	}
+
+	var attemptKind entity.LoginAttemptKind
+	switch kind {
+	case "username":
+		attemptKind = entity.UsernameLoginAttempt
+	case "ip":
+		attemptKind = entity.IPLoginAttempt
+	default:
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if txErr := a.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := a.loginAttemptRepository.Delete(ctx, attemptKind, value); repoErr != nil {
+			return repoErr
+		}
+
+		return a.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.LockoutCleared,
+			TargetType: audit.LockoutTarget,
+			TargetID:   value,
+			Before:     map[string]any{"kind": kind},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	return
+}
+
+func (a *adminServiceImpl) GetAllRole(ctx context.Context, accessorRole string) (rs []response.Role, err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageRoles); err != nil {
+		return
+	}
+
+	rs = make([]response.Role, len(service.Roles))
+
+	for i, role := range service.Roles {
+		permissions := service.PermissionsOf(role)
+
+		rs[i] = response.Role{
+			Name:        role,
+			Permissions: make([]string, len(permissions)),
+		}
+
+		for j, permission := range permissions {
+			rs[i].Permissions[j] = string(permission)
+		}
+	}
+
+	return
+}
+
+func (a *adminServiceImpl) GrantRole(
+	ctx context.Context,
+	accessorUserID,
+	accessorRole,
+	username string,
+	p payload.GrantRole,
+) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageRoles); err != nil {
+		return
+	}
+
+	if validateErr := validator.Validate(p); validateErr != nil || !service.IsValidRole(p.Role) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	err = a.changeRole(ctx, accessorUserID, username, p.Role, audit.RoleGranted)
+	return
+}
+
+func (a *adminServiceImpl) RevokeRole(
+	ctx context.Context,
+	accessorUserID,
+	accessorRole,
+	username string,
+) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageRoles); err != nil {
+		return
+	}
+
+	err = a.changeRole(ctx, accessorUserID, username, service.RoleUser, audit.RoleRevoked)
+	return
+}
+
+func (a *adminServiceImpl) changeRole(ctx context.Context, accessorUserID, username, role, action string) (err error) {
+	user, repoErr := a.userRepository.FindByUsername(ctx, username)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrUsernameNotFound
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// An admin can't change their own role, so there is always an admin left to manage the roles.
+	if user.ID == accessorUserID {
+		err = service.ErrAccessForbidden
+		return
+	}
+
+	if user.Role == role {
+		return
+	}
+
+	if txErr := a.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := a.userRepository.UpdateRole(ctx, user.ID, role); repoErr != nil {
+			return repoErr
+		}
+
+		return a.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     action,
+			TargetType: audit.UserTarget,
+			TargetID:   user.ID,
+			Before:     map[string]any{"role": user.Role},
+			After:      map[string]any{"role": role},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	return
+}
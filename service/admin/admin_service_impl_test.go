@@ -1,22 +1,35 @@
 package admin
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/admin/mocks"
+	mlr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/loginattempt/mocks"
+	mrp "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/mocks"
+	mur "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
+	mas "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestGetDashboardInfo(t *testing.T) {
 	mockAdminRepo := &mocks.AdminRepository{}
-	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo)
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
 
 	testCases := []struct {
 		name                  string
@@ -99,3 +112,930 @@ func TestGetDashboardInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStats(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	from := time.Date(2022, time.June, 27, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.July, 4, 0, 0, 0, 0, time.UTC)
+	publishedAt := time.Date(2022, time.June, 28, 10, 0, 0, 0, time.UTC)
+
+	expectedPeriod := entity.StatsPeriod{From: from, To: to, Interval: entity.WeeklyInterval}
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		inputFilter       payload.StatsFilter
+		expectedError     error
+		expectedStats     response.Stats
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, if accessorRole is not admin",
+			inputAccessorRole: "user",
+			inputFilter:       payload.StatsFilter{},
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if interval is unknown",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{Interval: "year"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if from is not a valid date",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{From: "27-06-2022"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if from is after to",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{From: "2022-07-04", To: "2022-07-03"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if the daily series is longer than a year",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{From: "2020-01-01", To: "2022-07-03"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if limit is more than 50",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{Limit: 51},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrRepository, when admin repository return repository.ErrDatabase",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{},
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockAdminRepo.On(
+					"FindStatsSeries",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.StatsPeriod{})),
+				).Return(
+					func(ctx context.Context, period entity.StatsPeriod) []entity.StatsBucket {
+						return nil
+					},
+					func(ctx context.Context, period entity.StatsPeriod) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return valid stats, when no error is returned",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.StatsFilter{From: "2022-06-27", To: "2022-07-03", Interval: "week", Limit: 3},
+			expectedError:     nil,
+			expectedStats: response.Stats{
+				From:     "2022-06-27",
+				To:       "2022-07-03",
+				Interval: "week",
+				Series: []response.StatsBucket{
+					{Start: "2022-06-27", NewUser: 12, NewThread: 5, NewComment: 40, NewLike: 61, NewReport: 2},
+				},
+				TopCategories: []response.CategoryStats{
+					{ID: "c-abc", Name: "Golang", NewThread: 3},
+				},
+				TopThreads: []response.ThreadStats{
+					{
+						ID:              "t-abcdefg",
+						Title:           "Goroutines",
+						CreatorID:       "u-abcdef",
+						CreatorUsername: "erikrios",
+						CategoryID:      "c-abc",
+						CategoryName:    "Golang",
+						PublishedOn:     publishedAt.Format(time.RFC822),
+						NewComment:      21,
+						NewLike:         30,
+					},
+				},
+				MostActiveUsers: []response.UserStats{
+					{UserID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan", Role: "user", NewThread: 2, NewComment: 15},
+				},
+			},
+			mockBehaviours: func() {
+				mockAdminRepo.On(
+					"FindStatsSeries",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					expectedPeriod,
+				).Return(
+					func(ctx context.Context, period entity.StatsPeriod) []entity.StatsBucket {
+						return []entity.StatsBucket{
+							{Start: from, NewUser: 12, NewThread: 5, NewComment: 40, NewLike: 61, NewReport: 2},
+						}
+					},
+					func(ctx context.Context, period entity.StatsPeriod) error {
+						return nil
+					},
+				).Once()
+
+				mockAdminRepo.On(
+					"FindTopCategories",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					expectedPeriod,
+					uint(3),
+				).Return(
+					func(ctx context.Context, period entity.StatsPeriod, limit uint) []entity.CategoryStats {
+						return []entity.CategoryStats{
+							{Category: entity.Category{ID: "c-abc", Name: "Golang"}, NewThread: 3},
+						}
+					},
+					func(ctx context.Context, period entity.StatsPeriod, limit uint) error {
+						return nil
+					},
+				).Once()
+
+				mockAdminRepo.On(
+					"FindTopThreads",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					expectedPeriod,
+					uint(3),
+				).Return(
+					func(ctx context.Context, period entity.StatsPeriod, limit uint) []entity.ThreadStats {
+						return []entity.ThreadStats{
+							{
+								Thread: entity.Thread{
+									ID:        "t-abcdefg",
+									Title:     "Goroutines",
+									Creator:   entity.User{ID: "u-abcdef", Username: "erikrios"},
+									Category:  entity.Category{ID: "c-abc", Name: "Golang"},
+									CreatedAt: publishedAt,
+								},
+								NewComment: 21,
+								NewLike:    30,
+							},
+						}
+					},
+					func(ctx context.Context, period entity.StatsPeriod, limit uint) error {
+						return nil
+					},
+				).Once()
+
+				mockAdminRepo.On(
+					"FindMostActiveUsers",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					expectedPeriod,
+					uint(3),
+				).Return(
+					func(ctx context.Context, period entity.StatsPeriod, limit uint) []entity.UserStats {
+						return []entity.UserStats{
+							{
+								User:       entity.User{ID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan", Role: "user"},
+								NewThread:  2,
+								NewComment: 15,
+							},
+						}
+					},
+					func(ctx context.Context, period entity.StatsPeriod, limit uint) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotStats, gotError := adminService.GetStats(
+				context.Background(),
+				testCase.inputAccessorRole,
+				testCase.inputFilter,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedStats, gotStats)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	registeredAt := time.Date(2022, time.June, 27, 8, 30, 0, 0, time.UTC)
+
+	dummyUsers := []entity.User{
+		{
+			ID:          "u-abcdef",
+			Username:    "erikrios",
+			Email:       "erikriosetiawan15@gmail.com",
+			Name:        "Erik Rio Setiawan",
+			Role:        "user",
+			IsActive:    true,
+			IsVerified:  true,
+			Location:    "Malang, Indonesia",
+			TotalThread: 3,
+			CreatedAt:   registeredAt,
+		},
+		{
+			ID:        "u-ghijkl",
+			Username:  "sarifaturr",
+			Email:     "sarifaturr@gmail.com",
+			Name:      "Sarifatur, Rizki",
+			Role:      "admin",
+			IsActive:  true,
+			CreatedAt: registeredAt,
+		},
+	}
+
+	streamUsers := func(args mock.Arguments) {
+		fn := args.Get(2).(func(user entity.User) error)
+		for _, user := range dummyUsers {
+			if err := fn(user); err != nil {
+				return
+			}
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		inputResource     string
+		inputFilter       payload.ExportFilter
+		expectedError     error
+		expectedOutput    string
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, if accessorRole is not admin",
+			inputAccessorRole: "global_moderator",
+			inputResource:     "users",
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if resource is unknown",
+			inputAccessorRole: "admin",
+			inputResource:     "sessions",
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if format is unknown",
+			inputAccessorRole: "admin",
+			inputResource:     "users",
+			inputFilter:       payload.ExportFilter{Format: "xlsx"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, if createdAfter is not a valid date",
+			inputAccessorRole: "admin",
+			inputResource:     "users",
+			inputFilter:       payload.ExportFilter{CreatedAfter: "27/06/2022"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrRepository and write nothing, when admin repository return repository.ErrDatabase",
+			inputAccessorRole: "admin",
+			inputResource:     "users",
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockAdminRepo.On(
+					"StreamUsers",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.ExportFilter{},
+					mock.AnythingOfType(fmt.Sprintf("%T", func(user entity.User) error { return nil })),
+				).Return(repository.ErrDatabase).Once()
+			},
+		},
+		{
+			name:              "it should write the users as csv, when no error is returned",
+			inputAccessorRole: "admin",
+			inputResource:     "users",
+			inputFilter:       payload.ExportFilter{CreatedAfter: "2022-06-01", CreatedBefore: "2022-07-01"},
+			expectedError:     nil,
+			expectedOutput: "ID,username,email,name,role,isActive,isVerified,location,totalThread,registeredAt\n" +
+				"u-abcdef,erikrios,erikriosetiawan15@gmail.com,Erik Rio Setiawan,user,true,true,\"Malang, Indonesia\",3,2022-06-27T08:30:00Z\n" +
+				"u-ghijkl,sarifaturr,sarifaturr@gmail.com,\"Sarifatur, Rizki\",admin,true,false,,0,2022-06-27T08:30:00Z\n",
+			mockBehaviours: func() {
+				mockAdminRepo.On(
+					"StreamUsers",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.ExportFilter{
+						CreatedAfter:  time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC),
+						CreatedBefore: time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC),
+					},
+					mock.AnythingOfType(fmt.Sprintf("%T", func(user entity.User) error { return nil })),
+				).Run(streamUsers).Return(nil).Once()
+			},
+		},
+		{
+			name:              "it should write the header only, when there is no row",
+			inputAccessorRole: "admin",
+			inputResource:     "comments",
+			expectedError:     nil,
+			expectedOutput:    "ID,threadID,parentID,userID,username,comment,publishedAt,editedAt,deletedAt\n",
+			mockBehaviours: func() {
+				mockAdminRepo.On(
+					"StreamComments",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.ExportFilter{},
+					mock.AnythingOfType(fmt.Sprintf("%T", func(comment entity.Comment) error { return nil })),
+				).Return(nil).Once()
+			},
+		},
+		{
+			name:              "it should write the users as ndjson, when no error is returned",
+			inputAccessorRole: "admin",
+			inputResource:     "users",
+			inputFilter:       payload.ExportFilter{Format: "ndjson"},
+			expectedError:     nil,
+			expectedOutput: `{"ID":"u-abcdef","username":"erikrios","email":"erikriosetiawan15@gmail.com","name":"Erik Rio Setiawan","role":"user","isActive":true,"isVerified":true,"location":"Malang, Indonesia","totalThread":3,"registeredAt":"2022-06-27T08:30:00Z"}` + "\n" +
+				`{"ID":"u-ghijkl","username":"sarifaturr","email":"sarifaturr@gmail.com","name":"Sarifatur, Rizki","role":"admin","isActive":true,"isVerified":false,"location":"","totalThread":0,"registeredAt":"2022-06-27T08:30:00Z"}` + "\n",
+			mockBehaviours: func() {
+				mockAdminRepo.On(
+					"StreamUsers",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.ExportFilter{},
+					mock.AnythingOfType(fmt.Sprintf("%T", func(user entity.User) error { return nil })),
+				).Run(streamUsers).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			var buf bytes.Buffer
+
+			gotError := adminService.Export(
+				context.Background(),
+				testCase.inputAccessorRole,
+				testCase.inputResource,
+				testCase.inputFilter,
+				&buf,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.Empty(t, buf.String())
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+func TestCSVExportWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	writer := newExportWriter(CSVExportFormat, []string{"ID", "name", "location"}, &buf)
+
+	assert.NoError(t, writer.Write(nil, []string{"u-abcdef", "=HYPERLINK(\"http://evil.com\")", "+62 Malang"}))
+	assert.NoError(t, writer.Write(nil, []string{"u-ghijkl", "@SUM(A1)", "-1"}))
+	assert.NoError(t, writer.Write(nil, []string{"u-mnopqr", "\tErik", "\rMalang"}))
+	assert.NoError(t, writer.Write(nil, []string{"u-stuvwx", "Erik = Rio", ""}))
+	assert.NoError(t, writer.Flush())
+
+	assert.Equal(t, "ID,name,location\n"+
+		"u-abcdef,\"'=HYPERLINK(\"\"http://evil.com\"\")\",'+62 Malang\n"+
+		"u-ghijkl,'@SUM(A1),'-1\n"+
+		"u-mnopqr,'\tErik,\"'\rMalang\"\n"+
+		"u-stuvwx,Erik = Rio,\n", buf.String())
+}
+
+func TestGetAllLockout(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	lockedUntil := time.Now().Add(time.Minute * 4)
+	lastFailedAt := time.Now()
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		expectedError     error
+		expectedLockouts  []response.Lockout
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, if accessorRole is not admin",
+			inputAccessorRole: "user",
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrRepository, when login attempt repository return repository.ErrDatabase",
+			inputAccessorRole: "admin",
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockLoginAttemptRepo.On(
+					"FindAllLocked",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, now time.Time) []entity.LoginAttempt {
+						return nil
+					},
+					func(ctx context.Context, now time.Time) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return valid lockouts, when no error is returned",
+			inputAccessorRole: "admin",
+			expectedError:     nil,
+			expectedLockouts: []response.Lockout{
+				{
+					Kind:           "username",
+					Value:          "erikrios",
+					FailedAttempts: 7,
+					LastFailedOn:   lastFailedAt.Format(time.RFC822),
+					LockedUntil:    lockedUntil.Format(time.RFC822),
+				},
+				{
+					Kind:           "ip",
+					Value:          "10.0.0.1",
+					FailedAttempts: 20,
+					LastFailedOn:   lastFailedAt.Format(time.RFC822),
+					LockedUntil:    lockedUntil.Format(time.RFC822),
+				},
+			},
+			mockBehaviours: func() {
+				mockLoginAttemptRepo.On(
+					"FindAllLocked",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, now time.Time) []entity.LoginAttempt {
+						return []entity.LoginAttempt{
+							{
+								Kind:           entity.UsernameLoginAttempt,
+								Value:          "erikrios",
+								FailedAttempts: 7,
+								LastFailedAt:   lastFailedAt,
+								LockedUntil:    lockedUntil,
+							},
+							{
+								Kind:           entity.IPLoginAttempt,
+								Value:          "10.0.0.1",
+								FailedAttempts: 20,
+								LastFailedAt:   lastFailedAt,
+								LockedUntil:    lockedUntil,
+							},
+						}
+					},
+					func(ctx context.Context, now time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotLockouts, gotError := adminService.GetAllLockout(
+				context.Background(),
+				testCase.inputAccessorRole,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.Equal(t, testCase.expectedLockouts, gotLockouts)
+			}
+		})
+	}
+}
+
+func TestClearLockout(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		inputKind         string
+		inputValue        string
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, if accessorRole is not admin",
+			inputAccessorRole: "user",
+			inputKind:         "username",
+			inputValue:        "erikrios",
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, when the kind is unknown",
+			inputAccessorRole: "admin",
+			inputKind:         "email",
+			inputValue:        "erikriosetiawan15@gmail.com",
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrDataNotFound, when there is no lockout",
+			inputAccessorRole: "admin",
+			inputKind:         "ip",
+			inputValue:        "10.0.0.1",
+			expectedError:     service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockLoginAttemptRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.IPLoginAttempt,
+					"10.0.0.1",
+				).Return(
+					func(ctx context.Context, kind entity.LoginAttemptKind, value string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil, when the lockout is cleared",
+			inputAccessorRole: "admin",
+			inputKind:         "username",
+			inputValue:        "erikrios",
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockLoginAttemptRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.UsernameLoginAttempt,
+					"erikrios",
+				).Return(
+					func(ctx context.Context, kind entity.LoginAttemptKind, value string) error {
+						return nil
+					},
+				).Once()
+
+				mockAuditService.On(
+					"Record",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					audit.Entry{
+						ActorID:    "u-aBcDeF",
+						Action:     audit.LockoutCleared,
+						TargetType: audit.LockoutTarget,
+						TargetID:   "erikrios",
+						Before:     map[string]any{"kind": "username"},
+					},
+				).Return(nil).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository, when the clearing can't be audited",
+			inputAccessorRole: "admin",
+			inputKind:         "ip",
+			inputValue:        "10.0.0.2",
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockLoginAttemptRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.IPLoginAttempt,
+					"10.0.0.2",
+				).Return(
+					func(ctx context.Context, kind entity.LoginAttemptKind, value string) error {
+						return nil
+					},
+				).Once()
+
+				mockAuditService.On(
+					"Record",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", audit.Entry{})),
+				).Return(repository.ErrDatabase).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotError := adminService.ClearLockout(
+				context.Background(),
+				"u-aBcDeF",
+				testCase.inputAccessorRole,
+				testCase.inputKind,
+				testCase.inputValue,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestGetAllRole(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	t.Run("it should return service.ErrAccessForbidden, if accessorRole can't manage the roles", func(t *testing.T) {
+		_, gotError := adminService.GetAllRole(context.Background(), "global_moderator")
+		assert.ErrorIs(t, gotError, service.ErrAccessForbidden)
+	})
+
+	t.Run("it should return the roles with their permissions, when accessorRole is admin", func(t *testing.T) {
+		gotRoles, gotError := adminService.GetAllRole(context.Background(), "admin")
+		if assert.NoError(t, gotError) {
+			assert.Len(t, gotRoles, len(service.Roles))
+
+			for _, gotRole := range gotRoles {
+				switch gotRole.Name {
+				case "admin":
+					assert.Contains(t, gotRole.Permissions, "roles:manage")
+				case "global_moderator":
+					assert.Contains(t, gotRole.Permissions, "users:ban")
+					assert.NotContains(t, gotRole.Permissions, "roles:manage")
+				case "user":
+					assert.Empty(t, gotRole.Permissions)
+				}
+			}
+		}
+	})
+}
+
+func TestGrantRole(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	testCases := []struct {
+		name                string
+		inputAccessorUserID string
+		inputAccessorRole   string
+		inputUsername       string
+		inputPayload        payload.GrantRole
+		expectedError       error
+		mockBehaviours      func()
+	}{
+		{
+			name:                "it should return service.ErrAccessForbidden, if accessorRole can't manage the roles",
+			inputAccessorUserID: "u-abcdefg",
+			inputAccessorRole:   "global_moderator",
+			inputUsername:       "erikrios",
+			inputPayload:        payload.GrantRole{Role: "admin"},
+			expectedError:       service.ErrAccessForbidden,
+			mockBehaviours:      func() {},
+		},
+		{
+			name:                "it should return service.ErrInvalidPayload, when the role is unknown",
+			inputAccessorUserID: "a-abcd",
+			inputAccessorRole:   "admin",
+			inputUsername:       "erikrios",
+			inputPayload:        payload.GrantRole{Role: "superuser"},
+			expectedError:       service.ErrInvalidPayload,
+			mockBehaviours:      func() {},
+		},
+		{
+			name:                "it should return service.ErrUsernameNotFound, when the user doesn't exist",
+			inputAccessorUserID: "a-abcd",
+			inputAccessorRole:   "admin",
+			inputUsername:       "unknown",
+			inputPayload:        payload.GrantRole{Role: "global_moderator"},
+			expectedError:       service.ErrUsernameNotFound,
+			mockBehaviours: func() {
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"unknown",
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, username string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when the admin changes their own role",
+			inputAccessorUserID: "a-abcd",
+			inputAccessorRole:   "admin",
+			inputUsername:       "sarifaturr",
+			inputPayload:        payload.GrantRole{Role: "user"},
+			expectedError:       service.ErrAccessForbidden,
+			mockBehaviours: func() {
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"sarifaturr",
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "a-abcd", Username: "sarifaturr", Role: "admin"}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil, when the role is granted",
+			inputAccessorUserID: "a-abcd",
+			inputAccessorRole:   "admin",
+			inputUsername:       "erikrios",
+			inputPayload:        payload.GrantRole{Role: "global_moderator"},
+			expectedError:       nil,
+			mockBehaviours: func() {
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"erikrios",
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-abcdefg", Username: "erikrios", Role: "user"}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"UpdateRole",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdefg",
+					"global_moderator",
+				).Return(
+					func(ctx context.Context, ID string, role string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotError := adminService.GrantRole(
+				context.Background(),
+				testCase.inputAccessorUserID,
+				testCase.inputAccessorRole,
+				testCase.inputUsername,
+				testCase.inputPayload,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestRevokeRole(t *testing.T) {
+	mockAdminRepo := &mocks.AdminRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockLoginAttemptRepo := &mlr.LoginAttemptRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var adminService AdminService = NewAdminServiceImpl(mockAdminRepo, mockUserRepo, mockLoginAttemptRepo, mockAuditService, mrp.NewInlineTxManager())
+
+	testCases := []struct {
+		name                string
+		inputAccessorUserID string
+		inputAccessorRole   string
+		inputUsername       string
+		expectedError       error
+		mockBehaviours      func()
+	}{
+		{
+			name:                "it should return service.ErrAccessForbidden, if accessorRole can't manage the roles",
+			inputAccessorUserID: "u-abcdefg",
+			inputAccessorRole:   "user",
+			inputUsername:       "erikrios",
+			expectedError:       service.ErrAccessForbidden,
+			mockBehaviours:      func() {},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the user is deleted while revoking",
+			inputAccessorUserID: "a-abcd",
+			inputAccessorRole:   "admin",
+			inputUsername:       "erikrios",
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"erikrios",
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-abcdefg", Username: "erikrios", Role: "global_moderator"}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"UpdateRole",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdefg",
+					"user",
+				).Return(
+					func(ctx context.Context, ID string, role string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil without updating, when the user has no role to revoke",
+			inputAccessorUserID: "a-abcd",
+			inputAccessorRole:   "admin",
+			inputUsername:       "erikrios",
+			expectedError:       nil,
+			mockBehaviours: func() {
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"erikrios",
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-abcdefg", Username: "erikrios", Role: "user"}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotError := adminService.RevokeRole(
+				context.Background(),
+				testCase.inputAccessorUserID,
+				testCase.inputAccessorRole,
+				testCase.inputUsername,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+const (
+	CSVExportFormat    = "csv"
+	NDJSONExportFormat = "ndjson"
+)
+
+const (
+	UsersExport    = "users"
+	ThreadsExport  = "threads"
+	CommentsExport = "comments"
+	ReportsExport  = "reports"
+)
+
+var exportHeaders = map[string][]string{
+	UsersExport: {
+		"ID", "username", "email", "name", "role", "isActive", "isVerified", "location", "totalThread", "registeredAt",
+	},
+	ThreadsExport: {
+		"ID", "title", "creatorID", "creatorUsername", "categoryID", "categoryName", "totalViewer", "totalLike",
+		"totalComment", "lockedAt", "publishedAt", "updatedAt",
+	},
+	CommentsExport: {
+		"ID", "threadID", "parentID", "userID", "username", "comment", "publishedAt", "editedAt", "deletedAt",
+	},
+	ReportsExport: {
+		"ID", "moderatorID", "moderatorUsername", "userID", "username", "threadID", "threadTitle", "commentID",
+		"comment", "reason", "status", "reportedAt", "updatedAt",
+	},
+}
+
+// exportWriter writes the rows of an export one at a time, the CSV writer uses the record while the NDJSON writer
+// encodes the value.
+type exportWriter interface {
+	Write(value any, record []string) error
+	// Flush writes the buffered rows, it is only called once every row is written.
+	Flush() error
+}
+
+func newExportWriter(format string, header []string, w io.Writer) exportWriter {
+	if format == NDJSONExportFormat {
+		return &ndjsonExportWriter{encoder: json.NewEncoder(w)}
+	}
+	return &csvExportWriter{writer: csv.NewWriter(w), header: header}
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+	header []string
+	// wroteHeader delays the header until the first row, so nothing is written if the query fails.
+	wroteHeader bool
+}
+
+func (c *csvExportWriter) Write(_ any, record []string) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	escaped := make([]string, len(record))
+	for i, cell := range record {
+		escaped[i] = escapeCSVCell(cell)
+	}
+	return c.writer.Write(escaped)
+}
+
+func (c *csvExportWriter) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (c *csvExportWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+	return c.writer.Write(c.header)
+}
+
+// escapeCSVCell prefixes the cells that a spreadsheet would run as a formula with a quote, so they are shown as text.
+func escapeCSVCell(cell string) string {
+	if cell == "" {
+		return cell
+	}
+
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + cell
+	default:
+		return cell
+	}
+}
+
+type ndjsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (n *ndjsonExportWriter) Write(value any, _ []string) error {
+	return n.encoder.Encode(value)
+}
+
+func (n *ndjsonExportWriter) Flush() error {
+	return nil
+}
+
+func newUserExport(user entity.User) (r response.UserExport, record []string) {
+	r = response.UserExport{
+		ID:           user.ID,
+		Username:     user.Username,
+		Email:        user.Email,
+		Name:         user.Name,
+		Role:         user.Role,
+		IsActive:     user.IsActive,
+		IsVerified:   user.IsVerified,
+		Location:     user.Location,
+		TotalThread:  uint(user.TotalThread),
+		RegisteredAt: formatExportTime(user.CreatedAt),
+	}
+
+	record = []string{
+		r.ID,
+		r.Username,
+		r.Email,
+		r.Name,
+		r.Role,
+		strconv.FormatBool(r.IsActive),
+		strconv.FormatBool(r.IsVerified),
+		r.Location,
+		strconv.FormatUint(uint64(r.TotalThread), 10),
+		r.RegisteredAt,
+	}
+
+	return
+}
+
+func newThreadExport(thread entity.Thread) (r response.ThreadExport, record []string) {
+	r = response.ThreadExport{
+		ID:              thread.ID,
+		Title:           thread.Title,
+		CreatorID:       thread.Creator.ID,
+		CreatorUsername: thread.Creator.Username,
+		CategoryID:      thread.Category.ID,
+		CategoryName:    thread.Category.Name,
+		TotalViewer:     uint(thread.TotalViewer),
+		TotalLike:       uint(thread.TotalLike),
+		TotalComment:    uint(thread.TotalComment),
+		LockedAt:        formatExportTime(thread.LockedAt),
+		PublishedAt:     formatExportTime(thread.CreatedAt),
+		UpdatedAt:       formatExportTime(thread.UpdatedAt),
+	}
+
+	record = []string{
+		r.ID,
+		r.Title,
+		r.CreatorID,
+		r.CreatorUsername,
+		r.CategoryID,
+		r.CategoryName,
+		strconv.FormatUint(uint64(r.TotalViewer), 10),
+		strconv.FormatUint(uint64(r.TotalLike), 10),
+		strconv.FormatUint(uint64(r.TotalComment), 10),
+		r.LockedAt,
+		r.PublishedAt,
+		r.UpdatedAt,
+	}
+
+	return
+}
+
+func newCommentExport(comment entity.Comment) (r response.CommentExport, record []string) {
+	r = response.CommentExport{
+		ID:          comment.ID,
+		ThreadID:    comment.Thread.ID,
+		ParentID:    comment.ParentID,
+		UserID:      comment.User.ID,
+		Username:    comment.User.Username,
+		Comment:     comment.Comment,
+		PublishedAt: formatExportTime(comment.CreatedAt),
+		EditedAt:    formatExportTime(comment.EditedAt),
+		DeletedAt:   formatExportTime(comment.DeletedAt),
+	}
+
+	record = []string{
+		r.ID,
+		r.ThreadID,
+		r.ParentID,
+		r.UserID,
+		r.Username,
+		r.Comment,
+		r.PublishedAt,
+		r.EditedAt,
+		r.DeletedAt,
+	}
+
+	return
+}
+
+func newReportExport(report entity.UserBanned) (r response.ReportExport, record []string) {
+	r = response.ReportExport{
+		ID:                report.ID,
+		ModeratorID:       report.Moderator.ID,
+		ModeratorUsername: report.Moderator.User.Username,
+		UserID:            report.User.ID,
+		Username:          report.User.Username,
+		ThreadID:          report.Thread.ID,
+		ThreadTitle:       report.Thread.Title,
+		CommentID:         report.Comment.ID,
+		Comment:           report.Comment.Comment,
+		Reason:            report.Reason,
+		Status:            report.Status,
+		ReportedAt:        formatExportTime(report.CreatedAt),
+		UpdatedAt:         formatExportTime(report.UpdatedAt),
+	}
+
+	record = []string{
+		r.ID,
+		r.ModeratorID,
+		r.ModeratorUsername,
+		r.UserID,
+		r.Username,
+		r.ThreadID,
+		r.ThreadTitle,
+		r.CommentID,
+		r.Comment,
+		r.Reason,
+		r.Status,
+		r.ReportedAt,
+		r.UpdatedAt,
+	}
+
+	return
+}
+
+// formatExportTime keeps an unset time empty instead of writing the zero time.
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -4,9 +4,13 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 
-	response "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	mock "github.com/stretchr/testify/mock"
+
+	payload "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+
+	response "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 )
 
 // AdminService is an autogenerated mock type for the AdminService type
@@ -14,6 +18,80 @@ type AdminService struct {
 	mock.Mock
 }
 
+// ClearLockout provides a mock function with given fields: ctx, accessorUserID, accessorRole, kind, value
+func (_m *AdminService) ClearLockout(ctx context.Context, accessorUserID string, accessorRole string, kind string, value string) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, kind, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, kind, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Export provides a mock function with given fields: ctx, accessorRole, resource, f, w
+func (_m *AdminService) Export(ctx context.Context, accessorRole string, resource string, f payload.ExportFilter, w io.Writer) error {
+	ret := _m.Called(ctx, accessorRole, resource, f, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.ExportFilter, io.Writer) error); ok {
+		r0 = rf(ctx, accessorRole, resource, f, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllLockout provides a mock function with given fields: ctx, accessorRole
+func (_m *AdminService) GetAllLockout(ctx context.Context, accessorRole string) ([]response.Lockout, error) {
+	ret := _m.Called(ctx, accessorRole)
+
+	var r0 []response.Lockout
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.Lockout); ok {
+		r0 = rf(ctx, accessorRole)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Lockout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessorRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllRole provides a mock function with given fields: ctx, accessorRole
+func (_m *AdminService) GetAllRole(ctx context.Context, accessorRole string) ([]response.Role, error) {
+	ret := _m.Called(ctx, accessorRole)
+
+	var r0 []response.Role
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.Role); ok {
+		r0 = rf(ctx, accessorRole)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessorRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDashboardInfo provides a mock function with given fields: ctx, accessorRole
 func (_m *AdminService) GetDashboardInfo(ctx context.Context, accessorRole string) (response.DashboardInfo, error) {
 	ret := _m.Called(ctx, accessorRole)
@@ -35,6 +113,55 @@ func (_m *AdminService) GetDashboardInfo(ctx context.Context, accessorRole strin
 	return r0, r1
 }
 
+// GetStats provides a mock function with given fields: ctx, accessorRole, f
+func (_m *AdminService) GetStats(ctx context.Context, accessorRole string, f payload.StatsFilter) (response.Stats, error) {
+	ret := _m.Called(ctx, accessorRole, f)
+
+	var r0 response.Stats
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.StatsFilter) response.Stats); ok {
+		r0 = rf(ctx, accessorRole, f)
+	} else {
+		r0 = ret.Get(0).(response.Stats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, payload.StatsFilter) error); ok {
+		r1 = rf(ctx, accessorRole, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GrantRole provides a mock function with given fields: ctx, accessorUserID, accessorRole, username, p
+func (_m *AdminService) GrantRole(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.GrantRole) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, username, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.GrantRole) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, username, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeRole provides a mock function with given fields: ctx, accessorUserID, accessorRole, username
+func (_m *AdminService) RevokeRole(ctx context.Context, accessorUserID string, accessorRole string, username string) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, username)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewAdminService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+type AuditService interface {
+	// Record appends the entry to the audit log. It's meant to be called within the transaction of the audited
+	// action, so the entry is only kept along with the action and a failure, which is returned, rolls both back.
+	Record(ctx context.Context, entry Entry) (err error)
+
+	GetAll(
+		ctx context.Context,
+		accessorRole string,
+		page uint,
+		limit uint,
+		f payload.AuditFilter,
+	) (rs response.Pagination[response.AuditLog], err error)
+}
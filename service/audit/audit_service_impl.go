@@ -0,0 +1,141 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/audit"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/requestctx"
+)
+
+const auditFilterDateLayout = "2006-01-02"
+
+type auditServiceImpl struct {
+	auditRepository audit.AuditRepository
+	idGenerator     generator.IDGenerator
+}
+
+func NewAuditServiceImpl(
+	auditRepository audit.AuditRepository,
+	idGenerator generator.IDGenerator,
+) *auditServiceImpl {
+	return &auditServiceImpl{
+		auditRepository: auditRepository,
+		idGenerator:     idGenerator,
+	}
+}
+
+func (a *auditServiceImpl) Record(ctx context.Context, entry Entry) (err error) {
+	id, err := a.idGenerator.GenerateAuditLogID()
+	if err != nil {
+		return
+	}
+
+	auditLog := entity.AuditLog{
+		ID:         id,
+		Actor:      entity.User{ID: entry.ActorID},
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		IPAddress:  requestctx.IPAddressFrom(ctx),
+	}
+
+	if auditLog.Before, err = marshalState(entry.Before); err != nil {
+		return
+	}
+
+	if auditLog.After, err = marshalState(entry.After); err != nil {
+		return
+	}
+
+	err = a.auditRepository.Insert(ctx, auditLog)
+	return
+}
+
+func (a *auditServiceImpl) GetAll(
+	ctx context.Context,
+	accessorRole string,
+	page uint,
+	limit uint,
+	f payload.AuditFilter,
+) (rs response.Pagination[response.AuditLog], err error) {
+	if err = service.Authorize(accessorRole, service.PermissionViewAuditLog); err != nil {
+		return
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filter := entity.AuditFilter{
+		ActorUsername: f.ActorUsername,
+		Action:        f.Action,
+		TargetType:    f.TargetType,
+		TargetID:      f.TargetID,
+	}
+
+	if f.CreatedAfter != "" {
+		if filter.CreatedAfter, err = time.Parse(auditFilterDateLayout, f.CreatedAfter); err != nil {
+			err = service.ErrInvalidPayload
+			return
+		}
+	}
+
+	if f.CreatedBefore != "" {
+		if filter.CreatedBefore, err = time.Parse(auditFilterDateLayout, f.CreatedBefore); err != nil {
+			err = service.ErrInvalidPayload
+			return
+		}
+	}
+
+	pagination, repoErr := a.auditRepository.FindAllWithFilterAndPagination(
+		ctx,
+		filter,
+		entity.PageInfo{Page: page, Limit: limit},
+	)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs.PageInfo.Page = pagination.PageInfo.Page
+	rs.PageInfo.Limit = pagination.PageInfo.Limit
+	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
+	rs.PageInfo.Total = pagination.PageInfo.Total
+	rs.List = make([]response.AuditLog, len(pagination.List))
+
+	for i, item := range pagination.List {
+		rs.List[i] = response.AuditLog{
+			ID:            item.ID,
+			ActorID:       item.Actor.ID,
+			ActorUsername: item.Actor.Username,
+			Action:        item.Action,
+			TargetType:    item.TargetType,
+			TargetID:      item.TargetID,
+			Before:        item.Before,
+			After:         item.After,
+			IPAddress:     item.IPAddress,
+			CreatedOn:     item.CreatedAt.Format(time.RFC822),
+		}
+	}
+
+	return
+}
+
+func marshalState(state any) (data []byte, err error) {
+	if state == nil {
+		return
+	}
+	data, err = json.Marshal(state)
+	return
+}
@@ -0,0 +1,217 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	mar "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/audit/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mig "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/requestctx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecord(t *testing.T) {
+	mockAuditRepo := &mar.AuditRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var auditService AuditService = NewAuditServiceImpl(mockAuditRepo, mockIDGen)
+
+	t.Run("it should insert the entry with the IP address of the request, when there is no error", func(t *testing.T) {
+		mockIDGen.On("GenerateAuditLogID").Return(
+			func() string {
+				return "a-aBcDeFg"
+			},
+			func() error {
+				return nil
+			},
+		).Once()
+
+		mockAuditRepo.On(
+			"Insert",
+			mock.Anything,
+			entity.AuditLog{
+				ID:         "a-aBcDeFg",
+				Actor:      entity.User{ID: "u-aBcDeF"},
+				Action:     RoleGranted,
+				TargetType: UserTarget,
+				TargetID:   "u-gHiJkL",
+				Before:     []byte(`{"role":"user"}`),
+				After:      []byte(`{"role":"admin"}`),
+				IPAddress:  "10.0.0.1",
+			},
+		).Return(
+			func(ctx context.Context, auditLog entity.AuditLog) error {
+				return nil
+			},
+		).Once()
+
+		ctx := requestctx.WithIPAddress(context.Background(), "10.0.0.1")
+
+		gotError := auditService.Record(ctx, Entry{
+			ActorID:    "u-aBcDeF",
+			Action:     RoleGranted,
+			TargetType: UserTarget,
+			TargetID:   "u-gHiJkL",
+			Before:     map[string]any{"role": "user"},
+			After:      map[string]any{"role": "admin"},
+		})
+
+		assert.NoError(t, gotError)
+		mockAuditRepo.AssertExpectations(t)
+	})
+
+	t.Run("it should return the error without inserting anything, when Audit Log ID Generator return an error", func(t *testing.T) {
+		mockIDGen.On("GenerateAuditLogID").Return(
+			func() string {
+				return ""
+			},
+			func() error {
+				return errors.New("failed to generate audit log id")
+			},
+		).Once()
+
+		gotError := auditService.Record(context.Background(), Entry{Action: CategoryDeleted, TargetType: CategoryTarget, TargetID: "c-abc"})
+
+		assert.Error(t, gotError)
+		mockAuditRepo.AssertNumberOfCalls(t, "Insert", 1)
+	})
+
+	t.Run("it should return repository.ErrDatabase, when Audit Repository return an error", func(t *testing.T) {
+		mockIDGen.On("GenerateAuditLogID").Return(
+			func() string {
+				return "a-hIjKlMn"
+			},
+			func() error {
+				return nil
+			},
+		).Once()
+
+		mockAuditRepo.On(
+			"Insert",
+			mock.Anything,
+			mock.AnythingOfType(fmt.Sprintf("%T", entity.AuditLog{})),
+		).Return(
+			func(ctx context.Context, auditLog entity.AuditLog) error {
+				return repository.ErrDatabase
+			},
+		).Once()
+
+		gotError := auditService.Record(context.Background(), Entry{Action: CategoryDeleted, TargetType: CategoryTarget, TargetID: "c-abc"})
+
+		assert.ErrorIs(t, gotError, repository.ErrDatabase)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	mockAuditRepo := &mar.AuditRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var auditService AuditService = NewAuditServiceImpl(mockAuditRepo, mockIDGen)
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		inputFilter       payload.AuditFilter
+		expectedError     error
+		mockBehaviour     func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, when role is not admin",
+			inputAccessorRole: "global_moderator",
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviour:     func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, when the date is invalid",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.AuditFilter{CreatedAfter: "01-07-2022"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviour:     func() {},
+		},
+		{
+			name:              "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputAccessorRole: "admin",
+			expectedError:     service.ErrRepository,
+			mockBehaviour: func() {
+				mockAuditRepo.On(
+					"FindAllWithFilterAndPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.AuditFilter{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(ctx context.Context, filter entity.AuditFilter, pageInfo entity.PageInfo) entity.Pagination[entity.AuditLog] {
+						return entity.Pagination[entity.AuditLog]{}
+					},
+					func(ctx context.Context, filter entity.AuditFilter, pageInfo entity.PageInfo) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return the audit logs, when no error is returned",
+			inputAccessorRole: "admin",
+			inputFilter:       payload.AuditFilter{ActorUsername: "erikrios", CreatedAfter: "2022-07-01"},
+			expectedError:     nil,
+			mockBehaviour: func() {
+				mockAuditRepo.On(
+					"FindAllWithFilterAndPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.AuditFilter{
+						ActorUsername: "erikrios",
+						CreatedAfter:  time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC),
+					},
+					entity.PageInfo{Page: 1, Limit: 10},
+				).Return(
+					func(ctx context.Context, filter entity.AuditFilter, pageInfo entity.PageInfo) entity.Pagination[entity.AuditLog] {
+						return entity.Pagination[entity.AuditLog]{
+							List: []entity.AuditLog{
+								{
+									ID:         "a-aBcDeFg",
+									Actor:      entity.User{ID: "u-aBcDeF", Username: "erikrios"},
+									Action:     UserBanned,
+									TargetType: UserTarget,
+									TargetID:   "u-gHiJkL",
+									After:      []byte(`{"reason":"spam"}`),
+									IPAddress:  "10.0.0.1",
+									CreatedAt:  time.Now(),
+								},
+							},
+							PageInfo: entity.PageInfo{Limit: 10, Page: 1, PageTotal: 1, Total: 1},
+						}
+					},
+					func(ctx context.Context, filter entity.AuditFilter, pageInfo entity.PageInfo) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			rs, err := auditService.GetAll(context.Background(), testCase.inputAccessorRole, 0, 0, testCase.inputFilter)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+				if assert.Len(t, rs.List, 1) {
+					assert.Equal(t, "erikrios", rs.List[0].ActorUsername)
+					assert.Nil(t, rs.List[0].Before)
+					assert.JSONEq(t, `{"reason":"spam"}`, string(rs.List[0].After))
+				}
+			}
+		})
+	}
+}
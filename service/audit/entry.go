@@ -0,0 +1,36 @@
+package audit
+
+const (
+	ThreadDeleted       = "thread.delete"
+	CommentDeleted      = "comment.delete"
+	ModeratorAdded      = "thread.moderator.add"
+	ModeratorRemoved    = "thread.moderator.remove"
+	CategoryCreated     = "category.create"
+	CategoryUpdated     = "category.update"
+	CategoryDeleted     = "category.delete"
+	UserBanned          = "user.ban"
+	UserUnbanned        = "user.unban"
+	RoleGranted         = "user.role.grant"
+	RoleRevoked         = "user.role.revoke"
+	ReportStatusUpdated = "report.status.update"
+	LockoutCleared      = "lockout.clear"
+)
+
+const (
+	ThreadTarget   = "thread"
+	CommentTarget  = "comment"
+	CategoryTarget = "category"
+	UserTarget     = "user"
+	ReportTarget   = "report"
+	LockoutTarget  = "lockout"
+)
+
+// Entry is an audited action, Before and After are encoded as JSON and nil is stored as null.
+type Entry struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     any
+	After      any
+}
@@ -0,0 +1,70 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
+
+	mock "github.com/stretchr/testify/mock"
+
+	payload "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+
+	response "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+// AuditService is an autogenerated mock type for the AuditService type
+type AuditService struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: ctx, accessorRole, page, limit, f
+func (_m *AuditService) GetAll(ctx context.Context, accessorRole string, page uint, limit uint, f payload.AuditFilter) (response.Pagination[response.AuditLog], error) {
+	ret := _m.Called(ctx, accessorRole, page, limit, f)
+
+	var r0 response.Pagination[response.AuditLog]
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint, uint, payload.AuditFilter) response.Pagination[response.AuditLog]); ok {
+		r0 = rf(ctx, accessorRole, page, limit, f)
+	} else {
+		r0 = ret.Get(0).(response.Pagination[response.AuditLog])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint, uint, payload.AuditFilter) error); ok {
+		r1 = rf(ctx, accessorRole, page, limit, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Record provides a mock function with given fields: ctx, entry
+func (_m *AuditService) Record(ctx context.Context, entry audit.Entry) error {
+	ret := _m.Called(ctx, entry)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Entry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAuditService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditService creates a new instance of AuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditService(t mockConstructorTestingTNewAuditService) *AuditService {
+	mock := &AuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
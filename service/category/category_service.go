@@ -9,14 +9,17 @@ import (
 
 type CategoryService interface {
 	GetAll(ctx context.Context) (rs []response.Category, err error)
-	Create(ctx context.Context, accessorRole string, p payload.CreateCategory) (id string, err error)
-	Update(ctx context.Context, accessorRole string, id string, p payload.UpdateCategory) (err error)
-	Delete(ctx context.Context, accessorRole string, id string) (err error)
+	Create(ctx context.Context, accessorUserID, accessorRole string, p payload.CreateCategory) (id string, err error)
+	Update(ctx context.Context, accessorUserID, accessorRole string, id string, p payload.UpdateCategory) (err error)
+	Delete(ctx context.Context, accessorUserID, accessorRole string, id string) (err error)
 	GetAllByCategory(
 		ctx context.Context,
 		accessorID string,
 		categoryID string,
 		page uint,
 		limit uint,
+		cursor string,
+		sort string,
+		f payload.ThreadFilter,
 	) (rs response.Pagination[response.ManyThread], err error)
 }
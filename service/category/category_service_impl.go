@@ -7,9 +7,11 @@ import (
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/category"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
 	"gopkg.in/validator.v2"
 )
@@ -18,17 +20,23 @@ type categoryServiceImpl struct {
 	categoryRepository category.CategoryRepository
 	threadRepository   thread.ThreadRepository
 	idGenerator        generator.IDGenerator
+	auditService       audit.AuditService
+	txManager          repository.TxManager
 }
 
 func NewCategoryServiceImpl(
 	categoryRepository category.CategoryRepository,
 	threadRepository thread.ThreadRepository,
 	idGenerator generator.IDGenerator,
+	auditService audit.AuditService,
+	txManager repository.TxManager,
 ) *categoryServiceImpl {
 	return &categoryServiceImpl{
 		categoryRepository: categoryRepository,
 		threadRepository:   threadRepository,
 		idGenerator:        idGenerator,
+		auditService:       auditService,
+		txManager:          txManager,
 	}
 }
 
@@ -53,9 +61,8 @@ func (c *categoryServiceImpl) GetAll(ctx context.Context) (rs []response.Categor
 	return
 }
 
-func (c *categoryServiceImpl) Create(ctx context.Context, accessorRole string, p payload.CreateCategory) (id string, err error) {
-	if accessorRole != "admin" {
-		err = service.ErrAccessForbidden
+func (c *categoryServiceImpl) Create(ctx context.Context, accessorUserID, accessorRole string, p payload.CreateCategory) (id string, err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageCategories); err != nil {
 		return
 	}
 
@@ -76,17 +83,28 @@ func (c *categoryServiceImpl) Create(ctx context.Context, accessorRole string, p
 		Description: p.Description,
 	}
 
-	if repoErr := c.categoryRepository.Insert(ctx, category); repoErr != nil {
-		err = service.MapError(repoErr)
+	if txErr := c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := c.categoryRepository.Insert(ctx, category); repoErr != nil {
+			return repoErr
+		}
+
+		return c.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.CategoryCreated,
+			TargetType: audit.CategoryTarget,
+			TargetID:   id,
+			After:      auditCategory(category),
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
 		return
 	}
 
 	return
 }
 
-func (c *categoryServiceImpl) Update(ctx context.Context, accessorRole string, id string, p payload.UpdateCategory) (err error) {
-	if accessorRole != "admin" {
-		err = service.ErrAccessForbidden
+func (c *categoryServiceImpl) Update(ctx context.Context, accessorUserID, accessorRole string, id string, p payload.UpdateCategory) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageCategories); err != nil {
 		return
 	}
 
@@ -95,30 +113,66 @@ func (c *categoryServiceImpl) Update(ctx context.Context, accessorRole string, i
 		return
 	}
 
+	before, repoErr := c.categoryRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
 	category := entity.Category{
 		Name:        p.Name,
 		Description: p.Description,
 	}
 
-	if repoErr := c.categoryRepository.Update(ctx, id, category); repoErr != nil {
-		err = service.MapError(repoErr)
+	if txErr := c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := c.categoryRepository.Update(ctx, id, category); repoErr != nil {
+			return repoErr
+		}
+
+		return c.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.CategoryUpdated,
+			TargetType: audit.CategoryTarget,
+			TargetID:   id,
+			Before:     auditCategory(before),
+			After:      auditCategory(category),
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
 		return
 	}
 
 	return
 }
 
-func (c *categoryServiceImpl) Delete(ctx context.Context, accessorRole string, id string) (err error) {
-	if accessorRole != "admin" {
-		err = service.ErrAccessForbidden
+func (c *categoryServiceImpl) Delete(ctx context.Context, accessorUserID, accessorRole string, id string) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageCategories); err != nil {
 		return
 	}
 
-	if repoErr := c.categoryRepository.Delete(ctx, id); repoErr != nil {
+	before, repoErr := c.categoryRepository.FindByID(ctx, id)
+	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
+	if txErr := c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := c.categoryRepository.Delete(ctx, id); repoErr != nil {
+			return repoErr
+		}
+
+		return c.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.CategoryDeleted,
+			TargetType: audit.CategoryTarget,
+			TargetID:   id,
+			Before:     auditCategory(before),
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
 	return
 }
 
@@ -128,6 +182,9 @@ func (c *categoryServiceImpl) GetAllByCategory(
 	categoryID string,
 	page uint,
 	limit uint,
+	cursor string,
+	sort string,
+	f payload.ThreadFilter,
 ) (rs response.Pagination[response.ManyThread], err error) {
 	if page <= 0 {
 		page = 1
@@ -137,6 +194,24 @@ func (c *categoryServiceImpl) GetAllByCategory(
 		limit = 10
 	}
 
+	filter, err := service.MapThreadFilter(f)
+	if err != nil {
+		return
+	}
+
+	threadSort := service.MapThreadSort(sort, "")
+
+	threadCursor, err := service.DecodeCursor(cursor)
+	if err != nil {
+		return
+	}
+
+	// The trending score can't be resumed from a position.
+	if threadCursor != nil && threadSort == entity.TrendingSort {
+		err = service.ErrInvalidPayload
+		return
+	}
+
 	if _, repoErr := c.categoryRepository.FindByID(ctx, categoryID); repoErr != nil {
 		err = service.MapError(repoErr)
 		return
@@ -146,9 +221,12 @@ func (c *categoryServiceImpl) GetAllByCategory(
 		ctx,
 		accessorID,
 		categoryID,
+		threadSort,
+		filter,
 		entity.PageInfo{
-			Limit: limit,
-			Page:  page,
+			Limit:  limit,
+			Page:   page,
+			Cursor: threadCursor,
 		},
 	)
 
@@ -161,6 +239,8 @@ func (c *categoryServiceImpl) GetAllByCategory(
 	rs.PageInfo.Limit = pagination.PageInfo.Limit
 	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
 	rs.PageInfo.Total = pagination.PageInfo.Total
+	rs.NextCursor = service.EncodeCursor(pagination.NextCursor)
+	rs.PrevCursor = service.EncodeCursor(pagination.PrevCursor)
 
 	rs.List = make([]response.ManyThread, len(pagination.List))
 
@@ -187,3 +267,11 @@ func (c *categoryServiceImpl) GetAllByCategory(
 
 	return
 }
+
+// auditCategory is the state of the category recorded in the audit log.
+func auditCategory(category entity.Category) map[string]any {
+	return map[string]any{
+		"name":        category.Name,
+		"description": category.Description,
+	}
+}
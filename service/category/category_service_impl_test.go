@@ -12,8 +12,11 @@ import (
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	mcr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/category/mocks"
+	mrp "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/mocks"
 	mtr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
+	mas "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit/mocks"
 	mig "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,7 +27,10 @@ func TestGetAll(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockIDGen := &mig.IDGenerator{}
 
-	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 	now := time.Now()
 
 	testCases := []struct {
@@ -139,7 +145,10 @@ func TestCreate(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockIDGen := &mig.IDGenerator{}
 
-	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 
 	testCases := []struct {
 		name              string
@@ -263,7 +272,7 @@ func TestCreate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			id, err := categoryService.Create(context.Background(), testCase.inputAccessorRole, testCase.inputPayload)
+			id, err := categoryService.Create(context.Background(), "u-aBcDeF", testCase.inputAccessorRole, testCase.inputPayload)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
@@ -279,7 +288,10 @@ func TestUpdate(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockIDGen := &mig.IDGenerator{}
 
-	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 
 	testCases := []struct {
 		name              string
@@ -321,6 +333,19 @@ func TestUpdate(t *testing.T) {
 			inputID:           "",
 			expectedError:     service.ErrRepository,
 			mockBehaviour: func() {
+				mockRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Category {
+						return entity.Category{Name: "Music", Description: "This is a music description"}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
 				mockRepo.On(
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -343,6 +368,19 @@ func TestUpdate(t *testing.T) {
 			inputID:           "",
 			expectedError:     nil,
 			mockBehaviour: func() {
+				mockRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Category {
+						return entity.Category{Name: "Music", Description: "This is a music description"}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
 				mockRepo.On(
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -361,7 +399,7 @@ func TestUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			err := categoryService.Update(context.Background(), testCase.inputAccessorRole, testCase.inputID, testCase.inputPayload)
+			err := categoryService.Update(context.Background(), "u-aBcDeF", testCase.inputAccessorRole, testCase.inputID, testCase.inputPayload)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
@@ -377,7 +415,10 @@ func TestDelete(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockIDGen := &mig.IDGenerator{}
 
-	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 
 	testCases := []struct {
 		name              string
@@ -392,12 +433,45 @@ func TestDelete(t *testing.T) {
 			expectedError:     service.ErrAccessForbidden,
 			mockBehaviour:     func() {},
 		},
+		{
+			name:              "it should return service.ErrDataNotFound, when the category is not found",
+			inputAccessorRole: "admin",
+			inputID:           "",
+			expectedError:     service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Category {
+						return entity.Category{Name: "Music", Description: "This is a music description"}
+					},
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
 		{
 			name:              "it should return service.ErrRepository, when repository.ErrDatabase return an error",
 			inputAccessorRole: "admin",
 			inputID:           "",
 			expectedError:     service.ErrRepository,
 			mockBehaviour: func() {
+				mockRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Category {
+						return entity.Category{Name: "Music", Description: "This is a music description"}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
 				mockRepo.On(
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -415,6 +489,19 @@ func TestDelete(t *testing.T) {
 			inputID:           "",
 			expectedError:     nil,
 			mockBehaviour: func() {
+				mockRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Category {
+						return entity.Category{Name: "Music", Description: "This is a music description"}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
 				mockRepo.On(
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -432,7 +519,7 @@ func TestDelete(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			err := categoryService.Delete(context.Background(), testCase.inputAccessorRole, testCase.inputID)
+			err := categoryService.Delete(context.Background(), "u-aBcDeF", testCase.inputAccessorRole, testCase.inputID)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
@@ -449,7 +536,10 @@ func TestGetAllByCategory(t *testing.T) {
 	mockIDGen := &mig.IDGenerator{}
 	now := time.Now()
 
-	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 
 	testCases := []struct {
 		name               string
@@ -457,10 +547,25 @@ func TestGetAllByCategory(t *testing.T) {
 		inputCategoryID    string
 		inputPage          uint
 		inputLimit         uint
+		inputCursor        string
+		inputSort          string
 		expectedError      error
 		expectedPagination response.Pagination[response.ManyThread]
 		mockBehaviour      func()
 	}{
+		{
+			name:          "it should return service.ErrInvalidPayload, when the cursor is malformed",
+			inputCursor:   "not a cursor",
+			expectedError: service.ErrInvalidPayload,
+			mockBehaviour: func() {},
+		},
+		{
+			name:          "it should return service.ErrInvalidPayload, when a cursor is given with the trending sort",
+			inputCursor:   service.EncodeCursor(&entity.Cursor{CreatedAt: now, ID: "t-abcdefg"}),
+			inputSort:     "trending",
+			expectedError: service.ErrInvalidPayload,
+			mockBehaviour: func() {},
+		},
 		{
 			name:               "it should return service.ErrRepository, when category repository return a repository.ErrDatabase error",
 			inputAccessorID:    "",
@@ -511,12 +616,16 @@ func TestGetAllByCategory(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
 					func(
 						ctx context.Context,
 						accessorUserID string,
 						categoryID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo) entity.Pagination[entity.Thread] {
 						return entity.Pagination[entity.Thread]{}
 					},
@@ -524,6 +633,8 @@ func TestGetAllByCategory(t *testing.T) {
 						ctx context.Context,
 						accessorUserID string,
 						categoryID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo) error {
 						return repository.ErrDatabase
 					},
@@ -583,12 +694,16 @@ func TestGetAllByCategory(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
 					func(
 						ctx context.Context,
 						accessorUserID string,
 						categoryID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo) entity.Pagination[entity.Thread] {
 						return entity.Pagination[entity.Thread]{
 							List: []entity.Thread{
@@ -626,6 +741,8 @@ func TestGetAllByCategory(t *testing.T) {
 						ctx context.Context,
 						accessorUserID string,
 						categoryID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo) error {
 						return nil
 					},
@@ -638,7 +755,7 @@ func TestGetAllByCategory(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			pagination, err := categoryService.GetAllByCategory(context.Background(), testCase.inputAccessorID, testCase.inputCategoryID, testCase.inputPage, testCase.inputLimit)
+			pagination, err := categoryService.GetAllByCategory(context.Background(), testCase.inputAccessorID, testCase.inputCategoryID, testCase.inputPage, testCase.inputLimit, testCase.inputCursor, testCase.inputSort, payload.ThreadFilter{})
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
@@ -649,3 +766,102 @@ func TestGetAllByCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRecordsAudit(t *testing.T) {
+	mockRepo := &mcr.CategoryRepository{}
+	mockAuditService := &mas.AuditService{}
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, &mtr.ThreadRepository{}, &mig.IDGenerator{}, mockAuditService, mrp.NewInlineTxManager())
+
+	mockRepo.On(
+		"FindByID",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		"c-abc",
+	).Return(
+		func(ctx context.Context, ID string) entity.Category {
+			return entity.Category{ID: "c-abc", Name: "Music", Description: "This is a music description"}
+		},
+		func(ctx context.Context, ID string) error {
+			return nil
+		},
+	).Once()
+
+	mockRepo.On(
+		"Delete",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		"c-abc",
+	).Return(
+		func(ctx context.Context, id string) error {
+			return nil
+		},
+	).Once()
+
+	mockAuditService.On(
+		"Record",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		audit.Entry{
+			ActorID:    "u-aBcDeF",
+			Action:     audit.CategoryDeleted,
+			TargetType: audit.CategoryTarget,
+			TargetID:   "c-abc",
+			Before:     map[string]any{"name": "Music", "description": "This is a music description"},
+		},
+	).Return(nil).Once()
+
+	err := categoryService.Delete(context.Background(), "u-aBcDeF", "admin", "c-abc")
+
+	assert.NoError(t, err)
+	mockAuditService.AssertExpectations(t)
+}
+
+func TestDeleteRollsBackWhenAuditFails(t *testing.T) {
+	mockRepo := &mcr.CategoryRepository{}
+	mockAuditService := &mas.AuditService{}
+	mockTxManager := &mrp.TxManager{}
+
+	var categoryService CategoryService = NewCategoryServiceImpl(mockRepo, &mtr.ThreadRepository{}, &mig.IDGenerator{}, mockAuditService, mockTxManager)
+
+	mockRepo.On(
+		"FindByID",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		"c-abc",
+	).Return(
+		func(ctx context.Context, ID string) entity.Category {
+			return entity.Category{ID: "c-abc", Name: "Music", Description: "This is a music description"}
+		},
+		func(ctx context.Context, ID string) error {
+			return nil
+		},
+	).Once()
+
+	mockRepo.On(
+		"Delete",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		"c-abc",
+	).Return(
+		func(ctx context.Context, id string) error {
+			return nil
+		},
+	).Once()
+
+	mockAuditService.On(
+		"Record",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		mock.AnythingOfType(fmt.Sprintf("%T", audit.Entry{})),
+	).Return(repository.ErrDatabase).Once()
+
+	// The deletion and its audit entry run in the same transaction, so the error of the entry is the one rolled back.
+	var txErr error
+	mockTxManager.On("WithinTx", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			txErr = fn(ctx)
+			return txErr
+		},
+	).Once()
+
+	err := categoryService.Delete(context.Background(), "u-aBcDeF", "admin", "c-abc")
+
+	assert.ErrorIs(t, err, service.ErrRepository)
+	assert.ErrorIs(t, txErr, repository.ErrDatabase)
+	mockTxManager.AssertExpectations(t)
+}
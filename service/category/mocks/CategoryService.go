@@ -15,20 +15,20 @@ type CategoryService struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, accessorRole, p
-func (_m *CategoryService) Create(ctx context.Context, accessorRole string, p payload.CreateCategory) (string, error) {
-	ret := _m.Called(ctx, accessorRole, p)
+// Create provides a mock function with given fields: ctx, accessorUserID, accessorRole, p
+func (_m *CategoryService) Create(ctx context.Context, accessorUserID string, accessorRole string, p payload.CreateCategory) (string, error) {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, p)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, string, payload.CreateCategory) string); ok {
-		r0 = rf(ctx, accessorRole, p)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.CreateCategory) string); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, p)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, payload.CreateCategory) error); ok {
-		r1 = rf(ctx, accessorRole, p)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.CreateCategory) error); ok {
+		r1 = rf(ctx, accessorUserID, accessorRole, p)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +36,13 @@ func (_m *CategoryService) Create(ctx context.Context, accessorRole string, p pa
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, accessorRole, id
-func (_m *CategoryService) Delete(ctx context.Context, accessorRole string, id string) error {
-	ret := _m.Called(ctx, accessorRole, id)
+// Delete provides a mock function with given fields: ctx, accessorUserID, accessorRole, id
+func (_m *CategoryService) Delete(ctx context.Context, accessorUserID string, accessorRole string, id string) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, accessorRole, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -73,20 +73,20 @@ func (_m *CategoryService) GetAll(ctx context.Context) ([]response.Category, err
 	return r0, r1
 }
 
-// GetAllByCategory provides a mock function with given fields: ctx, accessorID, categoryID, page, limit
-func (_m *CategoryService) GetAllByCategory(ctx context.Context, accessorID string, categoryID string, page uint, limit uint) (response.Pagination[response.ManyThread], error) {
-	ret := _m.Called(ctx, accessorID, categoryID, page, limit)
+// GetAllByCategory provides a mock function with given fields: ctx, accessorID, categoryID, page, limit, cursor, sort, f
+func (_m *CategoryService) GetAllByCategory(ctx context.Context, accessorID string, categoryID string, page uint, limit uint, cursor string, sort string, f payload.ThreadFilter) (response.Pagination[response.ManyThread], error) {
+	ret := _m.Called(ctx, accessorID, categoryID, page, limit, cursor, sort, f)
 
 	var r0 response.Pagination[response.ManyThread]
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint, uint) response.Pagination[response.ManyThread]); ok {
-		r0 = rf(ctx, accessorID, categoryID, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint, uint, string, string, payload.ThreadFilter) response.Pagination[response.ManyThread]); ok {
+		r0 = rf(ctx, accessorID, categoryID, page, limit, cursor, sort, f)
 	} else {
 		r0 = ret.Get(0).(response.Pagination[response.ManyThread])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint, uint) error); ok {
-		r1 = rf(ctx, accessorID, categoryID, page, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint, uint, string, string, payload.ThreadFilter) error); ok {
+		r1 = rf(ctx, accessorID, categoryID, page, limit, cursor, sort, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -94,13 +94,13 @@ func (_m *CategoryService) GetAllByCategory(ctx context.Context, accessorID stri
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, accessorRole, id, p
-func (_m *CategoryService) Update(ctx context.Context, accessorRole string, id string, p payload.UpdateCategory) error {
-	ret := _m.Called(ctx, accessorRole, id, p)
+// Update provides a mock function with given fields: ctx, accessorUserID, accessorRole, id, p
+func (_m *CategoryService) Update(ctx context.Context, accessorUserID string, accessorRole string, id string, p payload.UpdateCategory) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, id, p)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.UpdateCategory) error); ok {
-		r0 = rf(ctx, accessorRole, id, p)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.UpdateCategory) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, id, p)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+)
+
+// cursorDocument is the content of an opaque cursor, the creation time is in microseconds like the database stores it.
+type cursorDocument struct {
+	Key       int64  `json:"k,omitempty"`
+	CreatedAt int64  `json:"t"`
+	ID        string `json:"i"`
+	Backward  bool   `json:"b,omitempty"`
+}
+
+// EncodeCursor converts the cursor into an opaque string, a nil cursor is converted into an empty string.
+func EncodeCursor(cursor *entity.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	document, _ := json.Marshal(cursorDocument{
+		Key:       cursor.Key,
+		CreatedAt: cursor.CreatedAt.UnixMicro(),
+		ID:        cursor.ID,
+		Backward:  cursor.Backward,
+	})
+	return base64.RawURLEncoding.EncodeToString(document)
+}
+
+// DecodeCursor converts an opaque string made by EncodeCursor into a cursor, an empty string is converted into nil.
+// It returns ErrInvalidPayload if the string isn't a valid cursor.
+func DecodeCursor(value string) (cursor *entity.Cursor, err error) {
+	if value == "" {
+		return
+	}
+
+	document, decodeErr := base64.RawURLEncoding.DecodeString(value)
+	if decodeErr != nil {
+		err = ErrInvalidPayload
+		return
+	}
+
+	var c cursorDocument
+	if jsonErr := json.Unmarshal(document, &c); jsonErr != nil || c.ID == "" {
+		err = ErrInvalidPayload
+		return
+	}
+
+	cursor = &entity.Cursor{
+		Key:       c.Key,
+		CreatedAt: time.UnixMicro(c.CreatedAt).UTC(),
+		ID:        c.ID,
+		Backward:  c.Backward,
+	}
+	return
+}
@@ -0,0 +1,16 @@
+package feed
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+type FeedService interface {
+	GetFeed(
+		ctx context.Context,
+		accessorUserID string,
+		cursor string,
+		limit uint,
+	) (rs response.Feed, err error)
+}
@@ -0,0 +1,102 @@
+package feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/feed"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+)
+
+type feedServiceImpl struct {
+	feedRepository feed.FeedRepository
+}
+
+func NewFeedServiceImpl(feedRepository feed.FeedRepository) *feedServiceImpl {
+	return &feedServiceImpl{feedRepository: feedRepository}
+}
+
+func (f *feedServiceImpl) GetFeed(
+	ctx context.Context,
+	accessorUserID string,
+	cursor string,
+	limit uint,
+) (rs response.Feed, err error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	feedCursor, err := service.DecodeCursor(cursor)
+	if err != nil {
+		return
+	}
+
+	// The feed is only listed from the newest activity onwards.
+	if feedCursor != nil && feedCursor.Backward {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	// One more item is fetched to know whether there is a next page.
+	items, repoErr := f.feedRepository.FindAllByUserID(ctx, accessorUserID, feedCursor, limit+1)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if uint(len(items)) > limit {
+		items = items[:limit]
+		last := items[len(items)-1]
+		rs.NextCursor = service.EncodeCursor(&entity.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	rs.List = make([]response.FeedItem, len(items))
+
+	for i, item := range items {
+		feedItem := response.FeedItem{
+			Type:        feedItemTypeToString(item.Type),
+			ID:          item.ID,
+			PublishedOn: item.CreatedAt.Format(time.RFC822),
+			Thread: response.FeedThread{
+				ID:              item.Thread.ID,
+				Title:           item.Thread.Title,
+				Description:     item.Thread.Description,
+				CategoryID:      item.Thread.Category.ID,
+				CategoryName:    item.Thread.Category.Name,
+				CreatorID:       item.Thread.Creator.ID,
+				CreatorUsername: item.Thread.Creator.Username,
+				CreatorName:     item.Thread.Creator.Name,
+				PublishedOn:     item.Thread.CreatedAt.Format(time.RFC822),
+			},
+		}
+
+		if item.Type == entity.FollowedThreadComment {
+			feedItem.Comment = &response.FeedComment{
+				ID:       item.Comment.ID,
+				UserID:   item.Comment.User.ID,
+				Username: item.Comment.User.Username,
+				Name:     item.Comment.User.Name,
+				Comment:  item.Comment.Comment,
+				ParentID: item.Comment.ParentID,
+			}
+		}
+
+		rs.List[i] = feedItem
+	}
+
+	return
+}
+
+func feedItemTypeToString(itemType entity.FeedItemType) (value string) {
+	switch itemType {
+	case entity.FollowedThreadComment:
+		value = "followed_thread_comment"
+	case entity.CategoryThread:
+		value = "category_thread"
+	default:
+		value = "followed_user_thread"
+	}
+	return
+}
@@ -0,0 +1,182 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/feed/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetFeed(t *testing.T) {
+	mockFeedRepo := &mocks.FeedRepository{}
+	var feedService FeedService = NewFeedServiceImpl(mockFeedRepo)
+
+	now := time.Date(2022, 6, 26, 8, 15, 32, 123456000, time.UTC)
+
+	dummyItems := []entity.FeedItem{
+		{
+			Type: entity.FollowedThreadComment,
+			ID:   "c-abcdefg",
+			Thread: entity.Thread{
+				ID:       "t-abcdefg",
+				Title:    "Concurrency",
+				Creator:  entity.User{ID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan"},
+				Category: entity.Category{ID: "g-abc", Name: "Tech"},
+			},
+			Comment: entity.Comment{
+				ID:      "c-abcdefg",
+				User:    entity.User{ID: "u-ghijkl", Username: "naruto", Name: "Naruto Uzumaki"},
+				Comment: "Use a worker pool.",
+			},
+			CreatedAt: now,
+		},
+		{
+			Type: entity.FollowedUserThread,
+			ID:   "t-hijklmn",
+			Thread: entity.Thread{
+				ID:       "t-hijklmn",
+				Title:    "Generics",
+				Creator:  entity.User{ID: "u-mnopqr", Username: "sasuke", Name: "Sasuke Uchiha"},
+				Category: entity.Category{ID: "g-abc", Name: "Tech"},
+			},
+			CreatedAt: now.Add(-time.Hour),
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		inputCursor        string
+		inputLimit         uint
+		expectedError      error
+		expectedTypes      []string
+		expectedNextCursor bool
+		mockBehaviours     func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload, when the cursor is malformed",
+			inputCursor:    "not a cursor",
+			inputLimit:     1,
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload, when the cursor is backward",
+			inputCursor:    service.EncodeCursor(&entity.Cursor{CreatedAt: now, ID: "c-abcdefg", Backward: true}),
+			inputLimit:     1,
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository, when feed repository return a repository.ErrDatabase error",
+			inputCursor:   "",
+			inputLimit:    1,
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockFeedRepo.On(
+					"FindAllByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", &entity.Cursor{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", uint(0))),
+				).Return(
+					func(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) []entity.FeedItem {
+						return nil
+					},
+					func(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:               "it should return a next cursor, when there are more items than the limit",
+			inputCursor:        "",
+			inputLimit:         1,
+			expectedError:      nil,
+			expectedTypes:      []string{"followed_thread_comment"},
+			expectedNextCursor: true,
+			mockBehaviours: func() {
+				mockFeedRepo.On(
+					"FindAllByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+					(*entity.Cursor)(nil),
+					uint(2),
+				).Return(
+					func(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) []entity.FeedItem {
+						return dummyItems
+					},
+					func(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:               "it should decode the cursor and return no next cursor, when there is no more item",
+			inputCursor:        service.EncodeCursor(&entity.Cursor{CreatedAt: now, ID: "c-abcdefg"}),
+			inputLimit:         1,
+			expectedError:      nil,
+			expectedTypes:      []string{"followed_user_thread"},
+			expectedNextCursor: false,
+			mockBehaviours: func() {
+				mockFeedRepo.On(
+					"FindAllByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+					&entity.Cursor{CreatedAt: now, ID: "c-abcdefg"},
+					uint(2),
+				).Return(
+					func(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) []entity.FeedItem {
+						return dummyItems[1:]
+					},
+					func(ctx context.Context, userID string, cursor *entity.Cursor, limit uint) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotFeed, gotErr := feedService.GetFeed(context.Background(), "u-abcdef", testCase.inputCursor, testCase.inputLimit)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+
+				gotTypes := make([]string, len(gotFeed.List))
+				for i, item := range gotFeed.List {
+					gotTypes[i] = item.Type
+				}
+				assert.Equal(t, testCase.expectedTypes, gotTypes)
+
+				if testCase.expectedNextCursor {
+					assert.Equal(t, service.EncodeCursor(&entity.Cursor{CreatedAt: now, ID: "c-abcdefg"}), gotFeed.NextCursor)
+					assert.Equal(t, &response.FeedComment{
+						ID:       "c-abcdefg",
+						UserID:   "u-ghijkl",
+						Username: "naruto",
+						Name:     "Naruto Uzumaki",
+						Comment:  "Use a worker pool.",
+					}, gotFeed.List[0].Comment)
+				} else {
+					assert.Empty(t, gotFeed.NextCursor)
+					assert.Nil(t, gotFeed.List[0].Comment)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+// FeedService is an autogenerated mock type for the FeedService type
+type FeedService struct {
+	mock.Mock
+}
+
+// GetFeed provides a mock function with given fields: ctx, accessorUserID, cursor, limit
+func (_m *FeedService) GetFeed(ctx context.Context, accessorUserID string, cursor string, limit uint) (response.Feed, error) {
+	ret := _m.Called(ctx, accessorUserID, cursor, limit)
+
+	var r0 response.Feed
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint) response.Feed); ok {
+		r0 = rf(ctx, accessorUserID, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(response.Feed)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint) error); ok {
+		r1 = rf(ctx, accessorUserID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewFeedService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFeedService creates a new instance of FeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFeedService(t mockConstructorTestingTNewFeedService) *FeedService {
+	mock := &FeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+// NotificationService is an autogenerated mock type for the NotificationService type
+type NotificationService struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: ctx, accessorUserID, page, limit
+func (_m *NotificationService) GetAll(ctx context.Context, accessorUserID string, page uint, limit uint) (response.Pagination[response.Notification], error) {
+	ret := _m.Called(ctx, accessorUserID, page, limit)
+
+	var r0 response.Pagination[response.Notification]
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint, uint) response.Pagination[response.Notification]); ok {
+		r0 = rf(ctx, accessorUserID, page, limit)
+	} else {
+		r0 = ret.Get(0).(response.Pagination[response.Notification])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint, uint) error); ok {
+		r1 = rf(ctx, accessorUserID, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnreadTotal provides a mock function with given fields: ctx, accessorUserID
+func (_m *NotificationService) GetUnreadTotal(ctx context.Context, accessorUserID string) (response.UnreadNotification, error) {
+	ret := _m.Called(ctx, accessorUserID)
+
+	var r0 response.UnreadNotification
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.UnreadNotification); ok {
+		r0 = rf(ctx, accessorUserID)
+	} else {
+		r0 = ret.Get(0).(response.UnreadNotification)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessorUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllAsRead provides a mock function with given fields: ctx, accessorUserID
+func (_m *NotificationService) MarkAllAsRead(ctx context.Context, accessorUserID string) error {
+	ret := _m.Called(ctx, accessorUserID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, accessorUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkAsRead provides a mock function with given fields: ctx, accessorUserID, ID
+func (_m *NotificationService) MarkAsRead(ctx context.Context, accessorUserID string, ID string) error {
+	ret := _m.Called(ctx, accessorUserID, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, accessorUserID, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewNotificationService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNotificationService(t mockConstructorTestingTNewNotificationService) *NotificationService {
+	mock := &NotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+)
+
+type NotificationService interface {
+	GetAll(
+		ctx context.Context,
+		accessorUserID string,
+		page uint,
+		limit uint,
+	) (rs response.Pagination[response.Notification], err error)
+
+	GetUnreadTotal(
+		ctx context.Context,
+		accessorUserID string,
+	) (rs response.UnreadNotification, err error)
+
+	MarkAsRead(
+		ctx context.Context,
+		accessorUserID string,
+		ID string,
+	) (err error)
+
+	MarkAllAsRead(
+		ctx context.Context,
+		accessorUserID string,
+	) (err error)
+}
@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+)
+
+type notificationServiceImpl struct {
+	notificationRepository notification.NotificationRepository
+}
+
+func NewNotificationServiceImpl(notificationRepository notification.NotificationRepository) *notificationServiceImpl {
+	return &notificationServiceImpl{notificationRepository: notificationRepository}
+}
+
+func (n *notificationServiceImpl) GetAll(
+	ctx context.Context,
+	accessorUserID string,
+	page uint,
+	limit uint,
+) (rs response.Pagination[response.Notification], err error) {
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	pagination, repoErr := n.notificationRepository.FindAllByUserIDWithPagination(
+		ctx,
+		accessorUserID,
+		entity.PageInfo{
+			Limit: limit,
+			Page:  page,
+		},
+	)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs.PageInfo.Limit = pagination.PageInfo.Limit
+	rs.PageInfo.Page = pagination.PageInfo.Page
+	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
+	rs.PageInfo.Total = pagination.PageInfo.Total
+
+	rs.List = make([]response.Notification, len(pagination.List))
+
+	for i, item := range pagination.List {
+		rs.List[i] = response.Notification{
+			ID:            item.ID,
+			Type:          service.NotificationTypeToString(item.Type),
+			ActorID:       item.Actor.ID,
+			ActorUsername: item.Actor.Username,
+			ActorName:     item.Actor.Name,
+			ThreadID:      item.Thread.ID,
+			ThreadTitle:   item.Thread.Title,
+			CommentID:     item.Comment.ID,
+			IsRead:        item.IsRead,
+			CreatedOn:     item.CreatedAt.Format(time.RFC822),
+		}
+	}
+
+	return
+}
+
+func (n *notificationServiceImpl) GetUnreadTotal(
+	ctx context.Context,
+	accessorUserID string,
+) (rs response.UnreadNotification, err error) {
+	count, repoErr := n.notificationRepository.CountUnreadByUserID(ctx, accessorUserID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs.Total = count
+	return
+}
+
+func (n *notificationServiceImpl) MarkAsRead(
+	ctx context.Context,
+	accessorUserID string,
+	ID string,
+) (err error) {
+	if repoErr := n.notificationRepository.MarkAsRead(ctx, ID, accessorUserID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (n *notificationServiceImpl) MarkAllAsRead(
+	ctx context.Context,
+	accessorUserID string,
+) (err error) {
+	if repoErr := n.notificationRepository.MarkAllAsRead(ctx, accessorUserID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
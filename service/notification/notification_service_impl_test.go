@@ -0,0 +1,329 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAll(t *testing.T) {
+	mockNotificationRepo := &mocks.NotificationRepository{}
+	var notificationService NotificationService = NewNotificationServiceImpl(mockNotificationRepo)
+
+	now := time.Now()
+
+	dummyNotifications := []entity.Notification{
+		{
+			ID:        "n-abcdefg",
+			User:      entity.User{ID: "u-abcdef"},
+			Actor:     entity.User{ID: "u-ghijkl", Username: "naruto", Name: "Naruto Uzumaki"},
+			Type:      entity.CommentNotification,
+			Thread:    entity.Thread{ID: "t-abcdefg", Title: "Concurrency"},
+			Comment:   entity.Comment{ID: "c-abcdefg"},
+			IsRead:    false,
+			CreatedAt: now,
+		},
+		{
+			ID:        "n-hijklmn",
+			User:      entity.User{ID: "u-abcdef"},
+			Actor:     entity.User{ID: "u-mnopqr", Username: "sasuke", Name: "Sasuke Uchiha"},
+			Type:      entity.FollowNotification,
+			IsRead:    true,
+			CreatedAt: now,
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		inputPage      uint
+		inputLimit     uint
+		expected       response.Pagination[response.Notification]
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository, when notification repository return a repository.ErrDatabase error",
+			inputPage:     0,
+			inputLimit:    0,
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"FindAllByUserIDWithPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					entity.PageInfo{Limit: 10, Page: 1},
+				).Return(
+					func(ctx context.Context, userID string, pageInfo entity.PageInfo) entity.Pagination[entity.Notification] {
+						return entity.Pagination[entity.Notification]{}
+					},
+					func(ctx context.Context, userID string, pageInfo entity.PageInfo) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return valid notifications, when notification repository return nil error",
+			inputPage:     1,
+			inputLimit:    2,
+			expectedError: nil,
+			expected: response.Pagination[response.Notification]{
+				List: []response.Notification{
+					{
+						ID:            "n-abcdefg",
+						Type:          "comment",
+						ActorID:       "u-ghijkl",
+						ActorUsername: "naruto",
+						ActorName:     "Naruto Uzumaki",
+						ThreadID:      "t-abcdefg",
+						ThreadTitle:   "Concurrency",
+						CommentID:     "c-abcdefg",
+						IsRead:        false,
+						CreatedOn:     now.Format(time.RFC822),
+					},
+					{
+						ID:            "n-hijklmn",
+						Type:          "follow",
+						ActorID:       "u-mnopqr",
+						ActorUsername: "sasuke",
+						ActorName:     "Sasuke Uchiha",
+						IsRead:        true,
+						CreatedOn:     now.Format(time.RFC822),
+					},
+				},
+				PageInfo: response.PageInfo{
+					Limit:     2,
+					Page:      1,
+					PageTotal: 1,
+					Total:     2,
+				},
+			},
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"FindAllByUserIDWithPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+					entity.PageInfo{Limit: 2, Page: 1},
+				).Return(
+					func(ctx context.Context, userID string, pageInfo entity.PageInfo) entity.Pagination[entity.Notification] {
+						return entity.Pagination[entity.Notification]{
+							List: dummyNotifications,
+							PageInfo: entity.PageInfo{
+								Limit:     2,
+								Page:      1,
+								PageTotal: 1,
+								Total:     2,
+							},
+						}
+					},
+					func(ctx context.Context, userID string, pageInfo entity.PageInfo) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			got, gotErr := notificationService.GetAll(context.Background(), "u-abcdef", testCase.inputPage, testCase.inputLimit)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetUnreadTotal(t *testing.T) {
+	mockNotificationRepo := &mocks.NotificationRepository{}
+	var notificationService NotificationService = NewNotificationServiceImpl(mockNotificationRepo)
+
+	testCases := []struct {
+		name           string
+		expectedTotal  uint
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository, when notification repository return a repository.ErrDatabase error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"CountUnreadByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userID string) uint {
+						return 0
+					},
+					func(ctx context.Context, userID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return valid total, when notification repository return nil error",
+			expectedTotal: 5,
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"CountUnreadByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userID string) uint {
+						return 5
+					},
+					func(ctx context.Context, userID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			got, gotErr := notificationService.GetUnreadTotal(context.Background(), "u-abcdef")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedTotal, got.Total)
+			}
+		})
+	}
+}
+
+func TestMarkAsRead(t *testing.T) {
+	mockNotificationRepo := &mocks.NotificationRepository{}
+	var notificationService NotificationService = NewNotificationServiceImpl(mockNotificationRepo)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound, when notification repository return a repository.ErrRecordNotFound error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"MarkAsRead",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string, userID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when notification repository return nil error",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"MarkAsRead",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"n-abcdefg",
+					"u-abcdef",
+				).Return(
+					func(ctx context.Context, ID string, userID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := notificationService.MarkAsRead(context.Background(), "u-abcdef", "n-abcdefg")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestMarkAllAsRead(t *testing.T) {
+	mockNotificationRepo := &mocks.NotificationRepository{}
+	var notificationService NotificationService = NewNotificationServiceImpl(mockNotificationRepo)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository, when notification repository return a repository.ErrDatabase error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"MarkAllAsRead",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when notification repository return nil error",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockNotificationRepo.On(
+					"MarkAllAsRead",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := notificationService.MarkAllAsRead(context.Background(), "u-abcdef")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+type Permission string
+
+const (
+	PermissionViewDashboard     Permission = "dashboard:view"
+	PermissionManageCategories  Permission = "categories:manage"
+	PermissionManageReports     Permission = "reports:manage"
+	PermissionBanUsers          Permission = "users:ban"
+	PermissionDeleteAnyThread   Permission = "threads:delete-any"
+	PermissionDeleteAnyComment  Permission = "comments:delete-any"
+	PermissionManageLockouts    Permission = "lockouts:manage"
+	PermissionManageRoles       Permission = "roles:manage"
+	PermissionModerateAnyThread Permission = "threads:moderate-any"
+	PermissionViewAuditLog      Permission = "audit:view"
+	PermissionExportData        Permission = "data:export"
+)
+
+const (
+	RoleAdmin           = "admin"
+	RoleGlobalModerator = "global_moderator"
+	RoleUser            = "user"
+)
+
+// Roles lists the available roles, from the most to the least privileged.
+var Roles = []string{RoleAdmin, RoleGlobalModerator, RoleUser}
+
+var rolePermissions = map[string][]Permission{
+	RoleAdmin: {
+		PermissionViewDashboard,
+		PermissionManageCategories,
+		PermissionManageReports,
+		PermissionBanUsers,
+		PermissionDeleteAnyThread,
+		PermissionDeleteAnyComment,
+		PermissionManageLockouts,
+		PermissionManageRoles,
+		PermissionModerateAnyThread,
+		PermissionViewAuditLog,
+		PermissionExportData,
+	},
+	RoleGlobalModerator: {
+		PermissionManageReports,
+		PermissionBanUsers,
+		PermissionDeleteAnyThread,
+		PermissionDeleteAnyComment,
+		PermissionModerateAnyThread,
+	},
+	RoleUser: {},
+}
+
+// PermissionsOf returns the permissions granted to the role, an unknown role has none.
+func PermissionsOf(role string) []Permission {
+	return rolePermissions[role]
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+func HasPermission(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize returns ErrAccessForbidden when the role isn't granted the permission.
+func Authorize(role string, permission Permission) error {
+	if !HasPermission(role, permission) {
+		return ErrAccessForbidden
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+)
+
+func NotificationTypeToString(notificationType entity.NotificationType) (value string) {
+	switch notificationType {
+	case entity.FollowNotification:
+		value = "follow"
+	case entity.LikeNotification:
+		value = "like"
+	case entity.ModeratorNotification:
+		value = "moderator"
+	default:
+		value = "comment"
+	}
+	return
+}
+
+// NewNotificationEvent returns the event pushed to the recipient of a stored notification.
+func NewNotificationEvent(notification entity.Notification) realtime.Event {
+	return realtime.Event{
+		Topic: realtime.NotificationTopic(notification.User.ID),
+		Type:  realtime.NotificationCreated,
+		Data: response.Notification{
+			ID:            notification.ID,
+			Type:          NotificationTypeToString(notification.Type),
+			ActorID:       notification.Actor.ID,
+			ActorUsername: notification.Actor.Username,
+			ActorName:     notification.Actor.Name,
+			ThreadID:      notification.Thread.ID,
+			ThreadTitle:   notification.Thread.Title,
+			CommentID:     notification.Comment.ID,
+			IsRead:        notification.IsRead,
+			CreatedOn:     notification.CreatedAt.Format(time.RFC822),
+		},
+	}
+}
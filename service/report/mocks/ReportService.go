@@ -58,6 +58,20 @@ func (_m *ReportService) GetAll(ctx context.Context, accessorRole string, status
 	return r0, r1
 }
 
+// UpdateStatus provides a mock function with given fields: ctx, accessorUserID, accessorRole, ID, p
+func (_m *ReportService) UpdateStatus(ctx context.Context, accessorUserID string, accessorRole string, ID string, p payload.UpdateReportStatus) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, ID, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.UpdateReportStatus) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, ID, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewReportService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -21,4 +21,12 @@ type ReportService interface {
 		accessorUserID string,
 		p payload.CreateReport,
 	) (id string, err error)
+
+	UpdateStatus(
+		ctx context.Context,
+		accessorUserID,
+		accessorRole,
+		ID string,
+		p payload.UpdateReportStatus,
+	) (err error)
 }
@@ -2,15 +2,18 @@ package report
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/report"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
 	"gopkg.in/validator.v2"
 )
@@ -20,6 +23,8 @@ type reportServiceImpl struct {
 	userRepository   user.UserRepository
 	threadRepository thread.ThreadRepository
 	idGenerator      generator.IDGenerator
+	auditService     audit.AuditService
+	txManager        repository.TxManager
 }
 
 func NewReportServiceImpl(
@@ -27,12 +32,16 @@ func NewReportServiceImpl(
 	userRepository user.UserRepository,
 	threadRepository thread.ThreadRepository,
 	idGenerator generator.IDGenerator,
+	auditService audit.AuditService,
+	txManager repository.TxManager,
 ) *reportServiceImpl {
 	return &reportServiceImpl{
 		reportRepository: reportRepository,
 		userRepository:   userRepository,
 		threadRepository: threadRepository,
 		idGenerator:      idGenerator,
+		auditService:     auditService,
+		txManager:        txManager,
 	}
 }
 
@@ -43,8 +52,7 @@ func (r *reportServiceImpl) GetAll(
 	page uint,
 	limit uint,
 ) (rs response.Pagination[response.Report], err error) {
-	if accessorRole != "admin" {
-		err = service.ErrAccessForbidden
+	if err = service.Authorize(accessorRole, service.PermissionManageReports); err != nil {
 		return
 	}
 
@@ -59,6 +67,8 @@ func (r *reportServiceImpl) GetAll(
 	var reportStatus entity.ReportStatus
 	if status == "accepted" {
 		reportStatus = entity.Accepted
+	} else if status == "rejected" {
+		reportStatus = entity.Rejected
 	} else {
 		reportStatus = entity.Review
 	}
@@ -161,3 +171,96 @@ func (r *reportServiceImpl) Create(
 
 	return
 }
+
+func (r *reportServiceImpl) UpdateStatus(
+	ctx context.Context,
+	accessorUserID,
+	accessorRole,
+	ID string,
+	p payload.UpdateReportStatus,
+) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionManageReports); err != nil {
+		return
+	}
+
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.Status != "accepted" && p.Status != "rejected" {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	report, repoErr := r.reportRepository.FindByID(ctx, ID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if report.Status != "review" {
+		err = service.ErrDataAlreadyExists
+		return
+	}
+
+	var banID string
+	if p.Status == "accepted" {
+		var genErr error
+		if banID, genErr = r.idGenerator.GenerateBanID(); genErr != nil {
+			err = service.MapError(genErr)
+			return
+		}
+	}
+
+	// The report, the ban and their audit entries are written within a single transaction.
+	if txErr := r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if p.Status == "accepted" {
+			// BannedUser accepts every report of the user that is still in review and
+			// deactivates the user within a single transaction, the reported user is banned permanently.
+			repoErr := r.userRepository.BannedUser(ctx, entity.Ban{
+				ID:     banID,
+				User:   report.User,
+				Issuer: entity.User{ID: accessorUserID},
+				Reason: report.Reason,
+			})
+			switch {
+			case errors.Is(repoErr, repository.ErrRecordAlreadyExists):
+				// The user is already banned, only the report is settled.
+				if repoErr := r.reportRepository.UpdateStatus(ctx, ID, entity.Accepted); repoErr != nil {
+					return repoErr
+				}
+			case repoErr != nil:
+				return repoErr
+			default:
+				if auditErr := r.auditService.Record(ctx, audit.Entry{
+					ActorID:    accessorUserID,
+					Action:     audit.UserBanned,
+					TargetType: audit.UserTarget,
+					TargetID:   report.User.ID,
+					After:      map[string]any{"banID": banID, "reason": report.Reason, "reportID": ID},
+				}); auditErr != nil {
+					return auditErr
+				}
+			}
+		} else {
+			if repoErr := r.reportRepository.UpdateStatus(ctx, ID, entity.Rejected); repoErr != nil {
+				return repoErr
+			}
+		}
+
+		return r.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.ReportStatusUpdated,
+			TargetType: audit.ReportTarget,
+			TargetID:   ID,
+			Before:     map[string]any{"status": report.Status},
+			After:      map[string]any{"status": p.Status},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	return
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	mrp "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/mocks"
 	mr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/report/mocks"
 	mt "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread/mocks"
 	mu "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mas "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit/mocks"
 	mi "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -26,7 +28,10 @@ func TestGetAll(t *testing.T) {
 	mockThreadRepo := &mt.ThreadRepository{}
 	mockIDGen := &mi.IDGenerator{}
 
-	var reportService ReportService = NewReportServiceImpl(mockReportRepo, mockUserRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var reportService ReportService = NewReportServiceImpl(mockReportRepo, mockUserRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 
 	now := time.Now()
 
@@ -206,7 +211,10 @@ func TestCreate(t *testing.T) {
 	mockThreadRepo := &mt.ThreadRepository{}
 	mockIDGen := &mi.IDGenerator{}
 
-	var reportService ReportService = NewReportServiceImpl(mockReportRepo, mockUserRepo, mockThreadRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var reportService ReportService = NewReportServiceImpl(mockReportRepo, mockUserRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
 
 	testCases := []struct {
 		name                string
@@ -722,3 +730,318 @@ func TestCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateStatus(t *testing.T) {
+	mockReportRepo := &mr.ReportRepository{}
+	mockUserRepo := &mu.UserRepository{}
+	mockThreadRepo := &mt.ThreadRepository{}
+	mockIDGen := &mi.IDGenerator{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var reportService ReportService = NewReportServiceImpl(mockReportRepo, mockUserRepo, mockThreadRepo, mockIDGen, mockAuditService, mrp.NewInlineTxManager())
+
+	dummyReport := entity.UserBanned{
+		ID: "r-ErLN4lS",
+		User: entity.User{
+			ID:       "u-ZrxmQq",
+			Username: "naruto",
+			Name:     "Naruto Uzumaki",
+		},
+		Reason: "Harrashment",
+		Status: "review",
+	}
+
+	dummyBan := entity.Ban{
+		ID:     "b-abcdefg",
+		User:   dummyReport.User,
+		Issuer: entity.User{ID: "a-abcd"},
+		Reason: dummyReport.Reason,
+	}
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		inputID           string
+		inputPayload      payload.UpdateReportStatus
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, when accessor role is not admin",
+			inputAccessorRole: "user",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "accepted"},
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, when the status is not accepted or rejected",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "reviewed"},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrDataNotFound, when find by ID return repository.ErrRecordNotFound error",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "accepted"},
+			expectedError:     service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						return entity.UserBanned{}
+					},
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrDataAlreadyExists, when the report is already resolved",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "rejected"},
+			expectedError:     service.ErrDataAlreadyExists,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						report := dummyReport
+						report.Status = "accepted"
+						return report
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository, when banned user return repository.ErrDatabase error",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "accepted"},
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						return dummyReport
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return dummyBan.ID
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					dummyBan,
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the report is accepted and the user is banned",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "accepted"},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						return dummyReport
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return dummyBan.ID
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					dummyBan,
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the report is accepted and the user is already banned",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "accepted"},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						return dummyReport
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return dummyBan.ID
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					dummyBan,
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
+						return repository.ErrRecordAlreadyExists
+					},
+				).Once()
+
+				mockReportRepo.On(
+					"UpdateStatus",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"r-ErLN4lS",
+					entity.Accepted,
+				).Return(
+					func(ctx context.Context, ID string, reportStatus entity.ReportStatus) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository, when update status return repository.ErrDatabase error",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "rejected"},
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						return dummyReport
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockReportRepo.On(
+					"UpdateStatus",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					entity.Rejected,
+				).Return(
+					func(ctx context.Context, ID string, reportStatus entity.ReportStatus) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the report is rejected",
+			inputAccessorRole: "admin",
+			inputID:           "r-ErLN4lS",
+			inputPayload:      payload.UpdateReportStatus{Status: "rejected"},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockReportRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.UserBanned {
+						return dummyReport
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockReportRepo.On(
+					"UpdateStatus",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					entity.Rejected,
+				).Return(
+					func(ctx context.Context, ID string, reportStatus entity.ReportStatus) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := reportService.UpdateStatus(
+				context.Background(),
+				"a-abcd",
+				testCase.inputAccessorRole,
+				testCase.inputID,
+				testCase.inputPayload,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
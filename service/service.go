@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 )
@@ -14,8 +15,42 @@ var (
 	ErrCredentialNotMatch = errors.New("service: credential not match")
 	ErrUsernameNotFound   = errors.New("service: username not found")
 	ErrAccessForbidden    = errors.New("service: access for this resource is forbidden")
+	ErrInvalidToken       = errors.New("service: invalid or expired token")
+	ErrEmailNotVerified   = errors.New("service: email address is not verified")
+	ErrTooManyAttempts    = errors.New("service: too many failed attempts")
+	ErrUserBanned         = errors.New("service: user is banned")
+	ErrThreadLocked       = errors.New("service: thread is locked")
+	ErrUserMuted          = errors.New("service: user is muted in the thread")
 )
 
+// TooManyAttemptsError is returned while the login is locked out after too many failed attempts, it matches ErrTooManyAttempts.
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+func (e *TooManyAttemptsError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
+// BannedError is returned when a banned user signs in, it matches ErrUserBanned.
+// A zero ExpiresAt means the ban is permanent.
+type BannedError struct {
+	Reason    string
+	ExpiresAt time.Time
+}
+
+func (e *BannedError) Error() string {
+	return ErrUserBanned.Error()
+}
+
+func (e *BannedError) Is(target error) bool {
+	return target == ErrUserBanned
+}
+
 func MapError(from error) error {
 	if errors.Is(from, repository.ErrRecordNotFound) {
 		return ErrDataNotFound
@@ -99,6 +99,27 @@ func (_m *ThreadService) CreateComment(ctx context.Context, threadID string, acc
 	return r0, r1
 }
 
+// CreateReply provides a mock function with given fields: ctx, threadID, commentID, accessorUserID, p
+func (_m *ThreadService) CreateReply(ctx context.Context, threadID string, commentID string, accessorUserID string, p payload.CreateComment) (string, error) {
+	ret := _m.Called(ctx, threadID, commentID, accessorUserID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.CreateComment) string); ok {
+		r0 = rf(ctx, threadID, commentID, accessorUserID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, payload.CreateComment) error); ok {
+		r1 = rf(ctx, threadID, commentID, accessorUserID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: ctx, accessorUserID, role, ID
 func (_m *ThreadService) Delete(ctx context.Context, accessorUserID string, role string, ID string) error {
 	ret := _m.Called(ctx, accessorUserID, role, ID)
@@ -113,20 +134,34 @@ func (_m *ThreadService) Delete(ctx context.Context, accessorUserID string, role
 	return r0
 }
 
-// GetAll provides a mock function with given fields: ctx, accessorUserID, page, limit, query
-func (_m *ThreadService) GetAll(ctx context.Context, accessorUserID string, page uint, limit uint, query string) (response.Pagination[response.ManyThread], error) {
-	ret := _m.Called(ctx, accessorUserID, page, limit, query)
+// DeleteComment provides a mock function with given fields: ctx, threadID, commentID, accessorUserID, role
+func (_m *ThreadService) DeleteComment(ctx context.Context, threadID string, commentID string, accessorUserID string, role string) error {
+	ret := _m.Called(ctx, threadID, commentID, accessorUserID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, threadID, commentID, accessorUserID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx, accessorUserID, page, limit, cursor, query, sort, f
+func (_m *ThreadService) GetAll(ctx context.Context, accessorUserID string, page uint, limit uint, cursor string, query string, sort string, f payload.ThreadFilter) (response.Pagination[response.ManyThread], error) {
+	ret := _m.Called(ctx, accessorUserID, page, limit, cursor, query, sort, f)
 
 	var r0 response.Pagination[response.ManyThread]
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint, uint, string) response.Pagination[response.ManyThread]); ok {
-		r0 = rf(ctx, accessorUserID, page, limit, query)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint, uint, string, string, string, payload.ThreadFilter) response.Pagination[response.ManyThread]); ok {
+		r0 = rf(ctx, accessorUserID, page, limit, cursor, query, sort, f)
 	} else {
 		r0 = ret.Get(0).(response.Pagination[response.ManyThread])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, uint, uint, string) error); ok {
-		r1 = rf(ctx, accessorUserID, page, limit, query)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint, uint, string, string, string, payload.ThreadFilter) error); ok {
+		r1 = rf(ctx, accessorUserID, page, limit, cursor, query, sort, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -155,20 +190,41 @@ func (_m *ThreadService) GetByID(ctx context.Context, accessorUserID string, ID
 	return r0, r1
 }
 
-// GetComments provides a mock function with given fields: ctx, threadID, page, limit
-func (_m *ThreadService) GetComments(ctx context.Context, threadID string, page uint, limit uint) (response.Pagination[response.Comment], error) {
-	ret := _m.Called(ctx, threadID, page, limit)
+// GetComments provides a mock function with given fields: ctx, threadID, page, limit, cursor, view, depth
+func (_m *ThreadService) GetComments(ctx context.Context, threadID string, page uint, limit uint, cursor string, view string, depth uint) (response.Pagination[response.Comment], error) {
+	ret := _m.Called(ctx, threadID, page, limit, cursor, view, depth)
 
 	var r0 response.Pagination[response.Comment]
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint, uint) response.Pagination[response.Comment]); ok {
-		r0 = rf(ctx, threadID, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint, uint, string, string, uint) response.Pagination[response.Comment]); ok {
+		r0 = rf(ctx, threadID, page, limit, cursor, view, depth)
 	} else {
 		r0 = ret.Get(0).(response.Pagination[response.Comment])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, uint, uint) error); ok {
-		r1 = rf(ctx, threadID, page, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint, uint, string, string, uint) error); ok {
+		r1 = rf(ctx, threadID, page, limit, cursor, view, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetModerationLogs provides a mock function with given fields: ctx, threadID, accessorUserID, role, page, limit
+func (_m *ThreadService) GetModerationLogs(ctx context.Context, threadID string, accessorUserID string, role string, page uint, limit uint) (response.Pagination[response.ModerationLog], error) {
+	ret := _m.Called(ctx, threadID, accessorUserID, role, page, limit)
+
+	var r0 response.Pagination[response.ModerationLog]
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint, uint) response.Pagination[response.ModerationLog]); ok {
+		r0 = rf(ctx, threadID, accessorUserID, role, page, limit)
+	} else {
+		r0 = ret.Get(0).(response.Pagination[response.ModerationLog])
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint, uint) error); ok {
+		r1 = rf(ctx, threadID, accessorUserID, role, page, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -176,6 +232,48 @@ func (_m *ThreadService) GetComments(ctx context.Context, threadID string, page
 	return r0, r1
 }
 
+// LockThread provides a mock function with given fields: ctx, threadID, accessorUserID, role
+func (_m *ThreadService) LockThread(ctx context.Context, threadID string, accessorUserID string, role string) error {
+	ret := _m.Called(ctx, threadID, accessorUserID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, threadID, accessorUserID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MuteUser provides a mock function with given fields: ctx, threadID, accessorUserID, role, p
+func (_m *ThreadService) MuteUser(ctx context.Context, threadID string, accessorUserID string, role string, p payload.MuteUser) error {
+	ret := _m.Called(ctx, threadID, accessorUserID, role, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.MuteUser) error); ok {
+		r0 = rf(ctx, threadID, accessorUserID, role, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PinComment provides a mock function with given fields: ctx, threadID, commentID, accessorUserID, role
+func (_m *ThreadService) PinComment(ctx context.Context, threadID string, commentID string, accessorUserID string, role string) error {
+	ret := _m.Called(ctx, threadID, commentID, accessorUserID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, threadID, commentID, accessorUserID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveModerator provides a mock function with given fields: ctx, p, threadID, accessorUserID
 func (_m *ThreadService) RemoveModerator(ctx context.Context, p payload.AddRemoveModerator, threadID string, accessorUserID string) error {
 	ret := _m.Called(ctx, p, threadID, accessorUserID)
@@ -190,6 +288,48 @@ func (_m *ThreadService) RemoveModerator(ctx context.Context, p payload.AddRemov
 	return r0
 }
 
+// UnlockThread provides a mock function with given fields: ctx, threadID, accessorUserID, role
+func (_m *ThreadService) UnlockThread(ctx context.Context, threadID string, accessorUserID string, role string) error {
+	ret := _m.Called(ctx, threadID, accessorUserID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, threadID, accessorUserID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnmuteUser provides a mock function with given fields: ctx, threadID, username, accessorUserID, role
+func (_m *ThreadService) UnmuteUser(ctx context.Context, threadID string, username string, accessorUserID string, role string) error {
+	ret := _m.Called(ctx, threadID, username, accessorUserID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, threadID, username, accessorUserID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpinComment provides a mock function with given fields: ctx, threadID, commentID, accessorUserID, role
+func (_m *ThreadService) UnpinComment(ctx context.Context, threadID string, commentID string, accessorUserID string, role string) error {
+	ret := _m.Called(ctx, threadID, commentID, accessorUserID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, threadID, commentID, accessorUserID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, accessorUserID, ID, p
 func (_m *ThreadService) Update(ctx context.Context, accessorUserID string, ID string, p payload.UpdateThread) error {
 	ret := _m.Called(ctx, accessorUserID, ID, p)
@@ -204,6 +344,20 @@ func (_m *ThreadService) Update(ctx context.Context, accessorUserID string, ID s
 	return r0
 }
 
+// UpdateComment provides a mock function with given fields: ctx, threadID, commentID, accessorUserID, p
+func (_m *ThreadService) UpdateComment(ctx context.Context, threadID string, commentID string, accessorUserID string, p payload.UpdateComment) error {
+	ret := _m.Called(ctx, threadID, commentID, accessorUserID, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.UpdateComment) error); ok {
+		r0 = rf(ctx, threadID, commentID, accessorUserID, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewThreadService interface {
 	mock.TestingT
 	Cleanup(func())
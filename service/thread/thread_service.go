@@ -13,7 +13,10 @@ type ThreadService interface {
 		accessorUserID string,
 		page uint,
 		limit uint,
+		cursor string,
 		query string,
+		sort string,
+		f payload.ThreadFilter,
 	) (rs response.Pagination[response.ManyThread], err error)
 
 	Create(
@@ -47,6 +50,9 @@ type ThreadService interface {
 		threadID string,
 		page uint,
 		limit uint,
+		cursor string,
+		view string,
+		depth uint,
 	) (rs response.Pagination[response.Comment], err error)
 
 	CreateComment(
@@ -56,6 +62,30 @@ type ThreadService interface {
 		p payload.CreateComment,
 	) (id string, err error)
 
+	CreateReply(
+		ctx context.Context,
+		threadID string,
+		commentID string,
+		accessorUserID string,
+		p payload.CreateComment,
+	) (id string, err error)
+
+	UpdateComment(
+		ctx context.Context,
+		threadID string,
+		commentID string,
+		accessorUserID string,
+		p payload.UpdateComment,
+	) (err error)
+
+	DeleteComment(
+		ctx context.Context,
+		threadID string,
+		commentID string,
+		accessorUserID string,
+		role string,
+	) (err error)
+
 	ChangeFollowingState(
 		ctx context.Context,
 		threadID string,
@@ -81,4 +111,59 @@ type ThreadService interface {
 		threadID string,
 		accessorUserID string,
 	) (err error)
+
+	LockThread(
+		ctx context.Context,
+		threadID string,
+		accessorUserID string,
+		role string,
+	) (err error)
+
+	UnlockThread(
+		ctx context.Context,
+		threadID string,
+		accessorUserID string,
+		role string,
+	) (err error)
+
+	PinComment(
+		ctx context.Context,
+		threadID string,
+		commentID string,
+		accessorUserID string,
+		role string,
+	) (err error)
+
+	UnpinComment(
+		ctx context.Context,
+		threadID string,
+		commentID string,
+		accessorUserID string,
+		role string,
+	) (err error)
+
+	MuteUser(
+		ctx context.Context,
+		threadID string,
+		accessorUserID string,
+		role string,
+		p payload.MuteUser,
+	) (err error)
+
+	UnmuteUser(
+		ctx context.Context,
+		threadID string,
+		username string,
+		accessorUserID string,
+		role string,
+	) (err error)
+
+	GetModerationLogs(
+		ctx context.Context,
+		threadID string,
+		accessorUserID string,
+		role string,
+		page uint,
+		limit uint,
+	) (rs response.Pagination[response.ModerationLog], err error)
 }
@@ -2,37 +2,60 @@ package thread
 
 import (
 	"context"
+	"errors"
+	"log"
 	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/category"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/requestctx"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/viewcounter"
 	"gopkg.in/validator.v2"
 )
 
 type threadServiceImpl struct {
-	threadRepository   thread.ThreadRepository
-	categoryRepository category.CategoryRepository
-	userRepository     user.UserRepository
-	idGenerator        generator.IDGenerator
+	threadRepository       thread.ThreadRepository
+	categoryRepository     category.CategoryRepository
+	userRepository         user.UserRepository
+	notificationRepository notification.NotificationRepository
+	idGenerator            generator.IDGenerator
+	hub                    realtime.Hub
+	auditService           audit.AuditService
+	txManager              repository.TxManager
+	viewCounter            viewcounter.ViewCounter
 }
 
 func NewThreadServiceImpl(
 	threadRepository thread.ThreadRepository,
 	categoryRepository category.CategoryRepository,
 	userRepository user.UserRepository,
+	notificationRepository notification.NotificationRepository,
 	idGenerator generator.IDGenerator,
+	hub realtime.Hub,
+	auditService audit.AuditService,
+	txManager repository.TxManager,
+	viewCounter viewcounter.ViewCounter,
 ) *threadServiceImpl {
 	return &threadServiceImpl{
-		threadRepository:   threadRepository,
-		categoryRepository: categoryRepository,
-		userRepository:     userRepository,
-		idGenerator:        idGenerator,
+		threadRepository:       threadRepository,
+		categoryRepository:     categoryRepository,
+		userRepository:         userRepository,
+		notificationRepository: notificationRepository,
+		idGenerator:            idGenerator,
+		hub:                    hub,
+		auditService:           auditService,
+		txManager:              txManager,
+		viewCounter:            viewCounter,
 	}
 }
 
@@ -41,7 +64,10 @@ func (t *threadServiceImpl) GetAll(
 	accessorUserID string,
 	page uint,
 	limit uint,
+	cursor string,
 	query string,
+	sort string,
+	f payload.ThreadFilter,
 ) (rs response.Pagination[response.ManyThread], err error) {
 	if page <= 0 {
 		page = 1
@@ -51,7 +77,32 @@ func (t *threadServiceImpl) GetAll(
 		limit = 10
 	}
 
-	pagination, repoErr := t.threadRepository.FindAllWithQueryAndPagination(ctx, accessorUserID, query, entity.PageInfo{Page: page, Limit: limit})
+	filter, err := service.MapThreadFilter(f)
+	if err != nil {
+		return
+	}
+
+	threadSort := service.MapThreadSort(sort, query)
+
+	threadCursor, err := service.DecodeCursor(cursor)
+	if err != nil {
+		return
+	}
+
+	// The trending score and the relevance of a search can't be resumed from a position.
+	if threadCursor != nil && (threadSort == entity.TrendingSort || query != "") {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	pagination, repoErr := t.threadRepository.FindAllWithQueryAndPagination(
+		ctx,
+		accessorUserID,
+		query,
+		threadSort,
+		filter,
+		entity.PageInfo{Page: page, Limit: limit, Cursor: threadCursor},
+	)
 
 	if repoErr != nil {
 		err = service.MapError(repoErr)
@@ -62,6 +113,8 @@ func (t *threadServiceImpl) GetAll(
 	rs.PageInfo.Page = pagination.PageInfo.Page
 	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
 	rs.PageInfo.Total = pagination.PageInfo.Total
+	rs.NextCursor = service.EncodeCursor(pagination.NextCursor)
+	rs.PrevCursor = service.EncodeCursor(pagination.PrevCursor)
 
 	rs.List = make([]response.ManyThread, len(pagination.List))
 
@@ -83,6 +136,16 @@ func (t *threadServiceImpl) GetAll(
 			CreatorUsername: item.Creator.Username,
 			CreatorName:     item.Creator.Name,
 		}
+
+		if query != "" {
+			thread.Highlight = &response.ThreadHighlight{
+				Title:       item.Highlight.Title,
+				Description: item.Highlight.Description,
+				CommentID:   item.Highlight.CommentID,
+				Comment:     item.Highlight.Comment,
+			}
+		}
+
 		rs.List[i] = thread
 	}
 
@@ -122,27 +185,28 @@ func (t *threadServiceImpl) Create(
 		},
 	}
 
-	if repoErr := t.threadRepository.Insert(ctx, thread); repoErr != nil {
-		err = service.MapError(repoErr)
-		return
-	}
+	// The creator becomes the first moderator, a thread is never stored without it.
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := t.threadRepository.Insert(ctx, thread); repoErr != nil {
+			return repoErr
+		}
 
-	modID, genErr := t.idGenerator.GenerateModeratorID()
-	if genErr != nil {
-		err = service.MapError(genErr)
-		return
-	}
+		modID, genErr := t.idGenerator.GenerateModeratorID()
+		if genErr != nil {
+			return genErr
+		}
 
-	moderator := entity.Moderator{
-		ID: modID,
-		User: entity.User{
-			ID: accessorUserID,
-		},
-		ThreadID: id,
-	}
+		moderator := entity.Moderator{
+			ID: modID,
+			User: entity.User{
+				ID: accessorUserID,
+			},
+			ThreadID: id,
+		}
 
-	if repoErr := t.threadRepository.InsertModerator(ctx, moderator); repoErr != nil {
-		err = service.MapError(repoErr)
+		return t.threadRepository.InsertModerator(ctx, moderator)
+	}); txErr != nil {
+		err = service.MapError(txErr)
 		return
 	}
 
@@ -176,6 +240,8 @@ func (t *threadServiceImpl) GetByID(
 		CreatorID:       thread.Creator.ID,
 		CreatorUsername: thread.Creator.Username,
 		CreatorName:     thread.Creator.Name,
+		IsLocked:        !thread.LockedAt.IsZero(),
+		PinnedCommentID: thread.PinnedCommentID,
 	}
 
 	moderators, repoErr := t.threadRepository.FindAllModeratorByThreadID(ctx, ID)
@@ -184,9 +250,7 @@ func (t *threadServiceImpl) GetByID(
 		return
 	}
 
-	go func(ID string) {
-		_ = t.threadRepository.IncrementTotalViewer(ID)
-	}(ID)
+	t.viewCounter.View(ID, viewerOf(ctx, accessorUserID))
 
 	rs.Moderators = make([]response.Moderator, len(moderators))
 
@@ -258,13 +322,30 @@ func (t *threadServiceImpl) Delete(
 		return
 	}
 
-	if role != "admin" && accessorUserID != thread.Creator.ID {
+	if !service.HasPermission(role, service.PermissionDeleteAnyThread) && accessorUserID != thread.Creator.ID {
 		err = service.ErrAccessForbidden
 		return
 	}
 
-	if repoErr := t.threadRepository.Delete(ctx, ID); repoErr != nil {
-		err = service.MapError(repoErr)
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := t.threadRepository.Delete(ctx, ID); repoErr != nil {
+			return repoErr
+		}
+
+		return t.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.ThreadDeleted,
+			TargetType: audit.ThreadTarget,
+			TargetID:   ID,
+			Before: map[string]any{
+				"title":       thread.Title,
+				"description": thread.Description,
+				"categoryID":  thread.Category.ID,
+				"creatorID":   thread.Creator.ID,
+			},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
 		return
 	}
 
@@ -276,6 +357,9 @@ func (t *threadServiceImpl) GetComments(
 	threadID string,
 	page uint,
 	limit uint,
+	cursor string,
+	view string,
+	depth uint,
 ) (rs response.Pagination[response.Comment], err error) {
 	if page <= 0 {
 		page = 1
@@ -285,38 +369,81 @@ func (t *threadServiceImpl) GetComments(
 		limit = 20
 	}
 
+	var commentView entity.CommentView
+	if view == "tree" {
+		commentView = entity.TreeView
+	} else if view == "slice" {
+		commentView = entity.SliceView
+	} else {
+		commentView = entity.FlatView
+	}
+
 	if _, repoErr := t.threadRepository.FindByID(ctx, "", threadID); repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
+	commentCursor, err := service.DecodeCursor(cursor)
+	if err != nil {
+		return
+	}
+
 	pageInfo := entity.PageInfo{
-		Limit: limit,
-		Page:  page,
+		Limit:  limit,
+		Page:   page,
+		Cursor: commentCursor,
+	}
+
+	var pagination entity.Pagination[entity.Comment]
+	var repoErr error
+
+	if commentView == entity.FlatView {
+		pagination, repoErr = t.threadRepository.FindAllCommentByThreadID(ctx, threadID, pageInfo)
+	} else {
+		pagination, repoErr = t.threadRepository.FindAllRootCommentByThreadID(ctx, threadID, pageInfo)
 	}
 
-	pagination, repoErr := t.threadRepository.FindAllCommentByThreadID(ctx, threadID, pageInfo)
 	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
+	if commentView != entity.FlatView && len(pagination.List) > 0 {
+		rootCommentIDs := make([]string, len(pagination.List))
+		for i, item := range pagination.List {
+			rootCommentIDs[i] = item.ID
+		}
+
+		replies, repoErr := t.threadRepository.FindAllReplyByRootCommentIDs(ctx, rootCommentIDs, depth)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		children := make(map[string][]entity.Comment)
+		for _, reply := range replies {
+			children[reply.ParentID] = append(children[reply.ParentID], reply)
+		}
+
+		for i := range pagination.List {
+			if commentView == entity.TreeView {
+				pagination.List[i].Replies = buildReplyTree(pagination.List[i].ID, children)
+			} else {
+				pagination.List[i].Replies = buildReplySlice(pagination.List[i].ID, children)
+			}
+		}
+	}
+
 	rs.PageInfo.Page = pagination.PageInfo.Page
 	rs.PageInfo.Limit = pagination.PageInfo.Limit
 	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
 	rs.PageInfo.Total = pagination.PageInfo.Total
+	rs.NextCursor = service.EncodeCursor(pagination.NextCursor)
+	rs.PrevCursor = service.EncodeCursor(pagination.PrevCursor)
 	rs.List = make([]response.Comment, len(pagination.List))
 
 	for i, item := range pagination.List {
-		comment := response.Comment{
-			ID:          item.ID,
-			UserID:      item.User.ID,
-			Username:    item.User.Username,
-			Name:        item.User.Name,
-			Comment:     item.Comment,
-			PublishedOn: item.CreatedAt.Format(time.RFC822),
-		}
-		rs.List[i] = comment
+		rs.List[i] = newCommentResponse(item)
 	}
 
 	return
@@ -333,12 +460,16 @@ func (t *threadServiceImpl) CreateComment(
 		return
 	}
 
-	_, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
 	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
+	if err = t.checkCanComment(ctx, thread, accessorUserID); err != nil {
+		return
+	}
+
 	id, genErr := t.idGenerator.GenerateCommentID()
 	if genErr != nil {
 		err = service.MapError(genErr)
@@ -356,11 +487,229 @@ func (t *threadServiceImpl) CreateComment(
 		Comment: p.Comment,
 	}
 
-	if repoErr := t.threadRepository.InsertComment(ctx, comment); repoErr != nil {
+	// The comment and its notifications are stored together, the notifications are pushed once both are committed.
+	var notifications []entity.Notification
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) (txErr error) {
+		if txErr = t.threadRepository.InsertComment(ctx, comment); txErr != nil {
+			return
+		}
+
+		// The comment is read back, so the events carry its author and its creation time like the listed comments.
+		if comment, txErr = t.threadRepository.FindCommentByID(ctx, id); txErr != nil {
+			return
+		}
+
+		if notifications, txErr = t.commentNotifications(ctx, thread, comment, thread.Creator.ID); txErr != nil {
+			return
+		}
+
+		notifications, txErr = t.storeNotifications(ctx, notifications...)
+		return
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	t.publish(ctx, realtime.Event{
+		Topic: realtime.ThreadTopic(threadID),
+		Type:  realtime.CommentCreated,
+		Data:  newCommentResponse(comment),
+	})
+
+	t.publishNotifications(ctx, notifications)
+
+	return
+}
+
+func (t *threadServiceImpl) CreateReply(
+	ctx context.Context,
+	threadID string,
+	commentID string,
+	accessorUserID string,
+	p payload.CreateComment,
+) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if err = t.checkCanComment(ctx, thread, accessorUserID); err != nil {
+		return
+	}
+
+	parent, repoErr := t.threadRepository.FindCommentByID(ctx, commentID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if parent.Thread.ID != threadID || !parent.DeletedAt.IsZero() {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	id, genErr := t.idGenerator.GenerateCommentID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	comment := entity.Comment{
+		ID: id,
+		User: entity.User{
+			ID: accessorUserID,
+		},
+		Thread: entity.Thread{
+			ID: threadID,
+		},
+		ParentID: parent.ID,
+		Comment:  p.Comment,
+	}
+
+	// The comment and its notifications are stored together, the notifications are pushed once both are committed.
+	var notifications []entity.Notification
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) (txErr error) {
+		if txErr = t.threadRepository.InsertComment(ctx, comment); txErr != nil {
+			return
+		}
+
+		// The comment is read back, so the events carry its author and its creation time like the listed comments.
+		if comment, txErr = t.threadRepository.FindCommentByID(ctx, id); txErr != nil {
+			return
+		}
+
+		if notifications, txErr = t.commentNotifications(ctx, thread, comment, thread.Creator.ID, parent.User.ID); txErr != nil {
+			return
+		}
+
+		notifications, txErr = t.storeNotifications(ctx, notifications...)
+		return
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	t.publish(ctx, realtime.Event{
+		Topic: realtime.ThreadTopic(threadID),
+		Type:  realtime.CommentCreated,
+		Data:  newCommentResponse(comment),
+	})
+
+	t.publishNotifications(ctx, notifications)
+
+	return
+}
+
+func (t *threadServiceImpl) UpdateComment(
+	ctx context.Context,
+	threadID string,
+	commentID string,
+	accessorUserID string,
+	p payload.UpdateComment,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	comment, repoErr := t.threadRepository.FindCommentByID(ctx, commentID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if comment.Thread.ID != threadID || !comment.DeletedAt.IsZero() {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	if accessorUserID != comment.User.ID {
+		err = service.ErrAccessForbidden
+		return
+	}
+
+	comment.Comment = p.Comment
+
+	if repoErr := t.threadRepository.UpdateComment(ctx, commentID, comment); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (t *threadServiceImpl) DeleteComment(
+	ctx context.Context,
+	threadID string,
+	commentID string,
+	accessorUserID string,
+	role string,
+) (err error) {
+	comment, repoErr := t.threadRepository.FindCommentByID(ctx, commentID)
+	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
+	if comment.Thread.ID != threadID || !comment.DeletedAt.IsZero() {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	if !service.HasPermission(role, service.PermissionDeleteAnyComment) && accessorUserID != comment.User.ID {
+		moderators, repoErr := t.threadRepository.FindAllModeratorByThreadID(ctx, threadID)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		var isModerator bool
+
+		for _, moderator := range moderators {
+			if accessorUserID == moderator.User.ID {
+				isModerator = true
+				break
+			}
+		}
+
+		if !isModerator {
+			err = service.ErrAccessForbidden
+			return
+		}
+	}
+
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := t.threadRepository.DeleteComment(ctx, commentID); repoErr != nil {
+			return repoErr
+		}
+
+		// Only the comments deleted by the moderators and the staff are audited
+		if accessorUserID == comment.User.ID {
+			return nil
+		}
+
+		return t.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.CommentDeleted,
+			TargetType: audit.CommentTarget,
+			TargetID:   commentID,
+			Before: map[string]any{
+				"threadID": threadID,
+				"userID":   comment.User.ID,
+				"comment":  comment.Comment,
+			},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
 	return
 }
 
@@ -391,18 +740,36 @@ func (t *threadServiceImpl) ChangeFollowingState(
 		},
 	}
 
+	counter := response.ThreadCounter{
+		ThreadID:      threadID,
+		TotalLike:     thread.TotalLike,
+		TotalFollower: thread.TotalFollower,
+	}
+
 	if thread.IsFollowed {
 		if repoErr := t.threadRepository.DeleteFollowThread(ctx, tf); repoErr != nil {
 			err = service.MapError(repoErr)
 			return
 		}
+
+		if counter.TotalFollower > 0 {
+			counter.TotalFollower--
+		}
 	} else {
 		if repoErr := t.threadRepository.InsertFollowThread(ctx, tf); repoErr != nil {
 			err = service.MapError(repoErr)
 			return
 		}
+
+		counter.TotalFollower++
 	}
 
+	t.publish(ctx, realtime.Event{
+		Topic: realtime.ThreadTopic(threadID),
+		Type:  realtime.ThreadCounterChanged,
+		Data:  counter,
+	})
+
 	return
 }
 
@@ -433,16 +800,43 @@ func (t *threadServiceImpl) ChangeLikeState(
 		},
 	}
 
+	counter := response.ThreadCounter{
+		ThreadID:      threadID,
+		TotalLike:     thread.TotalLike,
+		TotalFollower: thread.TotalFollower,
+	}
+
 	if thread.IsLiked {
 		if repoErr := t.threadRepository.DeleteLike(ctx, tl); repoErr != nil {
 			err = service.MapError(repoErr)
 			return
 		}
+
+		if counter.TotalLike > 0 {
+			counter.TotalLike--
+		}
 	} else {
 		if repoErr := t.threadRepository.InsertLike(ctx, tl); repoErr != nil {
 			err = service.MapError(repoErr)
 			return
 		}
+
+		counter.TotalLike++
+	}
+
+	t.publish(ctx, realtime.Event{
+		Topic: realtime.ThreadTopic(threadID),
+		Type:  realtime.ThreadCounterChanged,
+		Data:  counter,
+	})
+
+	if !thread.IsLiked && thread.Creator.ID != accessorUserID {
+		t.notify(ctx, entity.Notification{
+			User:   entity.User{ID: thread.Creator.ID},
+			Actor:  entity.User{ID: accessorUserID},
+			Type:   entity.LikeNotification,
+			Thread: entity.Thread{ID: threadID, Title: thread.Title},
+		})
 	}
 
 	return
@@ -508,11 +902,30 @@ func (t *threadServiceImpl) AddModerator(
 		ThreadID: threadID,
 	}
 
-	if repoErr := t.threadRepository.InsertModerator(ctx, moderator); repoErr != nil {
-		err = service.MapError(repoErr)
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := t.threadRepository.InsertModerator(ctx, moderator); repoErr != nil {
+			return repoErr
+		}
+
+		return t.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.ModeratorAdded,
+			TargetType: audit.ThreadTarget,
+			TargetID:   threadID,
+			After:      map[string]any{"moderatorID": userToAdded.ID, "moderatorUsername": userToAdded.Username},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
 		return
 	}
 
+	t.notify(ctx, entity.Notification{
+		User:   entity.User{ID: userToAdded.ID},
+		Actor:  entity.User{ID: accessorUserID},
+		Type:   entity.ModeratorNotification,
+		Thread: entity.Thread{ID: threadID, Title: thread.Title},
+	})
+
 	return
 }
 
@@ -575,10 +988,579 @@ func (t *threadServiceImpl) RemoveModerator(
 		ThreadID: threadID,
 	}
 
-	if repoErr := t.threadRepository.DeleteModerator(ctx, moderator); repoErr != nil {
-		err = service.MapError(repoErr)
+	if txErr := t.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := t.threadRepository.DeleteModerator(ctx, moderator); repoErr != nil {
+			return repoErr
+		}
+
+		return t.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.ModeratorRemoved,
+			TargetType: audit.ThreadTarget,
+			TargetID:   threadID,
+			Before:     map[string]any{"moderatorID": userToRemoved.ID, "moderatorUsername": userToRemoved.Username},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
 		return
 	}
 
 	return
 }
+
+func (t *threadServiceImpl) LockThread(
+	ctx context.Context,
+	threadID string,
+	accessorUserID string,
+	role string,
+) (err error) {
+	err = t.changeLockState(ctx, threadID, accessorUserID, role, true)
+	return
+}
+
+func (t *threadServiceImpl) UnlockThread(
+	ctx context.Context,
+	threadID string,
+	accessorUserID string,
+	role string,
+) (err error) {
+	err = t.changeLockState(ctx, threadID, accessorUserID, role, false)
+	return
+}
+
+func (t *threadServiceImpl) PinComment(
+	ctx context.Context,
+	threadID string,
+	commentID string,
+	accessorUserID string,
+	role string,
+) (err error) {
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if _, err = t.authorizeThreadModerator(ctx, thread, accessorUserID, role); err != nil {
+		return
+	}
+
+	comment, repoErr := t.threadRepository.FindCommentByID(ctx, commentID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// Only root comments can be pinned
+	if comment.Thread.ID != threadID || !comment.DeletedAt.IsZero() || comment.ParentID != "" {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	if thread.PinnedCommentID == commentID {
+		err = service.ErrDataAlreadyExists
+		return
+	}
+
+	moderationLog, err := t.newModerationLog(threadID, accessorUserID, entity.PinAction)
+	if err != nil {
+		return
+	}
+	moderationLog.Comment.ID = commentID
+
+	if repoErr := t.threadRepository.UpdatePinnedComment(ctx, threadID, commentID, moderationLog); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (t *threadServiceImpl) UnpinComment(
+	ctx context.Context,
+	threadID string,
+	commentID string,
+	accessorUserID string,
+	role string,
+) (err error) {
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if _, err = t.authorizeThreadModerator(ctx, thread, accessorUserID, role); err != nil {
+		return
+	}
+
+	if thread.PinnedCommentID == "" || thread.PinnedCommentID != commentID {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	moderationLog, err := t.newModerationLog(threadID, accessorUserID, entity.UnpinAction)
+	if err != nil {
+		return
+	}
+	moderationLog.Comment.ID = commentID
+
+	if repoErr := t.threadRepository.UpdatePinnedComment(ctx, threadID, "", moderationLog); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (t *threadServiceImpl) MuteUser(
+	ctx context.Context,
+	threadID string,
+	accessorUserID string,
+	role string,
+	p payload.MuteUser,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	moderators, err := t.authorizeThreadModerator(ctx, thread, accessorUserID, role)
+	if err != nil {
+		return
+	}
+
+	userToMuted, repoErr := t.userRepository.FindByUsername(ctx, p.Username)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrUsernameNotFound
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// Neither the thread creator, its moderators nor the staff can be muted
+	if userToMuted.ID == accessorUserID ||
+		userToMuted.ID == thread.Creator.ID ||
+		service.HasPermission(userToMuted.Role, service.PermissionModerateAnyThread) {
+		err = service.ErrAccessForbidden
+		return
+	}
+
+	for _, moderator := range moderators {
+		if moderator.User.ID == userToMuted.ID {
+			err = service.ErrAccessForbidden
+			return
+		}
+	}
+
+	moderationLog, err := t.newModerationLog(threadID, accessorUserID, entity.MuteAction)
+	if err != nil {
+		return
+	}
+	moderationLog.TargetUser.ID = userToMuted.ID
+	moderationLog.Reason = p.Reason
+	moderationLog.ExpiresAt = time.Now().Add(time.Duration(p.Hours) * time.Hour)
+
+	mute := entity.ThreadMute{
+		Thread:    entity.Thread{ID: threadID},
+		User:      entity.User{ID: userToMuted.ID},
+		Moderator: entity.User{ID: accessorUserID},
+		Reason:    p.Reason,
+		ExpiresAt: moderationLog.ExpiresAt,
+	}
+
+	if repoErr := t.threadRepository.UpsertMute(ctx, mute, moderationLog); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (t *threadServiceImpl) UnmuteUser(
+	ctx context.Context,
+	threadID string,
+	username string,
+	accessorUserID string,
+	role string,
+) (err error) {
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if _, err = t.authorizeThreadModerator(ctx, thread, accessorUserID, role); err != nil {
+		return
+	}
+
+	userToUnmuted, repoErr := t.userRepository.FindByUsername(ctx, username)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrUsernameNotFound
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	moderationLog, err := t.newModerationLog(threadID, accessorUserID, entity.UnmuteAction)
+	if err != nil {
+		return
+	}
+	moderationLog.TargetUser.ID = userToUnmuted.ID
+
+	if repoErr := t.threadRepository.DeleteMute(
+		ctx,
+		threadID,
+		userToUnmuted.ID,
+		time.Now(),
+		moderationLog,
+	); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (t *threadServiceImpl) GetModerationLogs(
+	ctx context.Context,
+	threadID string,
+	accessorUserID string,
+	role string,
+	page uint,
+	limit uint,
+) (rs response.Pagination[response.ModerationLog], err error) {
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if _, err = t.authorizeThreadModerator(ctx, thread, accessorUserID, role); err != nil {
+		return
+	}
+
+	pagination, repoErr := t.threadRepository.FindAllModerationLogByThreadID(
+		ctx,
+		threadID,
+		entity.PageInfo{Page: page, Limit: limit},
+	)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs.PageInfo.Page = pagination.PageInfo.Page
+	rs.PageInfo.Limit = pagination.PageInfo.Limit
+	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
+	rs.PageInfo.Total = pagination.PageInfo.Total
+	rs.List = make([]response.ModerationLog, len(pagination.List))
+
+	for i, item := range pagination.List {
+		moderationLog := response.ModerationLog{
+			ID:             item.ID,
+			Action:         item.Action.String(),
+			ActorID:        item.Actor.ID,
+			ActorUsername:  item.Actor.Username,
+			TargetUserID:   item.TargetUser.ID,
+			TargetUsername: item.TargetUser.Username,
+			CommentID:      item.Comment.ID,
+			Reason:         item.Reason,
+			CreatedOn:      item.CreatedAt.Format(time.RFC822),
+		}
+
+		if !item.ExpiresAt.IsZero() {
+			moderationLog.ExpiresOn = item.ExpiresAt.Format(time.RFC822)
+		}
+
+		rs.List[i] = moderationLog
+	}
+
+	return
+}
+
+func (t *threadServiceImpl) changeLockState(
+	ctx context.Context,
+	threadID string,
+	accessorUserID string,
+	role string,
+	locked bool,
+) (err error) {
+	thread, repoErr := t.threadRepository.FindByID(ctx, accessorUserID, threadID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if _, err = t.authorizeThreadModerator(ctx, thread, accessorUserID, role); err != nil {
+		return
+	}
+
+	if locked == !thread.LockedAt.IsZero() {
+		err = service.ErrDataAlreadyExists
+		return
+	}
+
+	action := entity.UnlockAction
+	var lockedAt time.Time
+	if locked {
+		action = entity.LockAction
+		lockedAt = time.Now()
+	}
+
+	moderationLog, err := t.newModerationLog(threadID, accessorUserID, action)
+	if err != nil {
+		return
+	}
+
+	if repoErr := t.threadRepository.UpdateLock(ctx, threadID, lockedAt, moderationLog); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+// authorizeThreadModerator allows the staff, the thread creator and the thread moderators,
+// it returns the thread moderators.
+func (t *threadServiceImpl) authorizeThreadModerator(
+	ctx context.Context,
+	thread entity.Thread,
+	accessorUserID string,
+	role string,
+) (moderators []entity.Moderator, err error) {
+	moderators, repoErr := t.threadRepository.FindAllModeratorByThreadID(ctx, thread.ID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if service.HasPermission(role, service.PermissionModerateAnyThread) || accessorUserID == thread.Creator.ID {
+		return
+	}
+
+	for _, moderator := range moderators {
+		if accessorUserID == moderator.User.ID {
+			return
+		}
+	}
+
+	err = service.ErrAccessForbidden
+	return
+}
+
+func (t *threadServiceImpl) newModerationLog(
+	threadID string,
+	accessorUserID string,
+	action entity.ModerationAction,
+) (moderationLog entity.ModerationLog, err error) {
+	id, genErr := t.idGenerator.GenerateModerationLogID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	moderationLog = entity.ModerationLog{
+		ID:     id,
+		Thread: entity.Thread{ID: threadID},
+		Actor:  entity.User{ID: accessorUserID},
+		Action: action,
+	}
+	return
+}
+
+// checkCanComment fails if the thread is locked or the user is muted in it.
+func (t *threadServiceImpl) checkCanComment(
+	ctx context.Context,
+	thread entity.Thread,
+	accessorUserID string,
+) (err error) {
+	if !thread.LockedAt.IsZero() {
+		err = service.ErrThreadLocked
+		return
+	}
+
+	_, repoErr := t.threadRepository.FindActiveMute(ctx, thread.ID, accessorUserID, time.Now())
+	switch {
+	case repoErr == nil:
+		err = service.ErrUserMuted
+	case errors.Is(repoErr, repository.ErrRecordNotFound):
+	default:
+		err = service.MapError(repoErr)
+	}
+
+	return
+}
+
+// buildReplyTree nests the replies of the given comment recursively.
+func buildReplyTree(commentID string, children map[string][]entity.Comment) (replies []entity.Comment) {
+	replies = make([]entity.Comment, len(children[commentID]))
+	for i, child := range children[commentID] {
+		child.Replies = buildReplyTree(child.ID, children)
+		replies[i] = child
+	}
+	return
+}
+
+// buildReplySlice flattens the replies of the given comment in reading order.
+func buildReplySlice(commentID string, children map[string][]entity.Comment) (replies []entity.Comment) {
+	replies = make([]entity.Comment, 0)
+	for _, child := range children[commentID] {
+		replies = append(replies, child)
+		replies = append(replies, buildReplySlice(child.ID, children)...)
+	}
+	return
+}
+
+func newCommentResponse(comment entity.Comment) (r response.Comment) {
+	r = response.Comment{
+		ID:          comment.ID,
+		UserID:      comment.User.ID,
+		Username:    comment.User.Username,
+		Name:        comment.User.Name,
+		Comment:     comment.Comment,
+		PublishedOn: comment.CreatedAt.Format(time.RFC822),
+		IsEdited:    !comment.EditedAt.IsZero(),
+		IsDeleted:   !comment.DeletedAt.IsZero(),
+		ParentID:    comment.ParentID,
+		IsPinned:    comment.IsPinned,
+	}
+
+	if r.IsEdited {
+		r.EditedOn = comment.EditedAt.Format(time.RFC822)
+	}
+
+	if r.IsDeleted {
+		r.UserID = ""
+		r.Username = ""
+		r.Name = ""
+		r.Comment = ""
+	}
+
+	if comment.Replies != nil {
+		r.Replies = make([]response.Comment, len(comment.Replies))
+		for i, reply := range comment.Replies {
+			r.Replies[i] = newCommentResponse(reply)
+		}
+	}
+
+	return
+}
+
+// commentNotifications returns the notifications of a new comment for the followers of the thread and the given users,
+// except the commenter.
+func (t *threadServiceImpl) commentNotifications(
+	ctx context.Context,
+	thread entity.Thread,
+	comment entity.Comment,
+	userIDs ...string,
+) (notifications []entity.Notification, err error) {
+	followerIDs, repoErr := t.threadRepository.FindAllFollowerIDByThreadID(ctx, thread.ID)
+	if repoErr != nil {
+		err = repoErr
+		return
+	}
+
+	recipients := make(map[string]bool)
+	for _, userID := range append(userIDs, followerIDs...) {
+		if userID == "" || userID == comment.User.ID || recipients[userID] {
+			continue
+		}
+		recipients[userID] = true
+
+		notifications = append(notifications, entity.Notification{
+			User:    entity.User{ID: userID},
+			Actor:   comment.User,
+			Type:    entity.CommentNotification,
+			Thread:  entity.Thread{ID: thread.ID, Title: thread.Title},
+			Comment: entity.Comment{ID: comment.ID},
+		})
+	}
+
+	return
+}
+
+// notify stores the notifications and pushes them to their recipients.
+// A failure is only logged, as it must not fail the action that triggers it.
+func (t *threadServiceImpl) notify(ctx context.Context, notifications ...entity.Notification) {
+	stored, err := t.storeNotifications(ctx, notifications...)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	t.publishNotifications(ctx, stored)
+}
+
+// storeNotifications generates the IDs of the notifications and stores them.
+func (t *threadServiceImpl) storeNotifications(
+	ctx context.Context,
+	notifications ...entity.Notification,
+) (stored []entity.Notification, err error) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	now := time.Now()
+	for i := range notifications {
+		id, genErr := t.idGenerator.GenerateNotificationID()
+		if genErr != nil {
+			err = genErr
+			return
+		}
+		notifications[i].ID = id
+		notifications[i].CreatedAt = now
+	}
+
+	if repoErr := t.notificationRepository.InsertAll(ctx, notifications); repoErr != nil {
+		err = repoErr
+		return
+	}
+
+	stored = notifications
+	return
+}
+
+func (t *threadServiceImpl) publishNotifications(ctx context.Context, notifications []entity.Notification) {
+	for _, notification := range notifications {
+		t.publish(ctx, service.NewNotificationEvent(notification))
+	}
+}
+
+// publish pushes the event to the realtime subscribers. A failure is only logged, as it must not fail the action that triggers it.
+func (t *threadServiceImpl) publish(ctx context.Context, event realtime.Event) {
+	if pubErr := t.hub.Publish(ctx, event); pubErr != nil {
+		log.Println(pubErr)
+	}
+}
+
+// viewerOf identifies the viewer of a thread for the view dedupe, the guests are identified by their IP address.
+// The address is put into ctx by middleware.IPAddress, which never reads it from a header of an untrusted client.
+func viewerOf(ctx context.Context, accessorUserID string) string {
+	if accessorUserID != "" {
+		return "user:" + accessorUserID
+	}
+
+	if ipAddress := requestctx.IPAddressFrom(ctx); ipAddress != "" {
+		return "ip:" + ipAddress
+	}
+
+	return ""
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,10 +13,15 @@ import (
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
 	mcr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/category/mocks"
+	mrp "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/mocks"
+	mnr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification/mocks"
 	mtr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread/mocks"
 	mur "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mas "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit/mocks"
 	mig "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+	mvc "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/viewcounter/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -24,17 +30,25 @@ func TestGetAll(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
 	now := time.Now()
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name                string
 		inputAccessorUserID string
 		inputPage           uint
 		inputLimit          uint
+		inputCursor         string
 		inputQuery          string
+		inputSort           string
+		inputFilter         payload.ThreadFilter
 		expectedError       error
 		expectedPagination  response.Pagination[response.ManyThread]
 		mockBehaviour       func()
@@ -53,11 +67,15 @@ func TestGetAll(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
 						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo,
 					) entity.Pagination[entity.Thread] {
 						return entity.Pagination[entity.Thread]{}
@@ -65,6 +83,8 @@ func TestGetAll(t *testing.T) {
 					func(ctx context.Context,
 						accessorUserID string,
 						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo,
 					) error {
 						return repository.ErrDatabase
@@ -86,11 +106,15 @@ func TestGetAll(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
 						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo,
 					) entity.Pagination[entity.Thread] {
 						return entity.Pagination[entity.Thread]{}
@@ -98,6 +122,8 @@ func TestGetAll(t *testing.T) {
 					func(ctx context.Context,
 						accessorUserID string,
 						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo,
 					) error {
 						return repository.ErrDatabase
@@ -145,11 +171,15 @@ func TestGetAll(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
 					func(ctx context.Context,
 						accessorUserID string,
 						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo,
 					) entity.Pagination[entity.Thread] {
 						return entity.Pagination[entity.Thread]{
@@ -209,6 +239,273 @@ func TestGetAll(t *testing.T) {
 					func(ctx context.Context,
 						accessorUserID string,
 						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return the highlights, when the query is not empty",
+			inputAccessorUserID: "",
+			inputPage:           1,
+			inputLimit:          10,
+			inputQuery:          "\"go routine\" -java",
+			expectedError:       nil,
+			expectedPagination: response.Pagination[response.ManyThread]{
+				List: []response.ManyThread{
+					{
+						ID:              "t-Casfkjx",
+						Title:           "Concurrency",
+						CategoryID:      "g-jMds",
+						CategoryName:    "Tech",
+						PublishedOn:     now.Format(time.RFC822),
+						Description:     "How to use the go routine.",
+						CreatorID:       "d-MDje",
+						CreatorUsername: "erikrio",
+						CreatorName:     "erik",
+						Highlight: &response.ThreadHighlight{
+							Title:       "Concurrency",
+							Description: "How to use the <b>go</b> <b>routine</b>.",
+							CommentID:   "c-abcdefg",
+							Comment:     "A <b>go</b> <b>routine</b> is cheap.",
+						},
+					},
+				},
+				PageInfo: response.PageInfo{
+					Limit:     10,
+					Page:      1,
+					PageTotal: 1,
+					Total:     1,
+				},
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindAllWithQueryAndPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					"\"go routine\" -java",
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(ctx context.Context,
+						accessorUserID string,
+						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) entity.Pagination[entity.Thread] {
+						return entity.Pagination[entity.Thread]{
+							List: []entity.Thread{
+								{
+									ID:          "t-Casfkjx",
+									Title:       "Concurrency",
+									Description: "How to use the go routine.",
+									Creator: entity.User{
+										ID:       "d-MDje",
+										Username: "erikrio",
+										Name:     "erik",
+									},
+									Category: entity.Category{
+										ID:   "g-jMds",
+										Name: "Tech",
+									},
+									Highlight: entity.ThreadHighlight{
+										Rank:        0.6,
+										Title:       "Concurrency",
+										Description: "How to use the <b>go</b> <b>routine</b>.",
+										CommentID:   "c-abcdefg",
+										Comment:     "A <b>go</b> <b>routine</b> is cheap.",
+									},
+									CreatedAt: now,
+								},
+							},
+							PageInfo: entity.PageInfo{
+								Limit:     10,
+								Page:      1,
+								PageTotal: 1,
+								Total:     1,
+							},
+						}
+					},
+					func(ctx context.Context,
+						accessorUserID string,
+						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrInvalidPayload, when the created after filter is not a date",
+			inputAccessorUserID: "",
+			inputPage:           1,
+			inputLimit:          10,
+			inputQuery:          "",
+			inputFilter:         payload.ThreadFilter{CreatedAfter: "yesterday"},
+			expectedError:       service.ErrInvalidPayload,
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should pass the sort and the parsed filter, when sort and filter are given",
+			inputAccessorUserID: "u-abcdef",
+			inputPage:           1,
+			inputLimit:          10,
+			inputQuery:          "",
+			inputSort:           "trending",
+			inputFilter: payload.ThreadFilter{
+				Unanswered:    true,
+				FollowedOnly:  true,
+				CreatedAfter:  "2022-06-01",
+				CreatedBefore: "2022-07-01",
+				CategoryID:    "g-jMds",
+			},
+			expectedError: nil,
+			expectedPagination: response.Pagination[response.ManyThread]{
+				List: []response.ManyThread{},
+				PageInfo: response.PageInfo{
+					Limit: 10,
+					Page:  1,
+				},
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindAllWithQueryAndPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+					"",
+					entity.TrendingSort,
+					entity.ThreadFilter{
+						Unanswered:    true,
+						FollowedOnly:  true,
+						CreatedAfter:  time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+						CreatedBefore: time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC),
+						CategoryID:    "g-jMds",
+					},
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(ctx context.Context,
+						accessorUserID string,
+						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) entity.Pagination[entity.Thread] {
+						return entity.Pagination[entity.Thread]{
+							List:     []entity.Thread{},
+							PageInfo: entity.PageInfo{Limit: 10, Page: 1},
+						}
+					},
+					func(ctx context.Context,
+						accessorUserID string,
+						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:               "it should return service.ErrInvalidPayload, when the cursor is malformed",
+			inputPage:          1,
+			inputLimit:         10,
+			inputCursor:        "not-a-cursor",
+			expectedError:      service.ErrInvalidPayload,
+			expectedPagination: response.Pagination[response.ManyThread]{},
+			mockBehaviour:      func() {},
+		},
+		{
+			name:       "it should return service.ErrInvalidPayload, when the cursor is used with the trending sort",
+			inputPage:  1,
+			inputLimit: 10,
+			inputCursor: service.EncodeCursor(&entity.Cursor{
+				CreatedAt: now,
+				ID:        "d-Casfkj",
+			}),
+			inputSort:          "trending",
+			expectedError:      service.ErrInvalidPayload,
+			expectedPagination: response.Pagination[response.ManyThread]{},
+			mockBehaviour:      func() {},
+		},
+		{
+			name:       "it should pass the cursor and return the next cursor, when the cursor is valid",
+			inputPage:  1,
+			inputLimit: 1,
+			inputCursor: service.EncodeCursor(&entity.Cursor{
+				Key:       243,
+				CreatedAt: now,
+				ID:        "d-Casfkj",
+			}),
+			inputSort:     "liked",
+			expectedError: nil,
+			expectedPagination: response.Pagination[response.ManyThread]{
+				List: []response.ManyThread{
+					{
+						ID:          "d-MDje",
+						Title:       "Technology",
+						PublishedOn: now.Format(time.RFC822),
+						TotalLike:   200,
+					},
+				},
+				PageInfo: response.PageInfo{Limit: 1},
+				NextCursor: service.EncodeCursor(&entity.Cursor{
+					Key:       200,
+					CreatedAt: now,
+					ID:        "d-MDje",
+				}),
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindAllWithQueryAndPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					entity.MostLikedSort,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
+					mock.MatchedBy(func(pageInfo entity.PageInfo) bool {
+						return pageInfo.Cursor != nil && pageInfo.Cursor.ID == "d-Casfkj" && pageInfo.Cursor.Key == 243
+					}),
+				).Return(
+					func(ctx context.Context,
+						accessorUserID string,
+						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) entity.Pagination[entity.Thread] {
+						return entity.Pagination[entity.Thread]{
+							List: []entity.Thread{
+								{
+									ID:        "d-MDje",
+									Title:     "Technology",
+									TotalLike: 200,
+									CreatedAt: now,
+								},
+							},
+							PageInfo: entity.PageInfo{Limit: 1},
+							NextCursor: &entity.Cursor{
+								Key:       200,
+								CreatedAt: now,
+								ID:        "d-MDje",
+							},
+						}
+					},
+					func(ctx context.Context,
+						accessorUserID string,
+						query string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
 						pageInfo entity.PageInfo,
 					) error {
 						return nil
@@ -227,13 +524,18 @@ func TestGetAll(t *testing.T) {
 				testCase.inputAccessorUserID,
 				testCase.inputPage,
 				testCase.inputLimit,
+				testCase.inputCursor,
 				testCase.inputQuery,
+				testCase.inputSort,
+				testCase.inputFilter,
 			)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
 			} else {
 				assert.ElementsMatch(t, pagination.List, testCase.expectedPagination.List)
+				assert.Equal(t, testCase.expectedPagination.NextCursor, pagination.NextCursor)
+				assert.Equal(t, testCase.expectedPagination.PrevCursor, pagination.PrevCursor)
 			}
 		})
 	}
@@ -243,9 +545,14 @@ func TestCreate(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name                string
@@ -583,10 +890,17 @@ func TestGetByID(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
 	now := time.Now()
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	mockViewCounter := &mvc.ViewCounter{}
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), mockViewCounter)
 
 	testCases := []struct {
 		name                string
@@ -765,14 +1079,7 @@ func TestGetByID(t *testing.T) {
 					},
 				).Once()
 
-				mockThreadRepo.On(
-					"IncrementTotalViewer",
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ID string) error {
-						return nil
-					},
-				).Once()
+				mockViewCounter.On("View", "t-123", "user:u-123").Return().Once()
 			},
 		},
 	}
@@ -794,15 +1101,22 @@ func TestGetByID(t *testing.T) {
 			}
 		})
 	}
+
+	mockViewCounter.AssertExpectations(t)
 }
 
 func TestUpdate(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name                string
@@ -1049,9 +1363,14 @@ func TestDelete(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name                string
@@ -1186,16 +1505,23 @@ func TestGetComments(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
 	now := time.Now()
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name               string
 		inputThreadID      string
 		inputPage          uint
 		inputLimit         uint
+		inputView          string
+		inputDepth         uint
 		expectedError      error
 		expectedPagination response.Pagination[response.Comment]
 		mockBehaviour      func()
@@ -1319,56 +1645,49 @@ func TestGetComments(t *testing.T) {
 				).Once()
 			},
 		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.mockBehaviour()
-
-			pagination, err := threadService.GetComments(context.Background(), testCase.inputThreadID, testCase.inputPage, testCase.inputLimit)
-
-			if testCase.expectedError != nil {
-				assert.ErrorIs(t, err, testCase.expectedError)
-			} else {
-				assert.ElementsMatch(t, pagination.List, testCase.expectedPagination.List)
-				assert.Equal(t, pagination.PageInfo, testCase.expectedPagination.PageInfo)
-			}
-		})
-	}
-}
-
-func TestCreateComment(t *testing.T) {
-	mockThreadRepo := &mtr.ThreadRepository{}
-	mockCategoryRepo := &mcr.CategoryRepository{}
-	mockUserRepo := &mur.UserRepository{}
-	mockIDGen := &mig.IDGenerator{}
-
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
-
-	testCases := []struct {
-		name                string
-		inputThreadID       string
-		inputAccessorUserID string
-		expectedError       error
-		inputPayload        payload.CreateComment
-		expectedID          string
-		mockBehaviour       func()
-	}{
-		{
-			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrInvalidPayload,
-			inputPayload:        payload.CreateComment{},
-			mockBehaviour:       func() {},
-		},
 		{
-			name:                "it should return service.ErrRepository, repository.ErrDatabase return an error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.CreateComment{
-				Comment: "nice",
+			name:          "it should return root comments with nested replies, when view is tree",
+			inputThreadID: "t-123",
+			inputPage:     1,
+			inputLimit:    3,
+			inputView:     "tree",
+			inputDepth:    0,
+			expectedError: nil,
+			expectedPagination: response.Pagination[response.Comment]{
+				List: []response.Comment{
+					{
+						ID:          "c-123",
+						UserID:      "u-123",
+						Username:    "someone",
+						Name:        "Jane Doe",
+						Comment:     "Jane Doe commented",
+						PublishedOn: now.Format(time.RFC822),
+						Replies: []response.Comment{
+							{
+								ID:          "c-456",
+								UserID:      "u-456",
+								Username:    "another",
+								Name:        "John Doe",
+								Comment:     "John Doe replied",
+								PublishedOn: now.Format(time.RFC822),
+								ParentID:    "c-123",
+								Replies: []response.Comment{
+									{
+										ID:          "c-789",
+										UserID:      "u-123",
+										Username:    "someone",
+										Name:        "Jane Doe",
+										Comment:     "Jane Doe replied back",
+										PublishedOn: now.Format(time.RFC822),
+										ParentID:    "c-456",
+										Replies:     []response.Comment{},
+									},
+								},
+							},
+						},
+					},
+				},
+				PageInfo: response.PageInfo{Limit: 3, Page: 1, PageTotal: 1, Total: 1},
 			},
 			mockBehaviour: func() {
 				mockThreadRepo.On(
@@ -1381,66 +1700,106 @@ func TestCreateComment(t *testing.T) {
 						return entity.Thread{}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
-						return repository.ErrDatabase
+						return nil
 					},
 				).Once()
-			},
-		},
-		{
-			name:                "it should return service.ErrRepository, when Comment ID Generator return an error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.CreateComment{
-				Comment: "nice",
-			},
-			mockBehaviour: func() {
-				mockIDGen.On(
-					"GenerateCommentID",
-				).Return(
-					func() string {
-						return ""
-					},
-					func() error {
-						return errors.New("failed to generate comment id")
+
+				mockThreadRepo.On(
+					"FindAllRootCommentByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) entity.Pagination[entity.Comment] {
+						return entity.Pagination[entity.Comment]{
+							List: []entity.Comment{
+								{
+									ID:        "c-123",
+									User:      entity.User{ID: "u-123", Username: "someone", Name: "Jane Doe"},
+									Comment:   "Jane Doe commented",
+									CreatedAt: now,
+								},
+							},
+							PageInfo: entity.PageInfo{Limit: 3, Page: 1, PageTotal: 1, Total: 1},
+						}
+					},
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) error {
+						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"FindByID",
+					"FindAllReplyByRootCommentIDs",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					[]string{"c-123"},
+					uint(0),
 				).Return(
-					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
+					func(ctx context.Context, rootCommentIDs []string, depth uint) []entity.Comment {
+						return []entity.Comment{
+							{
+								ID:        "c-456",
+								User:      entity.User{ID: "u-456", Username: "another", Name: "John Doe"},
+								ParentID:  "c-123",
+								Comment:   "John Doe replied",
+								CreatedAt: now,
+							},
+							{
+								ID:        "c-789",
+								User:      entity.User{ID: "u-123", Username: "someone", Name: "Jane Doe"},
+								ParentID:  "c-456",
+								Comment:   "Jane Doe replied back",
+								CreatedAt: now,
+							},
+						}
 					},
-					func(ctx context.Context, accessorUserID string, ID string) error {
+					func(ctx context.Context, rootCommentIDs []string, depth uint) error {
 						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.CreateComment{
-				Comment: "nice",
+			name:          "it should return root comments with flattened replies, when view is slice",
+			inputThreadID: "t-123",
+			inputPage:     1,
+			inputLimit:    3,
+			inputView:     "slice",
+			inputDepth:    2,
+			expectedError: nil,
+			expectedPagination: response.Pagination[response.Comment]{
+				List: []response.Comment{
+					{
+						ID:          "c-123",
+						UserID:      "u-123",
+						Username:    "someone",
+						Name:        "Jane Doe",
+						Comment:     "Jane Doe commented",
+						PublishedOn: now.Format(time.RFC822),
+						Replies: []response.Comment{
+							{
+								ID:          "c-456",
+								UserID:      "u-456",
+								Username:    "another",
+								Name:        "John Doe",
+								Comment:     "John Doe replied",
+								PublishedOn: now.Format(time.RFC822),
+								ParentID:    "c-123",
+							},
+							{
+								ID:          "c-789",
+								UserID:      "u-123",
+								Username:    "someone",
+								Name:        "Jane Doe",
+								Comment:     "Jane Doe replied back",
+								PublishedOn: now.Format(time.RFC822),
+								ParentID:    "c-456",
+							},
+						},
+					},
+				},
+				PageInfo: response.PageInfo{Limit: 3, Page: 1, PageTotal: 1, Total: 1},
 			},
 			mockBehaviour: func() {
-				mockIDGen.On(
-					"GenerateCommentID",
-				).Return(
-					func() string {
-						return "X-aBc"
-					},
-					func() error {
-						return nil
-					},
-				).Once()
-
 				mockThreadRepo.On(
 					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -1456,56 +1815,54 @@ func TestCreateComment(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertComment",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
-				).Return(
-					func(ctx context.Context, comment entity.Comment) error {
-						return repository.ErrDatabase
-					},
-				).Once()
-			},
-		},
-		{
-			name:                "it should be valid id, when repository return nil error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       nil,
-			inputPayload: payload.CreateComment{
-				Comment: "nice",
-			},
-			mockBehaviour: func() {
-				mockIDGen.On(
-					"GenerateCommentID",
-				).Return(
-					func() string {
-						return ""
-					},
-					func() error {
-						return nil
-					},
-				).Once()
-
-				mockThreadRepo.On(
-					"FindByID",
+					"FindAllRootCommentByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
-					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) entity.Pagination[entity.Comment] {
+						return entity.Pagination[entity.Comment]{
+							List: []entity.Comment{
+								{
+									ID:        "c-123",
+									User:      entity.User{ID: "u-123", Username: "someone", Name: "Jane Doe"},
+									Comment:   "Jane Doe commented",
+									CreatedAt: now,
+								},
+							},
+							PageInfo: entity.PageInfo{Limit: 3, Page: 1, PageTotal: 1, Total: 1},
+						}
 					},
-					func(ctx context.Context, accessorUserID string, ID string) error {
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) error {
 						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertComment",
+					"FindAllReplyByRootCommentIDs",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
+					[]string{"c-123"},
+					uint(2),
 				).Return(
-					func(ctx context.Context, comment entity.Comment) error {
+					func(ctx context.Context, rootCommentIDs []string, depth uint) []entity.Comment {
+						return []entity.Comment{
+							{
+								ID:        "c-456",
+								User:      entity.User{ID: "u-456", Username: "another", Name: "John Doe"},
+								ParentID:  "c-123",
+								Comment:   "John Doe replied",
+								CreatedAt: now,
+							},
+							{
+								ID:        "c-789",
+								User:      entity.User{ID: "u-123", Username: "someone", Name: "Jane Doe"},
+								ParentID:  "c-456",
+								Comment:   "Jane Doe replied back",
+								CreatedAt: now,
+							},
+						}
+					},
+					func(ctx context.Context, rootCommentIDs []string, depth uint) error {
 						return nil
 					},
 				).Once()
@@ -1517,38 +1874,64 @@ func TestCreateComment(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			id, err := threadService.CreateComment(context.Background(), testCase.inputThreadID, testCase.inputAccessorUserID, testCase.inputPayload)
+			pagination, err := threadService.GetComments(context.Background(), testCase.inputThreadID, testCase.inputPage, testCase.inputLimit, "", testCase.inputView, testCase.inputDepth)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
 			} else {
-				assert.Equal(t, testCase.expectedID, id)
+				assert.ElementsMatch(t, pagination.List, testCase.expectedPagination.List)
+				assert.Equal(t, pagination.PageInfo, testCase.expectedPagination.PageInfo)
 			}
 		})
 	}
 }
 
-func TestChangeFollowingState(t *testing.T) {
+func TestCreateComment(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
-	now := time.Now()
+	hub := realtime.NewMemoryHub()
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name                string
 		inputThreadID       string
 		inputAccessorUserID string
 		expectedError       error
+		inputPayload        payload.CreateComment
+		expectedID          string
 		mockBehaviour       func()
 	}{
 		{
-			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrInvalidPayload,
+			inputPayload:        payload.CreateComment{},
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should return service.ErrInvalidPayload, when comment is longer than 1000 characters",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrInvalidPayload,
+			inputPayload:        payload.CreateComment{Comment: strings.Repeat("<", 1001)},
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should return service.ErrRepository, repository.ErrDatabase return an error",
 			inputThreadID:       "",
 			inputAccessorUserID: "",
 			expectedError:       service.ErrRepository,
+			inputPayload: payload.CreateComment{
+				Comment: "nice",
+			},
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -1566,13 +1949,16 @@ func TestChangeFollowingState(t *testing.T) {
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when Thread Follow ID Generator return an error",
+			name:                "it should return service.ErrRepository, when Comment ID Generator return an error",
 			inputThreadID:       "",
 			inputAccessorUserID: "",
 			expectedError:       service.ErrRepository,
+			inputPayload: payload.CreateComment{
+				Comment: "nice",
+			},
 			mockBehaviour: func() {
 				mockIDGen.On(
-					"GenerateThreadFollowID",
+					"GenerateCommentID",
 				).Return(
 					func() string {
 						return ""
@@ -1595,19 +1981,37 @@ func TestChangeFollowingState(t *testing.T) {
 						return nil
 					},
 				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error.",
+			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
 			inputThreadID:       "",
 			inputAccessorUserID: "",
 			expectedError:       service.ErrRepository,
+			inputPayload: payload.CreateComment{
+				Comment: "nice",
+			},
 			mockBehaviour: func() {
 				mockIDGen.On(
-					"GenerateThreadFollowID",
+					"GenerateCommentID",
 				).Return(
 					func() string {
-						return "x-abc"
+						return "X-aBc"
 					},
 					func() error {
 						return nil
@@ -1621,19 +2025,7 @@ func TestChangeFollowingState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{
-							ID:            "d-Casfkj",
-							Title:         "Technology",
-							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
-							TotalViewer:   320,
-							TotalLike:     243,
-							TotalFollower: 674,
-							TotalComment:  23,
-							IsLiked:       false,
-							IsFollowed:    true,
-							CreatedAt:     now,
-							UpdatedAt:     now,
-						}
+						return entity.Thread{}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -1641,37 +2033,45 @@ func TestChangeFollowingState(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteFollowThread",
+					"FindActiveMute",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
 				).Return(
-					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertFollowThread",
+					"InsertComment",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
 				).Return(
-					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+					func(ctx context.Context, comment entity.Comment) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error.",
+			name:                "it should be valid id, when repository return nil error",
 			inputThreadID:       "",
 			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
+			expectedError:       nil,
+			inputPayload: payload.CreateComment{
+				Comment: "nice",
+			},
 			mockBehaviour: func() {
 				mockIDGen.On(
-					"GenerateThreadFollowID",
+					"GenerateCommentID",
 				).Return(
 					func() string {
-						return "x-abc"
+						return ""
 					},
 					func() error {
 						return nil
@@ -1693,43 +2093,2935 @@ func TestChangeFollowingState(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteFollowThread",
+					"FindActiveMute",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
 				).Return(
-					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
-						return nil
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertFollowThread",
+					"InsertComment",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
 				).Return(
-					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, comment entity.Comment) error {
+						return nil
 					},
 				).Once()
-			},
-		},
-		{
-			name:                "it should return nil error, when no error is returned.",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       nil,
-			mockBehaviour: func() {
-				mockIDGen.On(
-					"GenerateThreadFollowID",
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func() string {
-						return "x-abc"
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: ID, User: entity.User{ID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan"}}
 					},
-					func() error {
+					func(ctx context.Context, ID string) error {
 						return nil
 					},
 				).Once()
 
+				mockThreadRepo.On(
+					"FindAllFollowerIDByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []string {
+						return []string{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should notify the creator and the followers except the commenter, when repository return nil error",
+			inputThreadID:       "t-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			expectedID:          "c-abcdefg",
+			expectedError:       nil,
+			inputPayload: payload.CreateComment{
+				Comment: "nice",
+			},
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateCommentID",
+				).Return(
+					func() string {
+						return "c-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-abcdefg", Creator: entity.User{ID: "u-ghijkl"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
+				).Return(
+					func(ctx context.Context, comment entity.Comment) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: ID, User: entity.User{ID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllFollowerIDByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"t-abcdefg",
+				).Return(
+					func(ctx context.Context, threadID string) []string {
+						return []string{"u-abcdef", "u-ghijkl", "u-mnopqr"}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateNotificationID",
+				).Return(
+					func() string {
+						return "n-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Twice()
+
+				mockNotificationRepo.On(
+					"InsertAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(notifications []entity.Notification) bool {
+						return len(notifications) == 2 &&
+							notifications[0].User.ID == "u-ghijkl" &&
+							notifications[1].User.ID == "u-mnopqr" &&
+							notifications[0].Actor.ID == "u-abcdef" &&
+							notifications[0].Type == entity.CommentNotification &&
+							notifications[0].Comment.ID == "c-abcdefg"
+					}),
+				).Return(
+					func(ctx context.Context, notifications []entity.Notification) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when storing the notifications return an error",
+			inputThreadID:       "t-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.CreateComment{
+				Comment: "nice",
+			},
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateCommentID",
+				).Return(
+					func() string {
+						return "c-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-abcdefg", Creator: entity.User{ID: "u-ghijkl"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
+				).Return(
+					func(ctx context.Context, comment entity.Comment) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: ID, User: entity.User{ID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllFollowerIDByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"t-abcdefg",
+				).Return(
+					func(ctx context.Context, threadID string) []string {
+						return []string{"u-abcdef", "u-ghijkl", "u-mnopqr"}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateNotificationID",
+				).Return(
+					func() string {
+						return "n-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Twice()
+
+				mockNotificationRepo.On(
+					"InsertAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", []entity.Notification{})),
+				).Return(
+					func(ctx context.Context, notifications []entity.Notification) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			id, err := threadService.CreateComment(context.Background(), testCase.inputThreadID, testCase.inputAccessorUserID, testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.Equal(t, testCase.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestCreateReply(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputCommentID      string
+		inputAccessorUserID string
+		inputPayload        payload.CreateComment
+		expectedID          string
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.CreateComment{},
+			expectedError:       service.ErrInvalidPayload,
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment is not found",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.CreateComment{Comment: "nice"},
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-abcdefg"}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{}
+					},
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment belongs to another thread",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.CreateComment{Comment: "nice"},
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-abcdefg"}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", Thread: entity.Thread{ID: "t-hijklmn"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return valid id, when repository return nil error",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.CreateComment{Comment: "nice"},
+			expectedID:          "c-hijklmn",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-abcdefg"}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateCommentID",
+				).Return(
+					func() string {
+						return "c-hijklmn"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(comment entity.Comment) bool {
+						return comment.ParentID == "c-abcdefg"
+					}),
+				).Return(
+					func(ctx context.Context, comment entity.Comment) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: ID, User: entity.User{ID: "u-abcdef", Username: "erikrios", Name: "Erik Rio Setiawan"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllFollowerIDByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []string {
+						return []string{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			id, err := threadService.CreateReply(
+				context.Background(),
+				testCase.inputThreadID,
+				testCase.inputCommentID,
+				testCase.inputAccessorUserID,
+				testCase.inputPayload,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestUpdateComment(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputCommentID      string
+		inputAccessorUserID string
+		inputPayload        payload.UpdateComment
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.UpdateComment{},
+			expectedError:       service.ErrInvalidPayload,
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment is not found",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.UpdateComment{Comment: "edited"},
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{}
+					},
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment belongs to another thread",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.UpdateComment{Comment: "edited"},
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-hijklmn"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment is already deleted",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.UpdateComment{Comment: "edited"},
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}, DeletedAt: time.Now()}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when the accessor is not the author",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-hijklm",
+			inputPayload:        payload.UpdateComment{Comment: "edited"},
+			expectedError:       service.ErrAccessForbidden,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when repository return an error",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.UpdateComment{Comment: "edited"},
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdateComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
+				).Return(
+					func(ctx context.Context, ID string, comment entity.Comment) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when repository return nil error",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputPayload:        payload.UpdateComment{Comment: "edited"},
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdateComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Comment{})),
+				).Return(
+					func(ctx context.Context, ID string, comment entity.Comment) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.UpdateComment(
+				context.Background(),
+				testCase.inputThreadID,
+				testCase.inputCommentID,
+				testCase.inputAccessorUserID,
+				testCase.inputPayload,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputCommentID      string
+		inputAccessorUserID string
+		inputRole           string
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment is not found",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputRole:           "user",
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{}
+					},
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrDataNotFound, when the comment is already deleted",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputRole:           "user",
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}, DeletedAt: time.Now()}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when the accessor is not the author, moderator, nor admin",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-hijklm",
+			inputRole:           "user",
+			expectedError:       service.ErrAccessForbidden,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{ID: "m-abcd", User: entity.User{ID: "u-opqrst"}}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when the accessor is the author",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-abcdef",
+			inputRole:           "user",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when the accessor is the thread moderator",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-hijklm",
+			inputRole:           "user",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{ID: "m-abcd", User: entity.User{ID: "u-hijklm"}}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when the accessor is admin",
+			inputThreadID:       "t-abcdefg",
+			inputCommentID:      "c-abcdefg",
+			inputAccessorUserID: "u-hijklm",
+			inputRole:           "admin",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-abcdefg", User: entity.User{ID: "u-abcdef"}, Thread: entity.Thread{ID: "t-abcdefg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.DeleteComment(
+				context.Background(),
+				testCase.inputThreadID,
+				testCase.inputCommentID,
+				testCase.inputAccessorUserID,
+				testCase.inputRole,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChangeFollowingState(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+	now := time.Now()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputAccessorUserID string
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Thread Follow ID Generator return an error",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateThreadFollowID",
+				).Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("failed to generate comment id")
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error.",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateThreadFollowID",
+				).Return(
+					func() string {
+						return "x-abc"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{
+							ID:            "d-Casfkj",
+							Title:         "Technology",
+							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
+							TotalViewer:   320,
+							TotalLike:     243,
+							TotalFollower: 674,
+							TotalComment:  23,
+							IsLiked:       false,
+							IsFollowed:    true,
+							CreatedAt:     now,
+							UpdatedAt:     now,
+						}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteFollowThread",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+				).Return(
+					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertFollowThread",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+				).Return(
+					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error.",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateThreadFollowID",
+				).Return(
+					func() string {
+						return "x-abc"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteFollowThread",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+				).Return(
+					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertFollowThread",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+				).Return(
+					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when no error is returned.",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateThreadFollowID",
+				).Return(
+					func() string {
+						return "x-abc"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{
+							ID:            "d-Casfkj",
+							Title:         "Technology",
+							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
+							TotalViewer:   320,
+							TotalLike:     243,
+							TotalFollower: 674,
+							TotalComment:  23,
+							IsLiked:       false,
+							IsFollowed:    true,
+							CreatedAt:     now,
+							UpdatedAt:     now,
+						}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteFollowThread",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+				).Return(
+					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertFollowThread",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+				).Return(
+					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.ChangeFollowingState(context.Background(), testCase.inputThreadID, testCase.inputAccessorUserID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChangeLikeState(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+	now := time.Now()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputAccessorUserID string
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Thread Follow ID Generator return an error",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateLikeID",
+				).Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("failed to generate comment id")
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateLikeID",
+				).Return(
+					func() string {
+						return "P-sk8d"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{
+							ID:            "d-Casfkj",
+							Title:         "Technology",
+							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
+							TotalViewer:   320,
+							TotalLike:     243,
+							TotalFollower: 674,
+							TotalComment:  23,
+							IsLiked:       true,
+							IsFollowed:    true,
+							CreatedAt:     now,
+							UpdatedAt:     now,
+						}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteLike",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+				).Return(
+					func(ctx context.Context, like entity.Like) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertLike",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+				).Return(
+					func(ctx context.Context, like entity.Like) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateLikeID",
+				).Return(
+					func() string {
+						return "P-sk8d"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteLike",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+				).Return(
+					func(ctx context.Context, like entity.Like) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertLike",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+				).Return(
+					func(ctx context.Context, like entity.Like) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when no error is returned.",
+			inputThreadID:       "",
+			inputAccessorUserID: "",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockIDGen.On(
+					"GenerateLikeID",
+				).Return(
+					func() string {
+						return "P-sk8d"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{
+							ID:            "d-Casfkj",
+							Title:         "Technology",
+							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
+							TotalViewer:   320,
+							TotalLike:     243,
+							TotalFollower: 674,
+							TotalComment:  23,
+							IsLiked:       true,
+							IsFollowed:    true,
+							CreatedAt:     now,
+							UpdatedAt:     now,
+						}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteLike",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+				).Return(
+					func(ctx context.Context, like entity.Like) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertLike",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+				).Return(
+					func(ctx context.Context, like entity.Like) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.ChangeLikeState(context.Background(), testCase.inputThreadID, testCase.inputAccessorUserID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("it should publish the new like total, when the thread is liked", func(t *testing.T) {
+		mockThreadRepo := &mtr.ThreadRepository{}
+		mockIDGen := &mig.IDGenerator{}
+		hub := realtime.NewMemoryHub()
+
+		var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+		mockThreadRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+			"t-abcdefg",
+		).Return(
+			func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+				return entity.Thread{
+					ID:            "t-abcdefg",
+					Creator:       entity.User{ID: "u-abcdef"},
+					TotalLike:     243,
+					TotalFollower: 674,
+					IsLiked:       false,
+				}
+			},
+			func(ctx context.Context, accessorUserID string, ID string) error {
+				return nil
+			},
+		).Once()
+
+		mockIDGen.On(
+			"GenerateLikeID",
+		).Return(
+			func() string {
+				return "P-sk8d"
+			},
+			func() error {
+				return nil
+			},
+		).Once()
+
+		mockThreadRepo.On(
+			"InsertLike",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+		).Return(
+			func(ctx context.Context, like entity.Like) error {
+				return nil
+			},
+		).Once()
+
+		events, unsubscribe := hub.Subscribe(realtime.ThreadTopic("t-abcdefg"))
+		defer unsubscribe()
+
+		err := threadService.ChangeLikeState(context.Background(), "t-abcdefg", "u-abcdef")
+		assert.NoError(t, err)
+
+		select {
+		case event := <-events:
+			assert.Equal(t, realtime.ThreadCounterChanged, event.Type)
+			assert.Equal(t, response.ThreadCounter{ThreadID: "t-abcdefg", TotalLike: 244, TotalFollower: 674}, event.Data)
+		default:
+			assert.Fail(t, "the like total is not published")
+		}
+	})
+}
+
+func TestAddModerator(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputAccessorUserID string
+		expectedError       error
+		expectedID          string
+		inputPayload        payload.AddRemoveModerator
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrInvalidPayload,
+			inputPayload:        payload.AddRemoveModerator{},
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase error",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrRepository,
+			expectedID:          "",
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when accessor user ID is not thread creator ID",
+			inputThreadID:       "U-abcd",
+			inputAccessorUserID: "b-123",
+			expectedError:       service.ErrAccessForbidden,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "b-124"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase error",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when user repository return a repository.ErrDatabase error",
+			inputThreadID:       "t-xyz",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, userName string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when accessorUserID is userToAddID",
+			inputThreadID:       "t-xyz",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrAccessForbidden,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-1243"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrDataAlreadyExists, when mod.User.ID is userToAdded.ID",
+			inputThreadID:       "t-xyz",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrDataAlreadyExists,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{
+							{
+								User: entity.User{ID: "u-1244"},
+							},
+						}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-1244"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when generate ID return an error",
+			inputThreadID:       "t-xyz",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{
+							{
+								User: entity.User{ID: "u-1244"},
+							},
+						}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-1245"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateModeratorID").Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("Something went wrong.")
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when insert moderator return repository.ErrDatabase error",
+			inputThreadID:       "t-xyz",
+			inputAccessorUserID: "u-1243",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{
+							{
+								User: entity.User{ID: "u-1244"},
+							},
+						}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-1245"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateModeratorID").Return(
+					func() string {
+						return "m-123"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertModerator",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
+				).Return(
+					func(ctx context.Context, moderator entity.Moderator) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return valid ID, when no error is returned",
+			inputThreadID:       "t-xyz",
+			inputAccessorUserID: "u-1243",
+			expectedError:       nil,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{
+							{
+								User: entity.User{ID: "u-1244"},
+							},
+						}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-1245"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateModeratorID").Return(
+					func() string {
+						return "m-123"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"InsertModerator",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
+				).Return(
+					func(ctx context.Context, moderator entity.Moderator) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateNotificationID",
+				).Return(
+					func() string {
+						return "n-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockNotificationRepo.On(
+					"InsertAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(notifications []entity.Notification) bool {
+						return len(notifications) == 1 &&
+							notifications[0].Type == entity.ModeratorNotification &&
+							notifications[0].User.ID == "u-1245"
+					}),
+				).Return(
+					func(ctx context.Context, notifications []entity.Notification) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.AddModerator(context.Background(), testCase.inputPayload, testCase.inputThreadID, testCase.inputAccessorUserID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRemoveModerator(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputThreadID       string
+		inputAccessorUserID string
+		expectedError       error
+		inputPayload        payload.AddRemoveModerator
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrInvalidPayload,
+			inputPayload:        payload.AddRemoveModerator{},
+			mockBehaviour:       func() {},
+		},
+		{
+			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase error",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "tomo12",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when accessor user ID is not thread creator ID",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrAccessForbidden,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcdd"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when user repository return a repository.ErrDatabase",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, userName string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when accessor want to remove itself",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrAccessForbidden,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-abcde"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrUsernameNotFound, when user is not moderator",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrUsernameNotFound,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{
+							User: entity.User{
+								ID: "u-abcde",
+							},
+						}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-abcdf"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when delete moderator return repository.ErrDatabase error",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       service.ErrRepository,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{
+							User: entity.User{
+								ID: "u-abcdf",
+							},
+						}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-abcdf"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteModerator",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
+				).Return(
+					func(ctx context.Context, moderator entity.Moderator) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when no error is returned",
+			inputThreadID:       "t-123",
+			inputAccessorUserID: "u-abcde",
+			expectedError:       nil,
+			inputPayload: payload.AddRemoveModerator{
+				Username: "Jody",
+			},
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{
+							User: entity.User{
+								ID: "u-abcdf",
+							},
+						}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, userName string) entity.User {
+						return entity.User{ID: "u-abcdf"}
+					},
+					func(ctx context.Context, userName string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteModerator",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
+				).Return(
+					func(ctx context.Context, moderator entity.Moderator) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.RemoveModerator(context.Background(), testCase.inputPayload, testCase.inputThreadID, testCase.inputAccessorUserID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateCommentModeration(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputAccessorUserID string
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrThreadLocked, when the thread is locked",
+			inputAccessorUserID: "u-member",
+			expectedError:       service.ErrThreadLocked,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}, LockedAt: time.Now()}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrUserMuted, when the user is muted in the thread",
+			inputAccessorUserID: "u-member",
+			expectedError:       service.ErrUserMuted,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error while finding the mute",
+			inputAccessorUserID: "u-member",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindActiveMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time) entity.ThreadMute {
+						return entity.ThreadMute{}
+					},
+					func(ctx context.Context, threadID string, userID string, now time.Time) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			_, err := threadService.CreateComment(context.Background(), "t-aBcDeFg", testCase.inputAccessorUserID, payload.CreateComment{Comment: "nice"})
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLockThread(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputAccessorUserID string
+		inputRole           string
+		expectedError       error
+		mockBehaviour       func()
+	}{
+		{
+			name:                "it should return service.ErrDataNotFound, when the thread is not found",
+			inputAccessorUserID: "u-member",
+			inputRole:           "user",
+			expectedError:       service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrAccessForbidden, when the accessor is not a moderator of the thread",
+			inputAccessorUserID: "u-member",
+			inputRole:           "user",
+			expectedError:       service.ErrAccessForbidden,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{User: entity.User{ID: "u-moderator"}}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrDataAlreadyExists, when the thread is already locked",
+			inputAccessorUserID: "u-creator",
+			inputRole:           "user",
+			expectedError:       service.ErrDataAlreadyExists,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}, LockedAt: time.Now()}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when Moderation Log ID Generator return an error",
+			inputAccessorUserID: "u-creator",
+			inputRole:           "user",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("failed to generate moderation log id")
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputAccessorUserID: "u-moderator",
+			inputRole:           "user",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{User: entity.User{ID: "u-moderator"}}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdateLock",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, ID string, lockedAt time.Time, moderationLog entity.ModerationLog) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when the thread moderator locks the thread",
+			inputAccessorUserID: "u-moderator",
+			inputRole:           "user",
+			expectedError:       nil,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{{User: entity.User{ID: "u-moderator"}}}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdateLock",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, ID string, lockedAt time.Time, moderationLog entity.ModerationLog) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when the global moderator locks the thread",
+			inputAccessorUserID: "u-staff",
+			inputRole:           "global_moderator",
+			expectedError:       nil,
+			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -1737,19 +5029,7 @@ func TestChangeFollowingState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{
-							ID:            "d-Casfkj",
-							Title:         "Technology",
-							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
-							TotalViewer:   320,
-							TotalLike:     243,
-							TotalFollower: 674,
-							TotalComment:  23,
-							IsLiked:       false,
-							IsFollowed:    true,
-							CreatedAt:     now,
-							UpdatedAt:     now,
-						}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -1757,21 +5037,37 @@ func TestChangeFollowingState(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteFollowThread",
+					"FindAllModeratorByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
 						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertFollowThread",
+					"UpdateLock",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFollow{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
 				).Return(
-					func(ctx context.Context, threadFollow entity.ThreadFollow) error {
+					func(ctx context.Context, ID string, lockedAt time.Time, moderationLog entity.ModerationLog) error {
 						return nil
 					},
 				).Once()
@@ -1783,7 +5079,7 @@ func TestChangeFollowingState(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			err := threadService.ChangeFollowingState(context.Background(), testCase.inputThreadID, testCase.inputAccessorUserID)
+			err := threadService.LockThread(context.Background(), "t-aBcDeFg", testCase.inputAccessorUserID, testCase.inputRole)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
@@ -1794,27 +5090,29 @@ func TestChangeFollowingState(t *testing.T) {
 	}
 }
 
-func TestChangeLikeState(t *testing.T) {
+func TestUnlockThread(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
-	now := time.Now()
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
 		name                string
-		inputThreadID       string
 		inputAccessorUserID string
 		expectedError       error
 		mockBehaviour       func()
 	}{
 		{
-			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
+			name:                "it should return service.ErrDataAlreadyExists, when the thread is not locked",
+			inputAccessorUserID: "u-creator",
+			expectedError:       service.ErrDataAlreadyExists,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -1823,31 +5121,216 @@ func TestChangeLikeState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
-						return repository.ErrDatabase
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when Thread Follow ID Generator return an error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
+			name:                "it should return nil error, when the thread creator unlocks the thread",
+			inputAccessorUserID: "u-creator",
+			expectedError:       nil,
 			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}, LockedAt: time.Now()}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
 				mockIDGen.On(
-					"GenerateLikeID",
+					"GenerateModerationLogID",
 				).Return(
 					func() string {
-						return ""
+						return "g-aBcDeFg"
 					},
 					func() error {
-						return errors.New("failed to generate comment id")
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdateLock",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, ID string, lockedAt time.Time, moderationLog entity.ModerationLog) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.UnlockThread(context.Background(), "t-aBcDeFg", testCase.inputAccessorUserID, "user")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPinComment(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name           string
+		inputCommentID string
+		expectedError  error
+		mockBehaviour  func()
+	}{
+		{
+			name:           "it should return service.ErrDataNotFound, when the comment is not found",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{}
+					},
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:           "it should return service.ErrDataNotFound, when the comment belongs to another thread",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  service.ErrDataNotFound,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
 					},
 				).Once()
 
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-aBcDeFg", Thread: entity.Thread{ID: "t-xXxXxXx"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:           "it should return service.ErrDataNotFound, when the comment is a reply",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  service.ErrDataNotFound,
+			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -1855,31 +5338,45 @@ func TestChangeLikeState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
 					},
 				).Once()
+
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"FindCommentByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-aBcDeFg", Thread: entity.Thread{ID: "t-aBcDeFg"}, ParentID: "c-xXxXxXx"}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
+			name:           "it should return service.ErrDataAlreadyExists, when the comment is already pinned",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  service.ErrDataAlreadyExists,
 			mockBehaviour: func() {
-				mockIDGen.On(
-					"GenerateLikeID",
-				).Return(
-					func() string {
-						return "P-sk8d"
-					},
-					func() error {
-						return nil
-					},
-				).Once()
-
 				mockThreadRepo.On(
 					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -1887,19 +5384,7 @@ func TestChangeLikeState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{
-							ID:            "d-Casfkj",
-							Title:         "Technology",
-							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
-							TotalViewer:   320,
-							TotalLike:     243,
-							TotalFollower: 674,
-							TotalComment:  23,
-							IsLiked:       true,
-							IsFollowed:    true,
-							CreatedAt:     now,
-							UpdatedAt:     now,
-						}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}, PinnedCommentID: "c-aBcDeFg"}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -1907,43 +5392,37 @@ func TestChangeLikeState(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteLike",
+					"FindAllModeratorByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, like entity.Like) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertLike",
+					"FindCommentByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, like entity.Like) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-aBcDeFg", Thread: entity.Thread{ID: "t-aBcDeFg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when Thread repository return a repository.ErrDatabase error",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       service.ErrRepository,
+			name:           "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  service.ErrRepository,
 			mockBehaviour: func() {
-				mockIDGen.On(
-					"GenerateLikeID",
-				).Return(
-					func() string {
-						return "P-sk8d"
-					},
-					func() error {
-						return nil
-					},
-				).Once()
-
 				mockThreadRepo.On(
 					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -1951,7 +5430,7 @@ func TestChangeLikeState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -1959,43 +5438,60 @@ func TestChangeLikeState(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteLike",
+					"FindAllModeratorByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, like entity.Like) error {
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
 						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertLike",
+					"FindCommentByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, like entity.Like) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-aBcDeFg", Thread: entity.Thread{ID: "t-aBcDeFg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
 					},
 				).Once()
-			},
-		},
-		{
-			name:                "it should return nil error, when no error is returned.",
-			inputThreadID:       "",
-			inputAccessorUserID: "",
-			expectedError:       nil,
-			mockBehaviour: func() {
+
 				mockIDGen.On(
-					"GenerateLikeID",
+					"GenerateModerationLogID",
 				).Return(
 					func() string {
-						return "P-sk8d"
+						return "g-aBcDeFg"
 					},
 					func() error {
 						return nil
 					},
 				).Once()
 
+				mockThreadRepo.On(
+					"UpdatePinnedComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, ID string, commentID string, moderationLog entity.ModerationLog) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:           "it should return nil error, when the comment is pinned",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  nil,
+			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
@@ -2003,19 +5499,7 @@ func TestChangeLikeState(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{
-							ID:            "d-Casfkj",
-							Title:         "Technology",
-							Description:   "Technology is the result of accumulated knowledge and application of skills, methods, and processes used in industrial production and scientific research.",
-							TotalViewer:   320,
-							TotalLike:     243,
-							TotalFollower: 674,
-							TotalComment:  23,
-							IsLiked:       true,
-							IsFollowed:    true,
-							CreatedAt:     now,
-							UpdatedAt:     now,
-						}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2023,21 +5507,50 @@ func TestChangeLikeState(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteLike",
+					"FindAllModeratorByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, like entity.Like) error {
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
 						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertLike",
+					"FindCommentByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Like{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, like entity.Like) error {
+					func(ctx context.Context, ID string) entity.Comment {
+						return entity.Comment{ID: "c-aBcDeFg", Thread: entity.Thread{ID: "t-aBcDeFg"}}
+					},
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdatePinnedComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, ID string, commentID string, moderationLog entity.ModerationLog) error {
 						return nil
 					},
 				).Once()
@@ -2049,7 +5562,7 @@ func TestChangeLikeState(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			err := threadService.ChangeLikeState(context.Background(), testCase.inputThreadID, testCase.inputAccessorUserID)
+			err := threadService.PinComment(context.Background(), "t-aBcDeFg", testCase.inputCommentID, "u-creator", "user")
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
@@ -2060,40 +5573,29 @@ func TestChangeLikeState(t *testing.T) {
 	}
 }
 
-func TestAddModerator(t *testing.T) {
+func TestUnpinComment(t *testing.T) {
 	mockThreadRepo := &mtr.ThreadRepository{}
 	mockCategoryRepo := &mcr.CategoryRepository{}
 	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
 	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
 
 	testCases := []struct {
-		name                string
-		inputThreadID       string
-		inputAccessorUserID string
-		expectedError       error
-		expectedID          string
-		inputPayload        payload.AddRemoveModerator
-		mockBehaviour       func()
+		name           string
+		inputCommentID string
+		expectedError  error
+		mockBehaviour  func()
 	}{
 		{
-			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrInvalidPayload,
-			inputPayload:        payload.AddRemoveModerator{},
-			mockBehaviour:       func() {},
-		},
-		{
-			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase error",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrRepository,
-			expectedID:          "",
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:           "it should return service.ErrDataNotFound, when the comment is not pinned",
+			inputCommentID: "c-xXxXxXx",
+			expectedError:  service.ErrDataNotFound,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2102,46 +5604,31 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}, PinnedCommentID: "c-aBcDeFg"}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
-						return repository.ErrDatabase
+						return nil
 					},
 				).Once()
-			},
-		},
-		{
-			name:                "it should return service.ErrAccessForbidden, when accessor user ID is not thread creator ID",
-			inputThreadID:       "U-abcd",
-			inputAccessorUserID: "b-123",
-			expectedError:       service.ErrAccessForbidden,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
-			mockBehaviour: func() {
+
 				mockThreadRepo.On(
-					"FindByID",
+					"FindAllModeratorByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "b-124"}}
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
 					},
-					func(ctx context.Context, accessorUserID string, ID string) error {
+					func(ctx context.Context, threadID string) error {
 						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase error",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
+			name:           "it should return nil error, when the comment is unpinned",
+			inputCommentID: "c-aBcDeFg",
+			expectedError:  nil,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2150,35 +5637,96 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}, PinnedCommentID: "c-aBcDeFg"}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
 					},
 				).Once()
 
-				mockThreadRepo.On(
-					"FindAllModeratorByThreadID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{}
-					},
-					func(ctx context.Context, threadID string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
-			},
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
+					},
+					func(ctx context.Context, threadID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpdatePinnedComment",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, ID string, commentID string, moderationLog entity.ModerationLog) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.UnpinComment(context.Background(), "t-aBcDeFg", testCase.inputCommentID, "u-creator", "user")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMuteUser(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name          string
+		inputPayload  payload.MuteUser
+		expectedError error
+		mockBehaviour func()
+	}{
+		{
+			name:          "it should return service.ErrInvalidPayload, when payload is invalid",
+			inputPayload:  payload.MuteUser{Username: "erikrios"},
+			expectedError: service.ErrInvalidPayload,
+			mockBehaviour: func() {},
 		},
 		{
-			name:                "it should return service.ErrRepository, when user repository return a repository.ErrDatabase error",
-			inputThreadID:       "t-xyz",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:          "it should return service.ErrAccessForbidden, when the accessor is not a moderator of the thread",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: service.ErrAccessForbidden,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2187,7 +5735,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-someone"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2206,29 +5754,12 @@ func TestAddModerator(t *testing.T) {
 						return nil
 					},
 				).Once()
-
-				mockUserRepo.On(
-					"FindByUsername",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{}
-					},
-					func(ctx context.Context, userName string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrAccessForbidden, when accessorUserID is userToAddID",
-			inputThreadID:       "t-xyz",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrAccessForbidden,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
+			name:          "it should return service.ErrUsernameNotFound, when the user is not found",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: service.ErrUsernameNotFound,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2237,7 +5768,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2262,23 +5793,19 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-1243"}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{}
 					},
-					func(ctx context.Context, userName string) error {
-						return nil
+					func(ctx context.Context, username string) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrDataAlreadyExists, when mod.User.ID is userToAdded.ID",
-			inputThreadID:       "t-xyz",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrDataAlreadyExists,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
+			name:          "it should return service.ErrRepository, when user repository return a repository.ErrDatabase error",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: service.ErrRepository,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2287,7 +5814,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2300,11 +5827,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{
-							{
-								User: entity.User{ID: "u-1244"},
-							},
-						}
+						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
@@ -2316,23 +5839,19 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-1244"}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{}
 					},
-					func(ctx context.Context, userName string) error {
-						return nil
+					func(ctx context.Context, username string) error {
+						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when generate ID return an error",
-			inputThreadID:       "t-xyz",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
+			name:          "it should return service.ErrAccessForbidden, when the user is the thread moderator",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: service.ErrAccessForbidden,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2341,7 +5860,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2354,11 +5873,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{
-							{
-								User: entity.User{ID: "u-1244"},
-							},
-						}
+						return []entity.Moderator{{User: entity.User{ID: "u-moderator"}}}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
@@ -2370,32 +5885,19 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-1245"}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-moderator", Role: "user"}
 					},
-					func(ctx context.Context, userName string) error {
+					func(ctx context.Context, username string) error {
 						return nil
 					},
 				).Once()
-
-				mockIDGen.On("GenerateModeratorID").Return(
-					func() string {
-						return ""
-					},
-					func() error {
-						return errors.New("Something went wrong.")
-					},
-				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when insert moderator return repository.ErrDatabase error",
-			inputThreadID:       "t-xyz",
-			inputAccessorUserID: "u-1243",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
+			name:          "it should return service.ErrAccessForbidden, when the user is a global moderator",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: service.ErrAccessForbidden,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2404,7 +5906,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2417,11 +5919,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{
-							{
-								User: entity.User{ID: "u-1244"},
-							},
-						}
+						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
@@ -2433,42 +5931,19 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-1245"}
-					},
-					func(ctx context.Context, userName string) error {
-						return nil
-					},
-				).Once()
-
-				mockIDGen.On("GenerateModeratorID").Return(
-					func() string {
-						return "m-123"
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-staff", Role: "global_moderator"}
 					},
-					func() error {
+					func(ctx context.Context, username string) error {
 						return nil
 					},
 				).Once()
-
-				mockThreadRepo.On(
-					"InsertModerator",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
-				).Return(
-					func(ctx context.Context, moderator entity.Moderator) error {
-						return repository.ErrDatabase
-					},
-				).Once()
 			},
 		},
 		{
-			name:                "it should return valid ID, when no error is returned",
-			inputThreadID:       "t-xyz",
-			inputAccessorUserID: "u-1243",
-			expectedError:       nil,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
+			name:          "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: service.ErrRepository,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2477,7 +5952,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-1243"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2490,11 +5965,7 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{
-							{
-								User: entity.User{ID: "u-1244"},
-							},
-						}
+						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
@@ -2506,17 +5977,19 @@ func TestAddModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-1245"}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-member", Role: "user"}
 					},
-					func(ctx context.Context, userName string) error {
+					func(ctx context.Context, username string) error {
 						return nil
 					},
 				).Once()
 
-				mockIDGen.On("GenerateModeratorID").Return(
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
 					func() string {
-						return "m-123"
+						return "g-aBcDeFg"
 					},
 					func() error {
 						return nil
@@ -2524,113 +5997,21 @@ func TestAddModerator(t *testing.T) {
 				).Once()
 
 				mockThreadRepo.On(
-					"InsertModerator",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
-				).Return(
-					func(ctx context.Context, moderator entity.Moderator) error {
-						return nil
-					},
-				).Once()
-			},
-		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.mockBehaviour()
-
-			err := threadService.AddModerator(context.Background(), testCase.inputPayload, testCase.inputThreadID, testCase.inputAccessorUserID)
-
-			if testCase.expectedError != nil {
-				assert.ErrorIs(t, err, testCase.expectedError)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
-func TestRemoveModerator(t *testing.T) {
-	mockThreadRepo := &mtr.ThreadRepository{}
-	mockCategoryRepo := &mcr.CategoryRepository{}
-	mockUserRepo := &mur.UserRepository{}
-	mockIDGen := &mig.IDGenerator{}
-
-	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockIDGen)
-
-	testCases := []struct {
-		name                string
-		inputThreadID       string
-		inputAccessorUserID string
-		expectedError       error
-		inputPayload        payload.AddRemoveModerator
-		mockBehaviour       func()
-	}{
-		{
-			name:                "it should return service.ErrInvalidPayload, when payload is invalid",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrInvalidPayload,
-			inputPayload:        payload.AddRemoveModerator{},
-			mockBehaviour:       func() {},
-		},
-		{
-			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase error",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "tomo12",
-			},
-			mockBehaviour: func() {
-				mockThreadRepo.On(
-					"FindByID",
+					"UpsertMute",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadMute{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
 				).Return(
-					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{}
-					},
-					func(ctx context.Context, accessorUserID string, ID string) error {
+					func(ctx context.Context, mute entity.ThreadMute, moderationLog entity.ModerationLog) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrAccessForbidden, when accessor user ID is not thread creator ID",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrAccessForbidden,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
-			mockBehaviour: func() {
-				mockThreadRepo.On(
-					"FindByID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcdd"}}
-					},
-					func(ctx context.Context, accessorUserID string, ID string) error {
-						return nil
-					},
-				).Once()
-			},
-		},
-		{
-			name:                "it should return service.ErrRepository, when thread repository return a repository.ErrDatabase",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:          "it should return nil error, when the user is muted",
+			inputPayload:  payload.MuteUser{Username: "erikrios", Reason: "spamming", Hours: 24},
+			expectedError: nil,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2639,7 +6020,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2655,19 +6036,86 @@ func TestRemoveModerator(t *testing.T) {
 						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
-						return repository.ErrDatabase
+						return nil
+					},
+				).Once()
+
+				mockUserRepo.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-member", Role: "user"}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"UpsertMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadMute{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, mute entity.ThreadMute, moderationLog entity.ModerationLog) error {
+						return nil
 					},
 				).Once()
 			},
 		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.MuteUser(context.Background(), "t-aBcDeFg", "u-creator", "user", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUnmuteUser(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name          string
+		inputUsername string
+		expectedError error
+		mockBehaviour func()
+	}{
 		{
-			name:                "it should return service.ErrRepository, when user repository return a repository.ErrDatabase",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:          "it should return service.ErrUsernameNotFound, when the user is not found",
+			inputUsername: "erikrios",
+			expectedError: service.ErrUsernameNotFound,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2676,7 +6124,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2701,23 +6149,19 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
+					func(ctx context.Context, username string) entity.User {
 						return entity.User{}
 					},
-					func(ctx context.Context, userName string) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, username string) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrAccessForbidden, when accessor want to remove itself",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrAccessForbidden,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:          "it should return service.ErrDataNotFound, when the user is not muted",
+			inputUsername: "erikrios",
+			expectedError: service.ErrDataNotFound,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2726,7 +6170,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2751,23 +6195,43 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-abcde"}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-member"}
 					},
-					func(ctx context.Context, userName string) error {
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
 						return nil
 					},
 				).Once()
+
+				mockThreadRepo.On(
+					"DeleteMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time, moderationLog entity.ModerationLog) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrUsernameNotFound, when user is not moderator",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrUsernameNotFound,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:          "it should return nil error, when the user is unmuted",
+			inputUsername: "erikrios",
+			expectedError: nil,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2776,7 +6240,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2789,11 +6253,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{{
-							User: entity.User{
-								ID: "u-abcde",
-							},
-						}}
+						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
@@ -2805,23 +6265,81 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-abcdf"}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-member"}
 					},
-					func(ctx context.Context, userName string) error {
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateModerationLogID",
+				).Return(
+					func() string {
+						return "g-aBcDeFg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepo.On(
+					"DeleteMute",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ModerationLog{})),
+				).Return(
+					func(ctx context.Context, threadID string, userID string, now time.Time, moderationLog entity.ModerationLog) error {
 						return nil
 					},
 				).Once()
 			},
 		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			err := threadService.UnmuteUser(context.Background(), "t-aBcDeFg", testCase.inputUsername, "u-creator", "user")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetModerationLogs(t *testing.T) {
+	mockThreadRepo := &mtr.ThreadRepository{}
+	mockCategoryRepo := &mcr.CategoryRepository{}
+	mockUserRepo := &mur.UserRepository{}
+	mockNotificationRepo := &mnr.NotificationRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	hub := realtime.NewMemoryHub()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var threadService ThreadService = NewThreadServiceImpl(mockThreadRepo, mockCategoryRepo, mockUserRepo, mockNotificationRepo, mockIDGen, hub, mockAuditService, mrp.NewInlineTxManager(), newViewCounter())
+
+	testCases := []struct {
+		name                string
+		inputAccessorUserID string
+		expectedAction      string
+		expectedError       error
+		mockBehaviour       func()
+	}{
 		{
-			name:                "it should return service.ErrRepository, when delete moderator return repository.ErrDatabase error",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
-			expectedError:       service.ErrRepository,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
+			name:                "it should return service.ErrAccessForbidden, when the accessor is not a moderator of the thread",
+			inputAccessorUserID: "u-member",
+			expectedAction:      "",
+			expectedError:       service.ErrAccessForbidden,
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2830,7 +6348,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2843,49 +6361,67 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{{
-							User: entity.User{
-								ID: "u-abcdf",
-							},
-						}}
+						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
 					},
 				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when repository.ErrDatabase return an error",
+			inputAccessorUserID: "u-creator",
+			expectedAction:      "",
+			expectedError:       service.ErrRepository,
+			mockBehaviour: func() {
+				mockThreadRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
+					},
+					func(ctx context.Context, accessorUserID string, ID string) error {
+						return nil
+					},
+				).Once()
 
-				mockUserRepo.On(
-					"FindByUsername",
+				mockThreadRepo.On(
+					"FindAllModeratorByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-abcdf"}
+					func(ctx context.Context, threadID string) []entity.Moderator {
+						return []entity.Moderator{}
 					},
-					func(ctx context.Context, userName string) error {
+					func(ctx context.Context, threadID string) error {
 						return nil
 					},
 				).Once()
 
 				mockThreadRepo.On(
-					"DeleteModerator",
+					"FindAllModerationLogByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
-					func(ctx context.Context, moderator entity.Moderator) error {
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) entity.Pagination[entity.ModerationLog] {
+						return entity.Pagination[entity.ModerationLog]{}
+					},
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return nil error, when no error is returned",
-			inputThreadID:       "t-123",
-			inputAccessorUserID: "u-abcde",
+			name:                "it should return moderation logs, when no error is returned",
+			inputAccessorUserID: "u-creator",
+			expectedAction:      "mute",
 			expectedError:       nil,
-			inputPayload: payload.AddRemoveModerator{
-				Username: "Jody",
-			},
 			mockBehaviour: func() {
 				mockThreadRepo.On(
 					"FindByID",
@@ -2894,7 +6430,7 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, accessorUserID string, ID string) entity.Thread {
-						return entity.Thread{Creator: entity.User{ID: "u-abcde"}}
+						return entity.Thread{ID: "t-aBcDeFg", Creator: entity.User{ID: "u-creator"}}
 					},
 					func(ctx context.Context, accessorUserID string, ID string) error {
 						return nil
@@ -2907,36 +6443,36 @@ func TestRemoveModerator(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, threadID string) []entity.Moderator {
-						return []entity.Moderator{{
-							User: entity.User{
-								ID: "u-abcdf",
-							},
-						}}
+						return []entity.Moderator{}
 					},
 					func(ctx context.Context, threadID string) error {
 						return nil
 					},
 				).Once()
 
-				mockUserRepo.On(
-					"FindByUsername",
+				mockThreadRepo.On(
+					"FindAllModerationLogByThreadID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
-					func(ctx context.Context, userName string) entity.User {
-						return entity.User{ID: "u-abcdf"}
-					},
-					func(ctx context.Context, userName string) error {
-						return nil
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) entity.Pagination[entity.ModerationLog] {
+						return entity.Pagination[entity.ModerationLog]{
+							List: []entity.ModerationLog{
+								{
+									ID:         "g-aBcDeFg",
+									Actor:      entity.User{ID: "u-creator", Username: "creator"},
+									Action:     entity.MuteAction,
+									TargetUser: entity.User{ID: "u-member", Username: "member"},
+									Reason:     "spamming",
+									ExpiresAt:  time.Now().Add(time.Hour),
+									CreatedAt:  time.Now(),
+								},
+							},
+							PageInfo: entity.PageInfo{Limit: 10, Page: 1, PageTotal: 1, Total: 1},
+						}
 					},
-				).Once()
-
-				mockThreadRepo.On(
-					"DeleteModerator",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Moderator{})),
-				).Return(
-					func(ctx context.Context, moderator entity.Moderator) error {
+					func(ctx context.Context, threadID string, pageInfo entity.PageInfo) error {
 						return nil
 					},
 				).Once()
@@ -2948,13 +6484,25 @@ func TestRemoveModerator(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			err := threadService.RemoveModerator(context.Background(), testCase.inputPayload, testCase.inputThreadID, testCase.inputAccessorUserID)
+			rs, err := threadService.GetModerationLogs(context.Background(), "t-aBcDeFg", testCase.inputAccessorUserID, "user", 0, 0)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, err, testCase.expectedError)
 			} else {
 				assert.NoError(t, err)
+				if assert.Len(t, rs.List, 1) {
+					assert.Equal(t, testCase.expectedAction, rs.List[0].Action)
+					assert.NotEmpty(t, rs.List[0].ExpiresOn)
+				}
 			}
 		})
 	}
 }
+
+// newViewCounter returns a ViewCounter that ignores the views.
+func newViewCounter() *mvc.ViewCounter {
+	mockViewCounter := &mvc.ViewCounter{}
+	mockViewCounter.On("View", mock.Anything, mock.Anything).Return()
+
+	return mockViewCounter
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"time"
+
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
+)
+
+const threadFilterDateLayout = "2006-01-02"
+
+// MapThreadSort converts a sort option into entity.ThreadSort. Unknown or empty options sort a search
+// by relevance and any other listing by newest.
+func MapThreadSort(sort string, query string) entity.ThreadSort {
+	switch sort {
+	case "newest":
+		return entity.NewestSort
+	case "liked":
+		return entity.MostLikedSort
+	case "viewed":
+		return entity.MostViewedSort
+	case "commented":
+		return entity.MostCommentedSort
+	case "trending":
+		return entity.TrendingSort
+	}
+
+	if query != "" {
+		return entity.RelevanceSort
+	}
+	return entity.NewestSort
+}
+
+func MapThreadFilter(p payload.ThreadFilter) (filter entity.ThreadFilter, err error) {
+	filter.Unanswered = p.Unanswered
+	filter.FollowedOnly = p.FollowedOnly
+	filter.CategoryID = p.CategoryID
+
+	if p.CreatedAfter != "" {
+		if filter.CreatedAfter, err = time.Parse(threadFilterDateLayout, p.CreatedAfter); err != nil {
+			err = ErrInvalidPayload
+			return
+		}
+	}
+
+	if p.CreatedBefore != "" {
+		if filter.CreatedBefore, err = time.Parse(threadFilterDateLayout, p.CreatedBefore); err != nil {
+			err = ErrInvalidPayload
+			return
+		}
+	}
+
+	return
+}
@@ -15,13 +15,13 @@ type UserService struct {
 	mock.Mock
 }
 
-// ChangeBannedState provides a mock function with given fields: ctx, accessorRole, username
-func (_m *UserService) ChangeBannedState(ctx context.Context, accessorRole string, username string) error {
-	ret := _m.Called(ctx, accessorRole, username)
+// Ban provides a mock function with given fields: ctx, accessorUserID, accessorRole, username, p
+func (_m *UserService) Ban(ctx context.Context, accessorUserID string, accessorRole string, username string, p payload.BanUser) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, username, p)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, accessorRole, username)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.BanUser) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, username, p)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -43,20 +43,76 @@ func (_m *UserService) ChangeFollowingState(ctx context.Context, accessorUserID
 	return r0
 }
 
-// GetAll provides a mock function with given fields: ctx, accessorUserID, orderBy, status, page, limit, keyword
-func (_m *UserService) GetAll(ctx context.Context, accessorUserID string, orderBy string, status string, page uint, limit uint, keyword string) (response.Pagination[response.User], error) {
-	ret := _m.Called(ctx, accessorUserID, orderBy, status, page, limit, keyword)
+// ChangePassword provides a mock function with given fields: ctx, accessorUserID, accessorUsername, p
+func (_m *UserService) ChangePassword(ctx context.Context, accessorUserID string, accessorUsername string, p payload.ChangePassword) (response.Login, error) {
+	ret := _m.Called(ctx, accessorUserID, accessorUsername, p)
+
+	var r0 response.Login
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.ChangePassword) response.Login); ok {
+		r0 = rf(ctx, accessorUserID, accessorUsername, p)
+	} else {
+		r0 = ret.Get(0).(response.Login)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.ChangePassword) error); ok {
+		r1 = rf(ctx, accessorUserID, accessorUsername, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteOwn provides a mock function with given fields: ctx, accessorUserID, accessorUsername, p
+func (_m *UserService) DeleteOwn(ctx context.Context, accessorUserID string, accessorUsername string, p payload.DeleteAccount) error {
+	ret := _m.Called(ctx, accessorUserID, accessorUsername, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.DeleteAccount) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorUsername, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportOwn provides a mock function with given fields: ctx, accessorUserID
+func (_m *UserService) ExportOwn(ctx context.Context, accessorUserID string) (response.PersonalData, error) {
+	ret := _m.Called(ctx, accessorUserID)
+
+	var r0 response.PersonalData
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.PersonalData); ok {
+		r0 = rf(ctx, accessorUserID)
+	} else {
+		r0 = ret.Get(0).(response.PersonalData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessorUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, accessorUserID, orderBy, status, page, limit, cursor, keyword
+func (_m *UserService) GetAll(ctx context.Context, accessorUserID string, orderBy string, status string, page uint, limit uint, cursor string, keyword string) (response.Pagination[response.User], error) {
+	ret := _m.Called(ctx, accessorUserID, orderBy, status, page, limit, cursor, keyword)
 
 	var r0 response.Pagination[response.User]
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint, uint, string) response.Pagination[response.User]); ok {
-		r0 = rf(ctx, accessorUserID, orderBy, status, page, limit, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint, uint, string, string) response.Pagination[response.User]); ok {
+		r0 = rf(ctx, accessorUserID, orderBy, status, page, limit, cursor, keyword)
 	} else {
 		r0 = ret.Get(0).(response.Pagination[response.User])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint, uint, string) error); ok {
-		r1 = rf(ctx, accessorUserID, orderBy, status, page, limit, keyword)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint, uint, string, string) error); ok {
+		r1 = rf(ctx, accessorUserID, orderBy, status, page, limit, cursor, keyword)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,20 +120,43 @@ func (_m *UserService) GetAll(ctx context.Context, accessorUserID string, orderB
 	return r0, r1
 }
 
-// GetAllThreadByUsername provides a mock function with given fields: ctx, accessorUserID, username, page, limit
-func (_m *UserService) GetAllThreadByUsername(ctx context.Context, accessorUserID string, username string, page uint, limit uint) (response.Pagination[response.ManyThread], error) {
-	ret := _m.Called(ctx, accessorUserID, username, page, limit)
+// GetAllBan provides a mock function with given fields: ctx, accessorUsername, accessorRole, username
+func (_m *UserService) GetAllBan(ctx context.Context, accessorUsername string, accessorRole string, username string) ([]response.Ban, error) {
+	ret := _m.Called(ctx, accessorUsername, accessorRole, username)
+
+	var r0 []response.Ban
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []response.Ban); ok {
+		r0 = rf(ctx, accessorUsername, accessorRole, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Ban)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, accessorUsername, accessorRole, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllThreadByUsername provides a mock function with given fields: ctx, accessorUserID, username, page, limit, cursor, sort, f
+func (_m *UserService) GetAllThreadByUsername(ctx context.Context, accessorUserID string, username string, page uint, limit uint, cursor string, sort string, f payload.ThreadFilter) (response.Pagination[response.ManyThread], error) {
+	ret := _m.Called(ctx, accessorUserID, username, page, limit, cursor, sort, f)
 
 	var r0 response.Pagination[response.ManyThread]
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint, uint) response.Pagination[response.ManyThread]); ok {
-		r0 = rf(ctx, accessorUserID, username, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint, uint, string, string, payload.ThreadFilter) response.Pagination[response.ManyThread]); ok {
+		r0 = rf(ctx, accessorUserID, username, page, limit, cursor, sort, f)
 	} else {
 		r0 = ret.Get(0).(response.Pagination[response.ManyThread])
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint, uint) error); ok {
-		r1 = rf(ctx, accessorUserID, username, page, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint, uint, string, string, payload.ThreadFilter) error); ok {
+		r1 = rf(ctx, accessorUserID, username, page, limit, cursor, sort, f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,6 +206,41 @@ func (_m *UserService) GetOwn(ctx context.Context, accessorUserID string, access
 	return r0, r1
 }
 
+// IsTokenRevoked provides a mock function with given fields: ctx, sessionID, userID, tokenVersion
+func (_m *UserService) IsTokenRevoked(ctx context.Context, sessionID string, userID string, tokenVersion uint) (bool, error) {
+	ret := _m.Called(ctx, sessionID, userID, tokenVersion)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint) bool); ok {
+		r0 = rf(ctx, sessionID, userID, tokenVersion)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint) error); ok {
+		r1 = rf(ctx, sessionID, userID, tokenVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LiftExpiredBans provides a mock function with given fields: ctx
+func (_m *UserService) LiftExpiredBans(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Login provides a mock function with given fields: ctx, p
 func (_m *UserService) Login(ctx context.Context, p payload.Login) (response.Login, error) {
 	ret := _m.Called(ctx, p)
@@ -148,6 +262,41 @@ func (_m *UserService) Login(ctx context.Context, p payload.Login) (response.Log
 	return r0, r1
 }
 
+// Logout provides a mock function with given fields: ctx, accessorSessionID
+func (_m *UserService) Logout(ctx context.Context, accessorSessionID string) error {
+	ret := _m.Called(ctx, accessorSessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, accessorSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Refresh provides a mock function with given fields: ctx, p
+func (_m *UserService) Refresh(ctx context.Context, p payload.RefreshToken) (response.Login, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 response.Login
+	if rf, ok := ret.Get(0).(func(context.Context, payload.RefreshToken) response.Login); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(response.Login)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.RefreshToken) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Register provides a mock function with given fields: ctx, p
 func (_m *UserService) Register(ctx context.Context, p payload.Register) (string, error) {
 	ret := _m.Called(ctx, p)
@@ -169,6 +318,97 @@ func (_m *UserService) Register(ctx context.Context, p payload.Register) (string
 	return r0, r1
 }
 
+// RequestEmailVerification provides a mock function with given fields: ctx, p
+func (_m *UserService) RequestEmailVerification(ctx context.Context, p payload.EmailRequest) error {
+	ret := _m.Called(ctx, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, payload.EmailRequest) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestPasswordReset provides a mock function with given fields: ctx, p
+func (_m *UserService) RequestPasswordReset(ctx context.Context, p payload.EmailRequest) error {
+	ret := _m.Called(ctx, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, payload.EmailRequest) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetPassword provides a mock function with given fields: ctx, p
+func (_m *UserService) ResetPassword(ctx context.Context, p payload.ResetPassword) error {
+	ret := _m.Called(ctx, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, payload.ResetPassword) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unban provides a mock function with given fields: ctx, accessorUserID, accessorRole, username
+func (_m *UserService) Unban(ctx context.Context, accessorUserID string, accessorRole string, username string) error {
+	ret := _m.Called(ctx, accessorUserID, accessorRole, username)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, accessorUserID, accessorRole, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateOwn provides a mock function with given fields: ctx, accessorUserID, accessorUsername, p
+func (_m *UserService) UpdateOwn(ctx context.Context, accessorUserID string, accessorUsername string, p payload.UpdateProfile) (response.User, error) {
+	ret := _m.Called(ctx, accessorUserID, accessorUsername, p)
+
+	var r0 response.User
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.UpdateProfile) response.User); ok {
+		r0 = rf(ctx, accessorUserID, accessorUsername, p)
+	} else {
+		r0 = ret.Get(0).(response.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.UpdateProfile) error); ok {
+		r1 = rf(ctx, accessorUserID, accessorUsername, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyEmail provides a mock function with given fields: ctx, p
+func (_m *UserService) VerifyEmail(ctx context.Context, p payload.VerifyEmail) error {
+	ret := _m.Called(ctx, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, payload.VerifyEmail) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUserService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,6 +12,17 @@ type UserService interface {
 
 	Login(ctx context.Context, p payload.Login) (r response.Login, err error)
 
+	Refresh(ctx context.Context, p payload.RefreshToken) (r response.Login, err error)
+
+	Logout(ctx context.Context, accessorSessionID string) (err error)
+
+	IsTokenRevoked(
+		ctx context.Context,
+		sessionID string,
+		userID string,
+		tokenVersion uint,
+	) (revoked bool, err error)
+
 	GetAll(
 		ctx context.Context,
 		accessorUserID,
@@ -19,6 +30,7 @@ type UserService interface {
 		status string,
 		page,
 		limit uint,
+		cursor,
 		keyword string,
 	) (r response.Pagination[response.User], err error)
 
@@ -34,12 +46,63 @@ type UserService interface {
 		username string,
 	) (r response.User, err error)
 
-	ChangeBannedState(
+	UpdateOwn(
 		ctx context.Context,
-		accessorRole string,
+		accessorUserID,
+		accessorUsername string,
+		p payload.UpdateProfile,
+	) (r response.User, err error)
+
+	// ExportOwn returns all the data of the accessor, for them to download.
+	ExportOwn(ctx context.Context, accessorUserID string) (r response.PersonalData, err error)
+
+	// DeleteOwn anonymizes the account of the accessor, their threads and comments are kept under a placeholder name.
+	DeleteOwn(
+		ctx context.Context,
+		accessorUserID,
+		accessorUsername string,
+		p payload.DeleteAccount,
+	) (err error)
+
+	ChangePassword(
+		ctx context.Context,
+		accessorUserID,
+		accessorUsername string,
+		p payload.ChangePassword,
+	) (r response.Login, err error)
+
+	RequestEmailVerification(ctx context.Context, p payload.EmailRequest) (err error)
+
+	VerifyEmail(ctx context.Context, p payload.VerifyEmail) (err error)
+
+	RequestPasswordReset(ctx context.Context, p payload.EmailRequest) (err error)
+
+	ResetPassword(ctx context.Context, p payload.ResetPassword) (err error)
+
+	Ban(
+		ctx context.Context,
+		accessorUserID,
+		accessorRole,
+		username string,
+		p payload.BanUser,
+	) (err error)
+
+	Unban(
+		ctx context.Context,
+		accessorUserID,
+		accessorRole,
 		username string,
 	) (err error)
 
+	GetAllBan(
+		ctx context.Context,
+		accessorUsername,
+		accessorRole,
+		username string,
+	) (rs []response.Ban, err error)
+
+	LiftExpiredBans(ctx context.Context) (err error)
+
 	ChangeFollowingState(
 		ctx context.Context,
 		accessorUserID,
@@ -52,5 +115,8 @@ type UserService interface {
 		username string,
 		page uint,
 		limit uint,
+		cursor string,
+		sort string,
+		f payload.ThreadFilter,
 	) (rs response.Pagination[response.ManyThread], err error)
 }
@@ -3,41 +3,90 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"net/mail"
+	"net/url"
+	"os"
 	"time"
 
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/entity"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/loginattempt"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/session"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/usertoken"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/mailer"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
 	"gopkg.in/validator.v2"
 )
 
+const (
+	emailVerificationTokenDuration = time.Hour * 24
+	passwordResetTokenDuration     = time.Hour
+)
+
+const (
+	maxUsernameLoginAttempts = 5
+	maxIPLoginAttempts       = 20
+	// The failed attempts older than the window are forgotten.
+	loginAttemptWindow  = time.Minute * 15
+	lockoutBaseDuration = time.Minute
+	lockoutMaxDuration  = time.Hour
+)
+
 type userServiceImpl struct {
-	userRepository    user.UserRepository
-	threadRepository  thread.ThreadRepository
-	idGenerator       generator.IDGenerator
-	passwordGenerator generator.PasswordGenerator
-	tokenGenerator    generator.TokenGenerator
+	userRepository         user.UserRepository
+	threadRepository       thread.ThreadRepository
+	sessionRepository      session.SessionRepository
+	notificationRepository notification.NotificationRepository
+	userTokenRepository    usertoken.UserTokenRepository
+	loginAttemptRepository loginattempt.LoginAttemptRepository
+	idGenerator            generator.IDGenerator
+	passwordGenerator      generator.PasswordGenerator
+	tokenGenerator         generator.TokenGenerator
+	hub                    realtime.Hub
+	mailer                 mailer.Mailer
+	auditService           audit.AuditService
+	txManager              repository.TxManager
 }
 
 func NewUserServiceImpl(
 	userRepository user.UserRepository,
 	threadRepository thread.ThreadRepository,
+	sessionRepository session.SessionRepository,
+	notificationRepository notification.NotificationRepository,
+	userTokenRepository usertoken.UserTokenRepository,
+	loginAttemptRepository loginattempt.LoginAttemptRepository,
 	idGenerator generator.IDGenerator,
 	passwordGenerator generator.PasswordGenerator,
 	tokenGenerator generator.TokenGenerator,
+	hub realtime.Hub,
+	mailer mailer.Mailer,
+	auditService audit.AuditService,
+	txManager repository.TxManager,
 ) *userServiceImpl {
 	return &userServiceImpl{
-		userRepository:    userRepository,
-		threadRepository:  threadRepository,
-		idGenerator:       idGenerator,
-		passwordGenerator: passwordGenerator,
-		tokenGenerator:    tokenGenerator,
+		userRepository:         userRepository,
+		threadRepository:       threadRepository,
+		sessionRepository:      sessionRepository,
+		notificationRepository: notificationRepository,
+		userTokenRepository:    userTokenRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		idGenerator:            idGenerator,
+		passwordGenerator:      passwordGenerator,
+		tokenGenerator:         tokenGenerator,
+		hub:                    hub,
+		mailer:                 mailer,
+		auditService:           auditService,
+		txManager:              txManager,
 	}
 }
 
@@ -73,7 +122,7 @@ func (u *userServiceImpl) Register(
 		Email:    p.Email,
 		Name:     p.Name,
 		Password: string(password),
-		Role:     "user",
+		Role:     service.RoleUser,
 	}
 
 	if repoErr := u.userRepository.Insert(ctx, user); repoErr != nil {
@@ -81,6 +130,11 @@ func (u *userServiceImpl) Register(
 		return
 	}
 
+	// The account is already created, the user can request another verification email when this one fails.
+	if sendErr := u.sendUserToken(ctx, user, entity.EmailVerificationToken); sendErr != nil {
+		log.Println(sendErr)
+	}
+
 	return
 }
 
@@ -93,9 +147,23 @@ func (u *userServiceImpl) Login(
 		return
 	}
 
+	now := time.Now()
+
+	lockedUntil, repoErr := u.loginAttemptRepository.FindLockedUntil(ctx, p.Username, p.IPAddress)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if lockedUntil.After(now) {
+		err = &service.TooManyAttemptsError{RetryAfter: lockedUntil.Sub(now)}
+		return
+	}
+
 	user, repoErr := u.userRepository.FindByUsername(ctx, p.Username)
 	if repoErr != nil {
 		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			u.recordLoginFailure(ctx, p.Username, p.IPAddress)
 			err = service.ErrUsernameNotFound
 			return
 		}
@@ -103,35 +171,180 @@ func (u *userServiceImpl) Login(
 		return
 	}
 
-	if !user.IsActive {
-		err = service.ErrUsernameNotFound
-		return
-	}
-
 	if compareErr := u.passwordGenerator.CompareHashAndPassword(
 		[]byte(user.Password),
 		[]byte(p.Password),
 	); compareErr != nil {
+		u.recordLoginFailure(ctx, p.Username, p.IPAddress)
 		err = service.ErrCredentialNotMatch
 		return
 	}
 
+	// The ban is only revealed to those who know the password.
+	if !user.IsActive {
+		if err = u.checkBan(ctx, user, now); err != nil {
+			return
+		}
+		user.IsActive = true
+	}
+
+	// Only the username is cleared, otherwise an attacker could reset the IP address counter by signing in to their own account.
+	if repoErr := u.loginAttemptRepository.Delete(ctx, entity.UsernameLoginAttempt, p.Username); repoErr != nil && !errors.Is(repoErr, repository.ErrRecordNotFound) {
+		log.Println(repoErr)
+	}
+
+	if !user.IsVerified && os.Getenv("REQUIRE_EMAIL_VERIFICATION") == "true" {
+		err = service.ErrEmailNotVerified
+		return
+	}
+
+	r, err = u.startSession(ctx, user, p.Device)
+	return
+}
+
+// checkBan returns a service.BannedError while the user is banned, an expired ban not lifted by the scheduler yet is lifted right away.
+func (u *userServiceImpl) checkBan(ctx context.Context, user entity.User, now time.Time) (err error) {
+	ban, repoErr := u.userRepository.FindActiveBanByUserID(ctx, user.ID)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = &service.BannedError{}
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if ban.IsPermanent() || ban.ExpiresAt.After(now) {
+		err = &service.BannedError{Reason: ban.Reason, ExpiresAt: ban.ExpiresAt}
+		return
+	}
+
+	if repoErr := u.userRepository.UnbannedUser(ctx, user.ID, "", now); repoErr != nil && !errors.Is(repoErr, repository.ErrRecordAlreadyExists) {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) Refresh(
+	ctx context.Context,
+	p payload.RefreshToken,
+) (r response.Login, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	refreshTokenHash := generator.HashRefreshToken(p.RefreshToken)
+
+	session, repoErr := u.sessionRepository.FindByRefreshTokenHash(ctx, refreshTokenHash)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrInvalidToken
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// A rotated refresh token is used again, the token may have been stolen, so the whole session is revoked.
+	if session.RefreshTokenHash != refreshTokenHash {
+		if repoErr := u.sessionRepository.Revoke(ctx, session.ID); repoErr != nil && !errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.MapError(repoErr)
+			return
+		}
+		err = service.ErrInvalidToken
+		return
+	}
+
+	if !session.RevokedAt.IsZero() || session.ExpiresAt.Before(time.Now()) || !session.User.IsActive {
+		err = service.ErrInvalidToken
+		return
+	}
+
+	// The token version is bumped by a ban or a password change, the session is stale since then.
+	if session.TokenVersion != session.User.TokenVersion {
+		err = service.ErrInvalidToken
+		return
+	}
+
+	refreshToken, genErr := u.tokenGenerator.GenerateRefreshToken()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	if repoErr := u.sessionRepository.Rotate(
+		ctx,
+		session.ID,
+		refreshTokenHash,
+		generator.HashRefreshToken(refreshToken),
+		time.Now().Add(generator.RefreshTokenDuration),
+	); repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrInvalidToken
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
 	tokenPayload := generator.TokenPayload{
-		ID:       user.ID,
-		Username: user.Username,
-		Role:     user.Role,
-		IsActive: user.IsActive,
+		ID:           session.User.ID,
+		Username:     session.User.Username,
+		Role:         session.User.Role,
+		IsActive:     session.User.IsActive,
+		SessionID:    session.ID,
+		TokenVersion: session.User.TokenVersion,
 	}
 
 	token, genErr := u.tokenGenerator.GenerateToken(tokenPayload)
 	if genErr != nil {
-		err = service.MapError(repoErr)
+		err = service.MapError(genErr)
 		return
 	}
 
 	r.Token = token
-	r.Role = user.Role
+	r.RefreshToken = refreshToken
+	r.Role = session.User.Role
+
+	return
+}
+
+func (u *userServiceImpl) Logout(
+	ctx context.Context,
+	accessorSessionID string,
+) (err error) {
+	if repoErr := u.sessionRepository.Revoke(ctx, accessorSessionID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) IsTokenRevoked(
+	ctx context.Context,
+	sessionID string,
+	userID string,
+	tokenVersion uint,
+) (revoked bool, err error) {
+	// The session is read with its user, so the token version is checked without another lookup.
+	session, repoErr := u.sessionRepository.FindByID(ctx, sessionID)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			revoked = true
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
 
+	revoked = !session.RevokedAt.IsZero() ||
+		session.ExpiresAt.Before(time.Now()) ||
+		session.User.ID != userID ||
+		session.User.TokenVersion != tokenVersion
 	return
 }
 
@@ -142,6 +355,7 @@ func (u *userServiceImpl) GetAll(
 	status string,
 	page,
 	limit uint,
+	cursor,
 	keyword string,
 ) (r response.Pagination[response.User], err error) {
 	if page <= 0 {
@@ -167,9 +381,15 @@ func (u *userServiceImpl) GetAll(
 		userStatus = entity.Active
 	}
 
+	userCursor, err := service.DecodeCursor(cursor)
+	if err != nil {
+		return
+	}
+
 	pageInfo := entity.PageInfo{
-		Page:  page,
-		Limit: limit,
+		Page:   page,
+		Limit:  limit,
+		Cursor: userCursor,
 	}
 
 	pagination, repoErr := u.userRepository.FindAllWithStatusAndPagination(
@@ -186,22 +406,11 @@ func (u *userServiceImpl) GetAll(
 	r.PageInfo.Limit = pagination.PageInfo.Limit
 	r.PageInfo.Total = pagination.PageInfo.Total
 	r.PageInfo.PageTotal = pagination.PageInfo.PageTotal
+	r.NextCursor = service.EncodeCursor(pagination.NextCursor)
+	r.PrevCursor = service.EncodeCursor(pagination.PrevCursor)
 
 	for i, user := range pagination.List {
-		user := response.User{
-			UserID:         user.ID,
-			Username:       user.Username,
-			Email:          user.Email,
-			Name:           user.Name,
-			Role:           user.Role,
-			IsActive:       user.IsActive,
-			RegisteredOn:   user.CreatedAt.Format(time.RFC822),
-			TotalThread:    uint(user.TotalThread),
-			TotalFollower:  uint(user.TotalFollower),
-			TotalFollowing: uint(user.TotalFollowing),
-			IsFollowed:     user.IsFollowed,
-		}
-		r.List[i] = user
+		r.List[i] = newUserResponse(user)
 	}
 
 	return
@@ -217,19 +426,7 @@ func (u *userServiceImpl) GetOwn(
 	); repoErr != nil {
 		err = service.MapError(repoErr)
 	} else {
-		r = response.User{
-			UserID:         user.ID,
-			Username:       user.Username,
-			Email:          user.Email,
-			Name:           user.Name,
-			Role:           user.Role,
-			IsActive:       user.IsActive,
-			RegisteredOn:   user.CreatedAt.Format(time.RFC822),
-			TotalThread:    uint(user.TotalThread),
-			TotalFollower:  uint(user.TotalFollower),
-			TotalFollowing: uint(user.TotalFollowing),
-			IsFollowed:     user.IsFollowed,
-		}
+		r = newUserResponse(user)
 	}
 
 	return
@@ -245,143 +442,685 @@ func (u *userServiceImpl) GetByUsername(
 	); repoErr != nil {
 		err = service.MapError(repoErr)
 	} else {
-		r = response.User{
-			UserID:         user.ID,
-			Username:       user.Username,
-			Email:          user.Email,
-			Name:           user.Name,
-			Role:           user.Role,
-			IsActive:       user.IsActive,
-			RegisteredOn:   user.CreatedAt.Format(time.RFC822),
-			TotalThread:    uint(user.TotalThread),
-			TotalFollower:  uint(user.TotalFollower),
-			TotalFollowing: uint(user.TotalFollowing),
-			IsFollowed:     user.IsFollowed,
-		}
+		r = newUserResponse(user)
 	}
 
 	return
 }
 
-func (u *userServiceImpl) ChangeBannedState(
+func (u *userServiceImpl) UpdateOwn(
 	ctx context.Context,
-	accessorRole string,
-	username string,
-) (err error) {
-	if accessorRole != "admin" {
-		err = service.ErrAccessForbidden
+	accessorUserID,
+	accessorUsername string,
+	p payload.UpdateProfile,
+) (r response.User, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
 		return
 	}
 
-	user, repoErr := u.userRepository.FindByUsername(ctx, username)
-	if repoErr != nil {
-		err = service.MapError(repoErr)
+	if _, parseErr := mail.ParseAddress(p.Email); parseErr != nil {
+		err = service.ErrInvalidPayload
 		return
 	}
 
-	if user.IsActive {
-		if repoErr := u.userRepository.BannedUser(
-			context.Background(), user.ID,
-		); repoErr != nil {
-			err = service.MapError(repoErr)
-			return
-		}
-	} else {
-		if repoErr := u.userRepository.UnbannedUser(
-			context.Background(), user.ID,
-		); repoErr != nil {
-			err = service.MapError(repoErr)
+	if p.AvatarURL != "" {
+		avatarURL, parseErr := url.ParseRequestURI(p.AvatarURL)
+		if parseErr != nil || (avatarURL.Scheme != "http" && avatarURL.Scheme != "https") || avatarURL.Host == "" {
+			err = service.ErrInvalidPayload
 			return
 		}
 	}
 
-	return
+	user := entity.User{
+		Email:     p.Email,
+		Name:      p.Name,
+		Bio:       p.Bio,
+		AvatarURL: p.AvatarURL,
+		Location:  p.Location,
+	}
+
+	if repoErr := u.userRepository.Update(ctx, accessorUserID, user); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return u.GetOwn(ctx, accessorUserID, accessorUsername)
 }
 
-func (u *userServiceImpl) ChangeFollowingState(
-	ctx context.Context,
-	accessorUserID,
-	usernameToFollow string,
-) (err error) {
-	user, repoErr := u.userRepository.FindByUsernameWithAccessor(ctx, accessorUserID, usernameToFollow)
+func (u *userServiceImpl) ExportOwn(ctx context.Context, accessorUserID string) (r response.PersonalData, err error) {
+	data, repoErr := u.userRepository.FindPersonalData(ctx, accessorUserID)
 	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
-	if user.IsFollowed {
-		if repoErr := u.userRepository.UnfollowUser(ctx, accessorUserID, user.ID); repoErr != nil {
-			err = service.MapError(repoErr)
-			return
+	r.ExportedAt = formatPersonalDataTime(time.Now())
+
+	r.Profile = response.PersonalProfile{
+		UserID:       data.User.ID,
+		Username:     data.User.Username,
+		Email:        data.User.Email,
+		Name:         data.User.Name,
+		Bio:          data.User.Bio,
+		AvatarURL:    data.User.AvatarURL,
+		Location:     data.User.Location,
+		Role:         data.User.Role,
+		IsActive:     data.User.IsActive,
+		IsVerified:   data.User.IsVerified,
+		RegisteredAt: formatPersonalDataTime(data.User.CreatedAt),
+		UpdatedAt:    formatPersonalDataTime(data.User.UpdatedAt),
+	}
+
+	r.Threads = make([]response.PersonalThread, len(data.Threads))
+	for i, thread := range data.Threads {
+		r.Threads[i] = response.PersonalThread{
+			ID:           thread.ID,
+			Title:        thread.Title,
+			Description:  thread.Description,
+			CategoryID:   thread.Category.ID,
+			CategoryName: thread.Category.Name,
+			PublishedAt:  formatPersonalDataTime(thread.CreatedAt),
+			UpdatedAt:    formatPersonalDataTime(thread.UpdatedAt),
 		}
-	} else {
-		id, genErr := u.idGenerator.GenerateUserFollowID()
-		if genErr != nil {
-			err = service.MapError(genErr)
-			return
+	}
+
+	r.Comments = make([]response.PersonalComment, len(data.Comments))
+	for i, comment := range data.Comments {
+		r.Comments[i] = response.PersonalComment{
+			ID:          comment.ID,
+			ThreadID:    comment.Thread.ID,
+			ThreadTitle: comment.Thread.Title,
+			ParentID:    comment.ParentID,
+			Comment:     comment.Comment,
+			PublishedAt: formatPersonalDataTime(comment.CreatedAt),
+			EditedAt:    formatPersonalDataTime(comment.EditedAt),
+			DeletedAt:   formatPersonalDataTime(comment.DeletedAt),
+		}
+	}
+
+	r.Likes = make([]response.PersonalThreadEntry, len(data.Likes))
+	for i, like := range data.Likes {
+		r.Likes[i] = response.PersonalThreadEntry{
+			ThreadID:    like.Thread.ID,
+			ThreadTitle: like.Thread.Title,
+			CreatedAt:   formatPersonalDataTime(like.CreatedAt),
 		}
+	}
 
-		if repoErr := u.userRepository.FollowUser(ctx, id, accessorUserID, user.ID); repoErr != nil {
-			err = service.MapError(repoErr)
-			return
+	r.Following = make([]response.PersonalUserEntry, len(data.Following))
+	for i, follow := range data.Following {
+		r.Following[i] = response.PersonalUserEntry{
+			UserID:    follow.Following.ID,
+			Username:  follow.Following.Username,
+			CreatedAt: formatPersonalDataTime(follow.CreatedAt),
+		}
+	}
+
+	r.Followers = make([]response.PersonalUserEntry, len(data.Followers))
+	for i, follow := range data.Followers {
+		r.Followers[i] = response.PersonalUserEntry{
+			UserID:    follow.User.ID,
+			Username:  follow.User.Username,
+			CreatedAt: formatPersonalDataTime(follow.CreatedAt),
+		}
+	}
+
+	r.FollowedThreads = make([]response.PersonalThreadEntry, len(data.FollowedThreads))
+	for i, follow := range data.FollowedThreads {
+		r.FollowedThreads[i] = response.PersonalThreadEntry{
+			ThreadID:    follow.Thread.ID,
+			ThreadTitle: follow.Thread.Title,
+			CreatedAt:   formatPersonalDataTime(follow.CreatedAt),
 		}
 	}
 
 	return
 }
 
-func (u *userServiceImpl) GetAllThreadByUsername(
+func (u *userServiceImpl) DeleteOwn(
 	ctx context.Context,
 	accessorUserID,
-	username string,
-	page uint,
-	limit uint,
-) (rs response.Pagination[response.ManyThread], err error) {
-	if page <= 0 {
-		page = 1
+	accessorUsername string,
+	p payload.DeleteAccount,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
 	}
 
-	if limit <= 0 {
-		limit = 10
+	user, repoErr := u.userRepository.FindByUsername(ctx, accessorUsername)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
 	}
 
-	user, repoErr := u.userRepository.FindByUsername(ctx, username)
-	if repoErr != nil {
+	if user.ID != accessorUserID {
+		err = service.ErrAccessForbidden
+		return
+	}
+
+	// An admin has to be revoked by another admin first, so there is always an admin left to manage the roles.
+	if user.Role == service.RoleAdmin {
+		err = service.ErrAccessForbidden
+		return
+	}
+
+	if compareErr := u.passwordGenerator.CompareHashAndPassword(
+		[]byte(user.Password),
+		[]byte(p.Password),
+	); compareErr != nil {
+		err = service.ErrCredentialNotMatch
+		return
+	}
+
+	if repoErr := u.userRepository.Anonymize(ctx, user.ID, time.Now()); repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
-	pagination, repoErr := u.threadRepository.FindAllByUserIDWithPagination(
-		ctx,
-		accessorUserID,
-		user.ID,
-		entity.PageInfo{
-			Limit: limit,
-			Page:  page,
-		},
-	)
+	return
+}
 
+func (u *userServiceImpl) ChangePassword(
+	ctx context.Context,
+	accessorUserID,
+	accessorUsername string,
+	p payload.ChangePassword,
+) (r response.Login, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	user, repoErr := u.userRepository.FindByUsername(ctx, accessorUsername)
 	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
-	rs.PageInfo.Page = pagination.PageInfo.Page
-	rs.PageInfo.Limit = pagination.PageInfo.Limit
-	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
-	rs.PageInfo.Total = pagination.PageInfo.Total
+	if user.ID != accessorUserID {
+		err = service.ErrAccessForbidden
+		return
+	}
 
-	rs.List = make([]response.ManyThread, len(pagination.List))
+	if compareErr := u.passwordGenerator.CompareHashAndPassword(
+		[]byte(user.Password),
+		[]byte(p.OldPassword),
+	); compareErr != nil {
+		err = service.ErrCredentialNotMatch
+		return
+	}
 
-	for i, item := range pagination.List {
-		thread := response.ManyThread{
-			ID:              item.ID,
-			Title:           item.Title,
-			CategoryID:      item.Category.ID,
-			CategoryName:    item.Category.Name,
-			PublishedOn:     item.CreatedAt.Format(time.RFC822),
-			IsLiked:         item.IsLiked,
-			IsFollowed:      item.IsFollowed,
+	password, genErr := u.passwordGenerator.GenerateFromPassword([]byte(p.NewPassword), 10)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	if repoErr := u.userRepository.UpdatePassword(ctx, user.ID, string(password)); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// The password change signs out every session, a new one is started for the current device only.
+	user.TokenVersion++
+
+	r, err = u.startSession(ctx, user, p.Device)
+	return
+}
+
+func (u *userServiceImpl) RequestEmailVerification(
+	ctx context.Context,
+	p payload.EmailRequest,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	// The response is the same whether the email is registered or not, so the registered emails can't be enumerated.
+	user, repoErr := u.userRepository.FindByEmail(ctx, p.Email)
+	if repoErr != nil {
+		if !errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.MapError(repoErr)
+		}
+		return
+	}
+
+	if user.IsVerified || !user.IsActive {
+		return
+	}
+
+	if sendErr := u.sendUserToken(ctx, user, entity.EmailVerificationToken); sendErr != nil {
+		err = service.MapError(sendErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) VerifyEmail(
+	ctx context.Context,
+	p payload.VerifyEmail,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	userID, repoErr := u.userTokenRepository.Use(ctx, generator.HashOneTimeToken(p.Token), entity.EmailVerificationToken)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrInvalidToken
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if repoErr := u.userRepository.MarkVerified(ctx, userID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) RequestPasswordReset(
+	ctx context.Context,
+	p payload.EmailRequest,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	// The response is the same whether the email is registered or not, so the registered emails can't be enumerated.
+	user, repoErr := u.userRepository.FindByEmail(ctx, p.Email)
+	if repoErr != nil {
+		if !errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.MapError(repoErr)
+		}
+		return
+	}
+
+	if !user.IsActive {
+		return
+	}
+
+	if sendErr := u.sendUserToken(ctx, user, entity.PasswordResetToken); sendErr != nil {
+		err = service.MapError(sendErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) ResetPassword(
+	ctx context.Context,
+	p payload.ResetPassword,
+) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	password, genErr := u.passwordGenerator.GenerateFromPassword([]byte(p.NewPassword), 10)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	// The token is only used up if the password is updated.
+	if txErr := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		userID, repoErr := u.userTokenRepository.Use(ctx, generator.HashOneTimeToken(p.Token), entity.PasswordResetToken)
+		if repoErr != nil {
+			return repoErr
+		}
+
+		// Updating the password bumps the token version, so every session of the user is signed out.
+		return u.userRepository.UpdatePassword(ctx, userID, string(password))
+	}); txErr != nil {
+		if errors.Is(txErr, repository.ErrRecordNotFound) {
+			err = service.ErrInvalidToken
+			return
+		}
+		err = service.MapError(txErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) Ban(
+	ctx context.Context,
+	accessorUserID,
+	accessorRole,
+	username string,
+	p payload.BanUser,
+) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionBanUsers); err != nil {
+		return
+	}
+
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	user, err := u.findBanTarget(ctx, accessorRole, username)
+	if err != nil {
+		return
+	}
+
+	if !user.IsActive {
+		err = service.ErrDataAlreadyExists
+		return
+	}
+
+	id, genErr := u.idGenerator.GenerateBanID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	ban := entity.Ban{
+		ID:     id,
+		User:   user,
+		Issuer: entity.User{ID: accessorUserID},
+		Reason: p.Reason,
+	}
+
+	if p.Days > 0 {
+		ban.ExpiresAt = time.Now().AddDate(0, 0, int(p.Days))
+	}
+
+	after := map[string]any{"isActive": false, "banID": ban.ID, "reason": ban.Reason}
+	if !ban.IsPermanent() {
+		after["expiresAt"] = ban.ExpiresAt
+	}
+
+	if txErr := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := u.userRepository.BannedUser(ctx, ban); repoErr != nil {
+			return repoErr
+		}
+
+		return u.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.UserBanned,
+			TargetType: audit.UserTarget,
+			TargetID:   user.ID,
+			Before:     map[string]any{"isActive": true},
+			After:      after,
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) Unban(
+	ctx context.Context,
+	accessorUserID,
+	accessorRole,
+	username string,
+) (err error) {
+	if err = service.Authorize(accessorRole, service.PermissionBanUsers); err != nil {
+		return
+	}
+
+	user, err := u.findBanTarget(ctx, accessorRole, username)
+	if err != nil {
+		return
+	}
+
+	if txErr := u.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if repoErr := u.userRepository.UnbannedUser(ctx, user.ID, accessorUserID, time.Now()); repoErr != nil {
+			return repoErr
+		}
+
+		return u.auditService.Record(ctx, audit.Entry{
+			ActorID:    accessorUserID,
+			Action:     audit.UserUnbanned,
+			TargetType: audit.UserTarget,
+			TargetID:   user.ID,
+			Before:     map[string]any{"isActive": false},
+			After:      map[string]any{"isActive": true},
+		})
+	}); txErr != nil {
+		err = service.MapError(txErr)
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) GetAllBan(
+	ctx context.Context,
+	accessorUsername,
+	accessorRole,
+	username string,
+) (rs []response.Ban, err error) {
+	// The users can see their own ban history.
+	if accessorUsername != username {
+		if err = service.Authorize(accessorRole, service.PermissionBanUsers); err != nil {
+			return
+		}
+	}
+
+	user, repoErr := u.userRepository.FindByUsername(ctx, username)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrUsernameNotFound
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	bans, repoErr := u.userRepository.FindAllBanByUserID(ctx, user.ID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs = make([]response.Ban, len(bans))
+
+	for i, ban := range bans {
+		rs[i] = response.Ban{
+			ID:             ban.ID,
+			Reason:         ban.Reason,
+			IssuerUsername: ban.Issuer.Username,
+			IsPermanent:    ban.IsPermanent(),
+			IsActive:       ban.LiftedAt.IsZero(),
+			LifterUsername: ban.Lifter.Username,
+			BannedOn:       ban.CreatedAt.Format(time.RFC822),
+		}
+
+		if !ban.IsPermanent() {
+			rs[i].ExpiresOn = ban.ExpiresAt.Format(time.RFC822)
+		}
+
+		if !ban.LiftedAt.IsZero() {
+			rs[i].LiftedOn = ban.LiftedAt.Format(time.RFC822)
+		}
+	}
+
+	return
+}
+
+func (u *userServiceImpl) LiftExpiredBans(ctx context.Context) (err error) {
+	userIDs, repoErr := u.userRepository.UnbannedExpiredUsers(ctx, time.Now())
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if len(userIDs) > 0 {
+		log.Printf("lifted the expired bans of %d users\n", len(userIDs))
+	}
+
+	return
+}
+
+func (u *userServiceImpl) findBanTarget(ctx context.Context, accessorRole, username string) (user entity.User, err error) {
+	user, repoErr := u.userRepository.FindByUsername(ctx, username)
+	if repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrUsernameNotFound
+			return
+		}
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// The staff can only be banned by those who can manage their roles.
+	if user.Role != service.RoleUser && !service.HasPermission(accessorRole, service.PermissionManageRoles) {
+		err = service.ErrAccessForbidden
+		return
+	}
+
+	return
+}
+
+func (u *userServiceImpl) ChangeFollowingState(
+	ctx context.Context,
+	accessorUserID,
+	usernameToFollow string,
+) (err error) {
+	user, repoErr := u.userRepository.FindByUsernameWithAccessor(ctx, accessorUserID, usernameToFollow)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	counter := response.UserCounter{
+		UserID:        user.ID,
+		TotalFollower: user.TotalFollower,
+	}
+
+	if user.IsFollowed {
+		if repoErr := u.userRepository.UnfollowUser(ctx, accessorUserID, user.ID); repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		if counter.TotalFollower > 0 {
+			counter.TotalFollower--
+		}
+	} else {
+		id, genErr := u.idGenerator.GenerateUserFollowID()
+		if genErr != nil {
+			err = service.MapError(genErr)
+			return
+		}
+
+		if repoErr := u.userRepository.FollowUser(ctx, id, accessorUserID, user.ID); repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		counter.TotalFollower++
+	}
+
+	u.publish(ctx, realtime.Event{
+		Topic: realtime.UserTopic(user.ID),
+		Type:  realtime.UserCounterChanged,
+		Data:  counter,
+	})
+
+	if !user.IsFollowed {
+		u.notify(ctx, entity.Notification{
+			User:  entity.User{ID: user.ID},
+			Actor: entity.User{ID: accessorUserID},
+			Type:  entity.FollowNotification,
+		})
+	}
+
+	return
+}
+
+func (u *userServiceImpl) GetAllThreadByUsername(
+	ctx context.Context,
+	accessorUserID,
+	username string,
+	page uint,
+	limit uint,
+	cursor string,
+	sort string,
+	f payload.ThreadFilter,
+) (rs response.Pagination[response.ManyThread], err error) {
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filter, err := service.MapThreadFilter(f)
+	if err != nil {
+		return
+	}
+
+	threadSort := service.MapThreadSort(sort, "")
+
+	threadCursor, err := service.DecodeCursor(cursor)
+	if err != nil {
+		return
+	}
+
+	// The trending score can't be resumed from a position.
+	if threadCursor != nil && threadSort == entity.TrendingSort {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	user, repoErr := u.userRepository.FindByUsername(ctx, username)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	pagination, repoErr := u.threadRepository.FindAllByUserIDWithPagination(
+		ctx,
+		accessorUserID,
+		user.ID,
+		threadSort,
+		filter,
+		entity.PageInfo{
+			Limit:  limit,
+			Page:   page,
+			Cursor: threadCursor,
+		},
+	)
+
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	rs.PageInfo.Page = pagination.PageInfo.Page
+	rs.PageInfo.Limit = pagination.PageInfo.Limit
+	rs.PageInfo.PageTotal = pagination.PageInfo.PageTotal
+	rs.PageInfo.Total = pagination.PageInfo.Total
+	rs.NextCursor = service.EncodeCursor(pagination.NextCursor)
+	rs.PrevCursor = service.EncodeCursor(pagination.PrevCursor)
+
+	rs.List = make([]response.ManyThread, len(pagination.List))
+
+	for i, item := range pagination.List {
+		thread := response.ManyThread{
+			ID:              item.ID,
+			Title:           item.Title,
+			CategoryID:      item.Category.ID,
+			CategoryName:    item.Category.Name,
+			PublishedOn:     item.CreatedAt.Format(time.RFC822),
+			IsLiked:         item.IsLiked,
+			IsFollowed:      item.IsFollowed,
 			Description:     item.Description,
 			TotalViewer:     item.TotalViewer,
 			TotalLike:       item.TotalLike,
@@ -396,3 +1135,214 @@ func (u *userServiceImpl) GetAllThreadByUsername(
 
 	return
 }
+
+// recordLoginFailure counts the failed login of the username and the IP address, then locks them out once they reach their limit.
+// The lockout doubles for every further failure. A failure is only logged, as it must not hide the login error.
+func (u *userServiceImpl) recordLoginFailure(ctx context.Context, username, ipAddress string) {
+	u.countLoginFailure(ctx, entity.UsernameLoginAttempt, username, maxUsernameLoginAttempts)
+
+	if ipAddress != "" {
+		u.countLoginFailure(ctx, entity.IPLoginAttempt, ipAddress, maxIPLoginAttempts)
+	}
+}
+
+func (u *userServiceImpl) countLoginFailure(
+	ctx context.Context,
+	kind entity.LoginAttemptKind,
+	value string,
+	maxAttempts uint,
+) {
+	now := time.Now()
+
+	failedAttempts, repoErr := u.loginAttemptRepository.IncrementFailure(ctx, kind, value, now.Add(-loginAttemptWindow))
+	if repoErr != nil {
+		log.Println(repoErr)
+		return
+	}
+
+	if failedAttempts < maxAttempts {
+		return
+	}
+
+	if repoErr := u.loginAttemptRepository.Lock(ctx, kind, value, now.Add(lockoutDuration(failedAttempts-maxAttempts))); repoErr != nil {
+		log.Println(repoErr)
+	}
+}
+
+// lockoutDuration returns the base duration doubled for every failure past the limit, capped at the max duration.
+func lockoutDuration(failuresPastLimit uint) time.Duration {
+	duration := lockoutBaseDuration
+	for i := uint(0); i < failuresPastLimit && duration < lockoutMaxDuration; i++ {
+		duration *= 2
+	}
+
+	if duration > lockoutMaxDuration {
+		duration = lockoutMaxDuration
+	}
+	return duration
+}
+
+// startSession stores a new session of the user for the device and issues its tokens.
+func (u *userServiceImpl) startSession(
+	ctx context.Context,
+	user entity.User,
+	device string,
+) (r response.Login, err error) {
+	sessionID, genErr := u.idGenerator.GenerateSessionID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	tokenPayload := generator.TokenPayload{
+		ID:           user.ID,
+		Username:     user.Username,
+		Role:         user.Role,
+		IsActive:     user.IsActive,
+		SessionID:    sessionID,
+		TokenVersion: user.TokenVersion,
+	}
+
+	token, genErr := u.tokenGenerator.GenerateToken(tokenPayload)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	refreshToken, genErr := u.tokenGenerator.GenerateRefreshToken()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	session := entity.Session{
+		ID:               sessionID,
+		User:             user,
+		Device:           device,
+		RefreshTokenHash: generator.HashRefreshToken(refreshToken),
+		TokenVersion:     user.TokenVersion,
+		ExpiresAt:        time.Now().Add(generator.RefreshTokenDuration),
+	}
+
+	if repoErr := u.sessionRepository.Insert(ctx, session); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	r.Token = token
+	r.RefreshToken = refreshToken
+	r.Role = user.Role
+
+	return
+}
+
+// sendUserToken stores a new single-use token of the user for the purpose, then emails the link containing it.
+func (u *userServiceImpl) sendUserToken(
+	ctx context.Context,
+	user entity.User,
+	purpose entity.UserTokenPurpose,
+) (err error) {
+	id, err := u.idGenerator.GenerateUserTokenID()
+	if err != nil {
+		return
+	}
+
+	token, err := u.tokenGenerator.GenerateOneTimeToken()
+	if err != nil {
+		return
+	}
+
+	duration := emailVerificationTokenDuration
+	if purpose == entity.PasswordResetToken {
+		duration = passwordResetTokenDuration
+	}
+
+	userToken := entity.UserToken{
+		ID:        id,
+		User:      user,
+		Purpose:   purpose,
+		TokenHash: generator.HashOneTimeToken(token),
+		ExpiresAt: time.Now().Add(duration),
+	}
+
+	if err = u.userTokenRepository.Insert(ctx, userToken); err != nil {
+		return
+	}
+
+	var message mailer.Message
+	if purpose == entity.PasswordResetToken {
+		message = mailer.Message{
+			To:      user.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf(
+				"Hi %s,\n\nOpen the link below to choose a new password, the link expires in 1 hour.\n\n%s/reset-password?token=%s\n\nIf you didn't request a password reset, you can ignore this email.",
+				user.Name, os.Getenv("FRONTEND_URL"), token,
+			),
+		}
+	} else {
+		message = mailer.Message{
+			To:      user.Email,
+			Subject: "Verify your email address",
+			Body: fmt.Sprintf(
+				"Hi %s,\n\nOpen the link below to verify your email address, the link expires in 24 hours.\n\n%s/verify-email?token=%s\n\nIf you didn't create an account, you can ignore this email.",
+				user.Name, os.Getenv("FRONTEND_URL"), token,
+			),
+		}
+	}
+
+	err = u.mailer.Send(ctx, message)
+	return
+}
+
+func newUserResponse(user entity.User) response.User {
+	return response.User{
+		UserID:         user.ID,
+		Username:       user.Username,
+		Email:          user.Email,
+		Name:           user.Name,
+		Role:           user.Role,
+		IsActive:       user.IsActive,
+		RegisteredOn:   user.CreatedAt.Format(time.RFC822),
+		TotalThread:    uint(user.TotalThread),
+		TotalFollower:  uint(user.TotalFollower),
+		TotalFollowing: uint(user.TotalFollowing),
+		IsFollowed:     user.IsFollowed,
+		Bio:            user.Bio,
+		AvatarURL:      user.AvatarURL,
+		Location:       user.Location,
+	}
+}
+
+// notify stores the notification and pushes it to its recipient.
+// A failure is only logged, as it must not fail the action that triggers it.
+func (u *userServiceImpl) notify(ctx context.Context, notification entity.Notification) {
+	id, genErr := u.idGenerator.GenerateNotificationID()
+	if genErr != nil {
+		log.Println(genErr)
+		return
+	}
+	notification.ID = id
+	notification.CreatedAt = time.Now()
+
+	if repoErr := u.notificationRepository.InsertAll(ctx, []entity.Notification{notification}); repoErr != nil {
+		log.Println(repoErr)
+		return
+	}
+
+	u.publish(ctx, service.NewNotificationEvent(notification))
+}
+
+// publish pushes the event to the realtime subscribers. A failure is only logged, as it must not fail the action that triggers it.
+func (u *userServiceImpl) publish(ctx context.Context, event realtime.Event) {
+	if pubErr := u.hub.Publish(ctx, event); pubErr != nil {
+		log.Println(pubErr)
+	}
+}
+
+// formatPersonalDataTime keeps an unset time empty instead of writing the zero time.
+func formatPersonalDataTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,13 +12,22 @@ import (
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/payload"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/model/response"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository"
+	mlr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/loginattempt/mocks"
+	mrp "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/mocks"
+	mnr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/notification/mocks"
+	msr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/session/mocks"
 	mtr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/thread/mocks"
 	mur "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/user/mocks"
+	mutr "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/repository/usertoken/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service"
+	mas "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/service/audit/mocks"
 	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator"
 	mig "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
 	mpg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
 	mtg "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/generator/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/mailer"
+	mml "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/mailer/mocks"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,16 +35,33 @@ import (
 func TestRegister(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
 	)
 
 	testCases := []struct {
@@ -217,6 +244,136 @@ func TestRegister(t *testing.T) {
 						return nil
 					},
 				).Once()
+
+				mockIDGen.On(
+					"GenerateUserTokenID",
+				).Return(
+					func() string {
+						return "k-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateOneTimeToken",
+				).Return(
+					func() string {
+						return "generatedonetimetoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserToken{})),
+				).Return(
+					func(ctx context.Context, userToken entity.UserToken) error {
+						return nil
+					},
+				).Once()
+
+				mockMailer.On(
+					"Send",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", mailer.Message{})),
+				).Return(
+					func(ctx context.Context, message mailer.Message) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return a valid ID, when the verification email can't be sent",
+			inputPayload: payload.Register{
+				Username: "erikrios",
+				Email:    "erikriosetiawan15@gmail.com",
+				Name:     "Erik Rio Setiawan",
+				Password: "erikriosetiawan",
+			},
+			expectedID:    "u-abcdef",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockIDGen.On(
+					"GenerateUserID",
+				).Return(
+					func() string {
+						return "u-abcdef"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"GenerateFromPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(p []byte, cost int) []byte {
+						return []byte("generatedpassword")
+					},
+					func(p []byte, cost int) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.User{})),
+				).Return(
+					func(ctx context.Context, user entity.User) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserTokenID",
+				).Return(
+					func() string {
+						return "k-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateOneTimeToken",
+				).Return(
+					func() string {
+						return "generatedonetimetoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserToken{})),
+				).Return(
+					func(ctx context.Context, userToken entity.UserToken) error {
+						return nil
+					},
+				).Once()
+
+				mockMailer.On(
+					"Send",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", mailer.Message{})),
+				).Return(
+					func(ctx context.Context, message mailer.Message) error {
+						return errors.New("smtp: connection refused")
+					},
+				).Once()
 			},
 		},
 	}
@@ -240,16 +397,74 @@ func TestRegister(t *testing.T) {
 func TestLogin(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	// The lockout isn't under test in the table, so the login attempts are never locked.
+	mockLoginAttemptRepository.On(
+		"FindLockedUntil",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		mock.AnythingOfType(fmt.Sprintf("%T", "")),
+	).Return(
+		func(ctx context.Context, username string, ipAddress string) time.Time {
+			return time.Time{}
+		},
+		func(ctx context.Context, username string, ipAddress string) error {
+			return nil
+		},
+	)
+
+	mockLoginAttemptRepository.On(
+		"IncrementFailure",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.UsernameLoginAttempt)),
+		mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+	).Return(
+		func(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) uint {
+			return 1
+		},
+		func(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) error {
+			return nil
+		},
+	)
+
+	mockLoginAttemptRepository.On(
+		"Delete",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.UsernameLoginAttempt)),
+		mock.AnythingOfType(fmt.Sprintf("%T", "")),
+	).Return(
+		func(ctx context.Context, kind entity.LoginAttemptKind, value string) error {
+			return repository.ErrRecordNotFound
+		},
 	)
 
 	testCases := []struct {
@@ -316,13 +531,13 @@ func TestLogin(t *testing.T) {
 			},
 		},
 		{
-			name: "it should return service.ErrUsernameNotFound error, when user is inactive",
+			name: "it should return service.ErrUserBanned error, when user is banned",
 			inputPayload: payload.Login{
 				Username: "erikrios",
 				Password: "erikriosetiawan",
 			},
 			expectedResponse: response.Login{},
-			expectedError:    service.ErrUsernameNotFound,
+			expectedError:    service.ErrUserBanned,
 			mockBehaviours: func() {
 				mockUserRepository.On(
 					"FindByUsername",
@@ -346,6 +561,29 @@ func TestLogin(t *testing.T) {
 						return nil
 					},
 				).Once()
+
+				mockPwdGen.On(
+					"CompareHashAndPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+				).Return(
+					func(hashedPassword []byte, password []byte) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"FindActiveBanByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+				).Return(
+					func(ctx context.Context, userID string) entity.Ban {
+						return entity.Ban{ID: "b-abcdefg", Reason: "Spamming"}
+					},
+					func(ctx context.Context, userID string) error {
+						return nil
+					},
+				).Once()
 			},
 		},
 		{
@@ -433,6 +671,17 @@ func TestLogin(t *testing.T) {
 					},
 				).Once()
 
+				mockIDGen.On(
+					"GenerateSessionID",
+				).Return(
+					func() string {
+						return "s-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
 				mockTokenGen.On(
 					"GenerateToken",
 					mock.AnythingOfType(fmt.Sprintf("%T", generator.TokenPayload{})),
@@ -453,8 +702,9 @@ func TestLogin(t *testing.T) {
 				Password: "erikriosetiawan",
 			},
 			expectedResponse: response.Login{
-				Token: "generatedtoken",
-				Role:  "user",
+				Token:        "generatedtoken",
+				RefreshToken: "generatedrefreshtoken",
+				Role:         "user",
 			},
 			expectedError: nil,
 			mockBehaviours: func() {
@@ -491,6 +741,17 @@ func TestLogin(t *testing.T) {
 					},
 				).Once()
 
+				mockIDGen.On(
+					"GenerateSessionID",
+				).Return(
+					func() string {
+						return "s-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
 				mockTokenGen.On(
 					"GenerateToken",
 					mock.AnythingOfType(fmt.Sprintf("%T", generator.TokenPayload{})),
@@ -502,6 +763,30 @@ func TestLogin(t *testing.T) {
 						return nil
 					},
 				).Once()
+
+				mockTokenGen.On(
+					"GenerateRefreshToken",
+				).Return(
+					func() string {
+						return "generatedrefreshtoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockSessionRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(session entity.Session) bool {
+						return session.ID == "s-abcdefg" &&
+							session.RefreshTokenHash == generator.HashRefreshToken("generatedrefreshtoken")
+					}),
+				).Return(
+					func(ctx context.Context, session entity.Session) error {
+						return nil
+					},
+				).Once()
 			},
 		},
 	}
@@ -520,163 +805,282 @@ func TestLogin(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("it should return the reason and the expiry of the ban, when user is banned temporarily", func(t *testing.T) {
+		expiresAt := time.Now().Add(48 * time.Hour)
+
+		mockUserRepository.On(
+			"FindByUsername",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, username string) entity.User {
+				return entity.User{ID: "u-abcdef", Username: "erikrios", Password: "erikriosetiawan", Role: "user", IsActive: false}
+			},
+			func(ctx context.Context, username string) error {
+				return nil
+			},
+		).Once()
+
+		mockPwdGen.On(
+			"CompareHashAndPassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+		).Return(
+			func(hashedPassword []byte, password []byte) error {
+				return nil
+			},
+		).Once()
+
+		mockUserRepository.On(
+			"FindActiveBanByUserID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"u-abcdef",
+		).Return(
+			func(ctx context.Context, userID string) entity.Ban {
+				return entity.Ban{ID: "b-abcdefg", Reason: "Harrashment", ExpiresAt: expiresAt}
+			},
+			func(ctx context.Context, userID string) error {
+				return nil
+			},
+		).Once()
+
+		_, gotErr := userService.Login(context.Background(), payload.Login{Username: "erikrios", Password: "erikriosetiawan"})
+
+		var bannedErr *service.BannedError
+		if assert.ErrorAs(t, gotErr, &bannedErr) {
+			assert.Equal(t, "Harrashment", bannedErr.Reason)
+			assert.Equal(t, expiresAt, bannedErr.ExpiresAt)
+		}
+	})
+
+	t.Run("it should return service.ErrEmailNotVerified error, when the verification is required and the email is not verified", func(t *testing.T) {
+		t.Setenv("REQUIRE_EMAIL_VERIFICATION", "true")
+
+		mockUserRepository.On(
+			"FindByUsername",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, username string) entity.User {
+				return entity.User{
+					ID:         "u-abcdef",
+					Username:   "erikrios",
+					Password:   "erikriosetiawan",
+					Role:       "user",
+					IsActive:   true,
+					IsVerified: false,
+				}
+			},
+			func(ctx context.Context, username string) error {
+				return nil
+			},
+		).Once()
+
+		mockPwdGen.On(
+			"CompareHashAndPassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+		).Return(
+			func(hashedPassword []byte, password []byte) error {
+				return nil
+			},
+		).Once()
+
+		_, gotErr := userService.Login(context.Background(), payload.Login{Username: "erikrios", Password: "erikriosetiawan"})
+		assert.ErrorIs(t, gotErr, service.ErrEmailNotVerified)
+	})
 }
 
-func TestGetAll(t *testing.T) {
+func TestRefresh(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
 
-	now := time.Now()
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
 	)
 
 	testCases := []struct {
-		name                string
-		inputAccessorUserID string
-		inputOrderBy        string
-		inputStatus         string
-		inputPage           uint
-		inputLimit          uint
-		inputKeyword        string
-		expectedResponse    response.Pagination[response.User]
-		expectedError       error
-		mockBehaviours      func()
+		name             string
+		inputPayload     payload.RefreshToken
+		expectedResponse response.Login
+		expectedError    error
+		mockBehaviours   func()
 	}{
 		{
-			name:                "it should return service.ErrRepository, when user repository return an repository.ErrDatabase error",
-			inputAccessorUserID: "u-ZrxmQS",
-			inputOrderBy:        "ranking",
-			inputStatus:         "banned",
-			inputPage:           0,
-			inputLimit:          0,
-			inputKeyword:        "random keyword",
-			expectedResponse:    response.Pagination[response.User]{},
-			expectedError:       service.ErrRepository,
+			name:             "it should return service.ErrInvalidPayload error, when payload is invalid",
+			inputPayload:     payload.RefreshToken{RefreshToken: ""},
+			expectedResponse: response.Login{},
+			expectedError:    service.ErrInvalidPayload,
+			mockBehaviours:   func() {},
+		},
+		{
+			name:             "it should return service.ErrInvalidToken error, when the refresh token is not found",
+			inputPayload:     payload.RefreshToken{RefreshToken: "refreshtoken"},
+			expectedResponse: response.Login{},
+			expectedError:    service.ErrInvalidToken,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindAllWithStatusAndPagination",
+				mockSessionRepository.On(
+					"FindByRefreshTokenHash",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserOrderBy(entity.Ranking))),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserStatus(entity.Banned))),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						orderBy entity.UserOrderBy,
-						userStatus entity.UserStatus,
-						pageInfo entity.PageInfo,
-						keyword string,
-					) entity.Pagination[entity.User] {
-						return entity.Pagination[entity.User]{}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{}
 					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						orderBy entity.UserOrderBy,
-						userStatus entity.UserStatus,
-						pageInfo entity.PageInfo,
-						keyword string,
-					) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, arg string) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return valid users pagination, when no error is returned",
-			inputAccessorUserID: "u-ZrxmQS",
-			inputOrderBy:        "registered_date",
-			inputStatus:         "active",
-			inputPage:           1,
-			inputLimit:          35,
-			inputKeyword:        "random keyword",
-			expectedResponse: response.Pagination[response.User]{
-				List: []response.User{
-					{
-						UserID:         "u-ZrxmQS",
-						Username:       "erikrios",
-						Email:          "erikriosetiawan@gmail.com",
-						Name:           "Erik Rio Setiawan",
-						Role:           "user",
-						IsActive:       true,
-						RegisteredOn:   now.Format(time.RFC822),
-						TotalThread:    15,
-						TotalFollower:  200,
-						TotalFollowing: 2,
-						IsFollowed:     false,
+			name:             "it should return service.ErrInvalidToken error and revoke the session, when a rotated refresh token is reused",
+			inputPayload:     payload.RefreshToken{RefreshToken: "refreshtoken"},
+			expectedResponse: response.Login{},
+			expectedError:    service.ErrInvalidToken,
+			mockBehaviours: func() {
+				mockSessionRepository.On(
+					"FindByRefreshTokenHash",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", IsActive: true}, RefreshTokenHash: generator.HashRefreshToken("newerrefreshtoken"), PreviousRefreshTokenHash: generator.HashRefreshToken("refreshtoken"), ExpiresAt: time.Now().Add(time.Hour)}
 					},
-				},
-				PageInfo: response.PageInfo{
-					Limit:     35,
-					Page:      1,
-					PageTotal: 1,
-					Total:     29,
-				},
+					func(ctx context.Context, arg string) error {
+						return nil
+					},
+				).Once()
+
+				mockSessionRepository.On(
+					"Revoke",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
 			},
-			expectedError: nil,
+		},
+		{
+			name:             "it should return service.ErrInvalidToken error, when the session is expired",
+			inputPayload:     payload.RefreshToken{RefreshToken: "refreshtoken"},
+			expectedResponse: response.Login{},
+			expectedError:    service.ErrInvalidToken,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindAllWithStatusAndPagination",
+				mockSessionRepository.On(
+					"FindByRefreshTokenHash",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserOrderBy(entity.Ranking))),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserStatus(entity.Banned))),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", IsActive: true}, RefreshTokenHash: generator.HashRefreshToken("refreshtoken"), ExpiresAt: time.Now().Add(-time.Hour)}
+					},
+					func(ctx context.Context, arg string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:             "it should return service.ErrInvalidToken error, when the token version has been bumped",
+			inputPayload:     payload.RefreshToken{RefreshToken: "refreshtoken"},
+			expectedResponse: response.Login{},
+			expectedError:    service.ErrInvalidToken,
+			mockBehaviours: func() {
+				mockSessionRepository.On(
+					"FindByRefreshTokenHash",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						orderBy entity.UserOrderBy,
-						userStatus entity.UserStatus,
-						pageInfo entity.PageInfo,
-						keyword string,
-					) entity.Pagination[entity.User] {
-						return entity.Pagination[entity.User]{
-							List: []entity.User{
-								{
-									ID:             "u-ZrxmQS",
-									Username:       "erikrios",
-									Email:          "erikriosetiawan@gmail.com",
-									Name:           "Erik Rio Setiawan",
-									Role:           "user",
-									IsActive:       true,
-									TotalThread:    15,
-									TotalFollower:  200,
-									TotalFollowing: 2,
-									IsFollowed:     false,
-									CreatedAt:      now,
-									UpdatedAt:      now,
-								},
-							},
-							PageInfo: entity.PageInfo{
-								Limit:     35,
-								Page:      1,
-								PageTotal: 1,
-								Total:     29,
-							},
-						}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", IsActive: true, TokenVersion: 1}, RefreshTokenHash: generator.HashRefreshToken("refreshtoken"), ExpiresAt: time.Now().Add(time.Hour)}
 					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						orderBy entity.UserOrderBy,
-						userStatus entity.UserStatus,
-						pageInfo entity.PageInfo,
-						keyword string,
-					) error {
+					func(ctx context.Context, arg string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:             "it should return valid response, when no error is returned",
+			inputPayload:     payload.RefreshToken{RefreshToken: "refreshtoken"},
+			expectedResponse: response.Login{Token: "generatedtoken", RefreshToken: "newrefreshtoken", Role: "user"},
+			expectedError:    nil,
+			mockBehaviours: func() {
+				mockSessionRepository.On(
+					"FindByRefreshTokenHash",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", Username: "erikrios", Role: "user", IsActive: true}, RefreshTokenHash: generator.HashRefreshToken("refreshtoken"), ExpiresAt: time.Now().Add(time.Hour)}
+					},
+					func(ctx context.Context, arg string) error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateRefreshToken",
+				).Return(
+					func() string {
+						return "newrefreshtoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockSessionRepository.On(
+					"Rotate",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"s-abcdefg",
+					generator.HashRefreshToken("refreshtoken"),
+					generator.HashRefreshToken("newrefreshtoken"),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, ID string, refreshTokenHash string, newRefreshTokenHash string, expiresAt time.Time) error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateToken",
+					mock.MatchedBy(func(p generator.TokenPayload) bool {
+						return p.SessionID == "s-abcdefg"
+					}),
+				).Return(
+					func(p generator.TokenPayload) string {
+						return "generatedtoken"
+					},
+					func(p generator.TokenPayload) error {
 						return nil
 					},
 				).Once()
@@ -688,132 +1092,83 @@ func TestGetAll(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotPagination, gotError := userService.GetAll(
-				context.Background(),
-				testCase.inputAccessorUserID,
-				testCase.inputOrderBy,
-				testCase.inputStatus,
-				testCase.inputPage,
-				testCase.inputLimit,
-				testCase.inputKeyword,
-			)
+			gotResponse, gotErr := userService.Refresh(context.Background(), testCase.inputPayload)
 
 			if testCase.expectedError != nil {
-				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
-				assert.NoError(t, testCase.expectedError)
-				assert.ElementsMatch(t, testCase.expectedResponse.List, gotPagination.List)
-				assert.Equal(t, testCase.expectedResponse.PageInfo, gotPagination.PageInfo)
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
 			}
 		})
 	}
 }
 
-func TestGetOwn(t *testing.T) {
+func TestLogout(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
 
-	now := time.Now()
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
 	)
 
 	testCases := []struct {
-		name                  string
-		inputAccessorUserID   string
-		inputAccessorUsername string
-		expectedResponse      response.User
-		expectedError         error
-		mockBehaviours        func()
+		name           string
+		inputSessionID string
+		expectedError  error
+		mockBehaviours func()
 	}{
 		{
-			name:                  "it should return service.ErrRepository, when user repository return an repository.ErrDatabase error",
-			inputAccessorUserID:   "u-ZrxmQS",
-			inputAccessorUsername: "erikrios",
-			expectedResponse:      response.User{},
-			expectedError:         service.ErrRepository,
+			name:           "it should return service.ErrDataNotFound error, when the session is already revoked",
+			inputSessionID: "s-abcdefg",
+			expectedError:  service.ErrDataNotFound,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsernameWithAccessor",
+				mockSessionRepository.On(
+					"Revoke",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) entity.User {
-						return entity.User{}
-					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, ID string) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
 		{
-			name:                  "it should return valid user, when no error is returned",
-			inputAccessorUserID:   "u-ZrxmQS",
-			inputAccessorUsername: "erikrios",
-			expectedResponse: response.User{
-				UserID:         "u-ZrxmQS",
-				Username:       "erikrios",
-				Email:          "erikriosetiawan@gmail.com",
-				Name:           "Erik Rio Setiawan",
-				Role:           "user",
-				IsActive:       true,
-				RegisteredOn:   now.Format(time.RFC822),
-				TotalThread:    15,
-				TotalFollower:  200,
-				TotalFollowing: 2,
-				IsFollowed:     false,
-			},
-			expectedError: nil,
+			name:           "it should return nil error, when no error is returned",
+			inputSessionID: "s-abcdefg",
+			expectedError:  nil,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsernameWithAccessor",
+				mockSessionRepository.On(
+					"Revoke",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       true,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     false,
-							CreatedAt:      now,
-							UpdatedAt:      now,
-						}
-					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) error {
+					func(ctx context.Context, ID string) error {
 						return nil
 					},
 				).Once()
@@ -825,353 +1180,185 @@ func TestGetOwn(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotUser, gotError := userService.GetOwn(
-				context.Background(),
-				testCase.inputAccessorUserID,
-				testCase.inputAccessorUsername,
-			)
+			gotErr := userService.Logout(context.Background(), testCase.inputSessionID)
 
 			if testCase.expectedError != nil {
-				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
-				assert.NoError(t, testCase.expectedError)
-				assert.Equal(t, testCase.expectedResponse, gotUser)
+				assert.NoError(t, gotErr)
 			}
 		})
 	}
 }
 
-func TestGetByUsername(t *testing.T) {
+func TestIsTokenRevoked(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
 
-	now := time.Now()
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
 	)
 
 	testCases := []struct {
-		name                  string
-		inputAccessorUserID   string
-		inputAccessorUsername string
-		expectedResponse      response.User
-		expectedError         error
-		mockBehaviours        func()
+		name              string
+		inputSessionID    string
+		inputUserID       string
+		inputTokenVersion uint
+		expectedRevoked   bool
+		expectedError     error
+		mockBehaviours    func()
 	}{
 		{
-			name:                  "it should return service.ErrRepository, when user repository return an repository.ErrDatabase error",
-			inputAccessorUserID:   "u-ZrxmQS",
-			inputAccessorUsername: "erikrios",
-			expectedResponse:      response.User{},
-			expectedError:         service.ErrRepository,
+			name:              "it should return service.ErrRepository error, when repository return an ErrDatabase error",
+			inputSessionID:    "s-abcdefg",
+			inputUserID:       "u-abcdef",
+			inputTokenVersion: 1,
+			expectedRevoked:   false,
+			expectedError:     service.ErrRepository,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsernameWithAccessor",
+				mockSessionRepository.On(
+					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) entity.User {
-						return entity.User{}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{}
 					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) error {
+					func(ctx context.Context, arg string) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                  "it should return valid user, when no error is returned",
-			inputAccessorUserID:   "u-ZrxmQS",
-			inputAccessorUsername: "erikrios",
-			expectedResponse: response.User{
-				UserID:         "u-ZrxmQS",
-				Username:       "erikrios",
-				Email:          "erikriosetiawan@gmail.com",
-				Name:           "Erik Rio Setiawan",
-				Role:           "user",
-				IsActive:       true,
-				RegisteredOn:   now.Format(time.RFC822),
-				TotalThread:    15,
-				TotalFollower:  200,
-				TotalFollowing: 2,
-				IsFollowed:     false,
-			},
-			expectedError: nil,
+			name:              "it should return true, when the session is not found",
+			inputSessionID:    "s-abcdefg",
+			inputUserID:       "u-abcdef",
+			inputTokenVersion: 1,
+			expectedRevoked:   true,
+			expectedError:     nil,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsernameWithAccessor",
+				mockSessionRepository.On(
+					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       true,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     false,
-							CreatedAt:      now,
-							UpdatedAt:      now,
-						}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{}
 					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						username string,
-					) error {
-						return nil
+					func(ctx context.Context, arg string) error {
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.mockBehaviours()
-
-			gotUser, gotError := userService.GetByUsername(
-				context.Background(),
-				testCase.inputAccessorUserID,
-				testCase.inputAccessorUsername,
-			)
-
-			if testCase.expectedError != nil {
-				assert.ErrorIs(t, gotError, testCase.expectedError)
-			} else {
-				assert.NoError(t, testCase.expectedError)
-				assert.Equal(t, testCase.expectedResponse, gotUser)
-			}
-		})
-	}
-}
-
-func TestChangeBannedState(t *testing.T) {
-	mockUserRepository := &mur.UserRepository{}
-	mockThreadRepository := &mtr.ThreadRepository{}
-	mockIDGen := &mig.IDGenerator{}
-	mockPwdGen := &mpg.PasswordGenerator{}
-	mockTokenGen := &mtg.TokenGenerator{}
-
-	now := time.Now()
-
-	var userService UserService = NewUserServiceImpl(
-		mockUserRepository,
-		mockThreadRepository,
-		mockIDGen,
-		mockPwdGen,
-		mockTokenGen,
-	)
-
-	testCases := []struct {
-		name              string
-		inputAccessorRole string
-		inputUsername     string
-		expectedError     error
-		mockBehaviours    func()
-	}{
-		{
-			name:              "it should return service.ErrAccessForbidden, when accessor role is not admin",
-			inputAccessorRole: "user",
-			inputUsername:     "naruto",
-			expectedError:     service.ErrAccessForbidden,
-			mockBehaviours:    func() {},
-		},
 		{
-			name:              "it should return service.ErrRepository, when user repository return a repository.ErrDatabase error",
-			inputAccessorRole: "admin",
-			inputUsername:     "naruto",
-			expectedError:     service.ErrRepository,
+			name:              "it should return true, when the session is revoked",
+			inputSessionID:    "s-abcdefg",
+			inputUserID:       "u-abcdef",
+			inputTokenVersion: 1,
+			expectedRevoked:   true,
+			expectedError:     nil,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsername",
+				mockSessionRepository.On(
+					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						username string,
-					) entity.User {
-						return entity.User{}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", TokenVersion: 1}, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: time.Now()}
 					},
-					func(
-						ctx context.Context,
-						username string,
-					) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, arg string) error {
+						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:              "it should return service.ErrRepository, when banned user return a repository.ErrDatabase error",
-			inputAccessorRole: "admin",
-			inputUsername:     "naruto",
-			expectedError:     service.ErrRepository,
+			name:              "it should return true, when the token version has been bumped",
+			inputSessionID:    "s-abcdefg",
+			inputUserID:       "u-abcdef",
+			inputTokenVersion: 1,
+			expectedRevoked:   true,
+			expectedError:     nil,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsername",
+				mockSessionRepository.On(
+					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						username string,
-					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       true,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     false,
-							CreatedAt:      now,
-							UpdatedAt:      now,
-						}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", TokenVersion: 2}, ExpiresAt: time.Now().Add(time.Hour)}
 					},
-					func(
-						ctx context.Context,
-						username string,
-					) error {
+					func(ctx context.Context, arg string) error {
 						return nil
 					},
 				).Once()
-
-				mockUserRepository.On(
-					"BannedUser",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, userID string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
 			},
 		},
 		{
-			name:              "it should return service.ErrRepository, when unbanned user return a repository.ErrDatabase error",
-			inputAccessorRole: "admin",
-			inputUsername:     "naruto",
-			expectedError:     service.ErrRepository,
+			name:              "it should return true, when the session belongs to another user",
+			inputSessionID:    "s-abcdefg",
+			inputUserID:       "u-ghijkl",
+			inputTokenVersion: 1,
+			expectedRevoked:   true,
+			expectedError:     nil,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsername",
+				mockSessionRepository.On(
+					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						username string,
-					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       false,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     false,
-							CreatedAt:      now,
-							UpdatedAt:      now,
-						}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", TokenVersion: 1}, ExpiresAt: time.Now().Add(time.Hour)}
 					},
-					func(
-						ctx context.Context,
-						username string,
-					) error {
+					func(ctx context.Context, arg string) error {
 						return nil
 					},
 				).Once()
-
-				mockUserRepository.On(
-					"UnbannedUser",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, userID string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
 			},
 		},
 		{
-			name:              "it should return nil error, when no error is returned from user repository",
-			inputAccessorRole: "admin",
-			inputUsername:     "naruto",
+			name:              "it should return false, when the session is still active",
+			inputSessionID:    "s-abcdefg",
+			inputUserID:       "u-abcdef",
+			inputTokenVersion: 1,
+			expectedRevoked:   false,
 			expectedError:     nil,
 			mockBehaviours: func() {
-				mockUserRepository.On(
-					"FindByUsername",
+				mockSessionRepository.On(
+					"FindByID",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(
-						ctx context.Context,
-						username string,
-					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       false,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     false,
-							CreatedAt:      now,
-							UpdatedAt:      now,
-						}
+					func(ctx context.Context, arg string) entity.Session {
+						return entity.Session{ID: "s-abcdefg", User: entity.User{ID: "u-abcdef", TokenVersion: 1}, ExpiresAt: time.Now().Add(time.Hour)}
 					},
-					func(
-						ctx context.Context,
-						username string,
-					) error {
-						return nil
-					},
-				).Once()
-
-				mockUserRepository.On(
-					"UnbannedUser",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, userID string) error {
+					func(ctx context.Context, arg string) error {
 						return nil
 					},
 				).Once()
@@ -1183,68 +1370,101 @@ func TestChangeBannedState(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotError := userService.ChangeBannedState(
-				context.Background(),
-				testCase.inputAccessorRole,
-				testCase.inputUsername,
-			)
+			gotRevoked, gotErr := userService.IsTokenRevoked(context.Background(), testCase.inputSessionID, testCase.inputUserID, testCase.inputTokenVersion)
 
 			if testCase.expectedError != nil {
-				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
-				assert.NoError(t, testCase.expectedError)
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedRevoked, gotRevoked)
 			}
 		})
 	}
 }
 
-func TestChangeFollowingState(t *testing.T) {
+func TestGetAll(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
 
 	now := time.Now()
 
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
 	)
 
 	testCases := []struct {
-		name                  string
-		inputAccessorUserID   string
-		inputUsernameToFollow string
-		expectedError         error
-		mockBehaviours        func()
-	}{
-		{
-			name:                  "it should return service.ErrRepository, when user repository return a repository.ErrDatabase error",
-			inputAccessorUserID:   "u-ZrxmQS",
-			inputUsernameToFollow: "naruto",
-			expectedError:         service.ErrRepository,
+		name                string
+		inputAccessorUserID string
+		inputOrderBy        string
+		inputStatus         string
+		inputPage           uint
+		inputLimit          uint
+		inputKeyword        string
+		expectedResponse    response.Pagination[response.User]
+		expectedError       error
+		mockBehaviours      func()
+	}{
+		{
+			name:                "it should return service.ErrRepository, when user repository return an repository.ErrDatabase error",
+			inputAccessorUserID: "u-ZrxmQS",
+			inputOrderBy:        "ranking",
+			inputStatus:         "banned",
+			inputPage:           0,
+			inputLimit:          0,
+			inputKeyword:        "random keyword",
+			expectedResponse:    response.Pagination[response.User]{},
+			expectedError:       service.ErrRepository,
 			mockBehaviours: func() {
 				mockUserRepository.On(
-					"FindByUsernameWithAccessor",
+					"FindAllWithStatusAndPagination",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserOrderBy(entity.Ranking))),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserStatus(entity.Banned))),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(
 						ctx context.Context,
 						accessorUserID string,
-						username string,
-					) entity.User {
-						return entity.User{}
+						orderBy entity.UserOrderBy,
+						userStatus entity.UserStatus,
+						pageInfo entity.PageInfo,
+						keyword string,
+					) entity.Pagination[entity.User] {
+						return entity.Pagination[entity.User]{}
 					},
 					func(
 						ctx context.Context,
 						accessorUserID string,
-						username string,
+						orderBy entity.UserOrderBy,
+						userStatus entity.UserStatus,
+						pageInfo entity.PageInfo,
+						keyword string,
 					) error {
 						return repository.ErrDatabase
 					},
@@ -1252,62 +1472,168 @@ func TestChangeFollowingState(t *testing.T) {
 			},
 		},
 		{
-			name:                  "it should return service.ErrRepository, when unfollow user return a repository.ErrDatabase error",
-			inputAccessorUserID:   "u-ZrxmQS",
-			inputUsernameToFollow: "erikrios",
-			expectedError:         service.ErrRepository,
+			name:                "it should return valid users pagination, when no error is returned",
+			inputAccessorUserID: "u-ZrxmQS",
+			inputOrderBy:        "registered_date",
+			inputStatus:         "active",
+			inputPage:           1,
+			inputLimit:          35,
+			inputKeyword:        "random keyword",
+			expectedResponse: response.Pagination[response.User]{
+				List: []response.User{
+					{
+						UserID:         "u-ZrxmQS",
+						Username:       "erikrios",
+						Email:          "erikriosetiawan@gmail.com",
+						Name:           "Erik Rio Setiawan",
+						Role:           "user",
+						IsActive:       true,
+						RegisteredOn:   now.Format(time.RFC822),
+						TotalThread:    15,
+						TotalFollower:  200,
+						TotalFollowing: 2,
+						IsFollowed:     false,
+					},
+				},
+				PageInfo: response.PageInfo{
+					Limit:     35,
+					Page:      1,
+					PageTotal: 1,
+					Total:     29,
+				},
+			},
+			expectedError: nil,
 			mockBehaviours: func() {
 				mockUserRepository.On(
-					"FindByUsernameWithAccessor",
+					"FindAllWithStatusAndPagination",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserOrderBy(entity.Ranking))),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserStatus(entity.Banned))),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(
 						ctx context.Context,
 						accessorUserID string,
-						username string,
-					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       false,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     true,
-							CreatedAt:      now,
-							UpdatedAt:      now,
+						orderBy entity.UserOrderBy,
+						userStatus entity.UserStatus,
+						pageInfo entity.PageInfo,
+						keyword string,
+					) entity.Pagination[entity.User] {
+						return entity.Pagination[entity.User]{
+							List: []entity.User{
+								{
+									ID:             "u-ZrxmQS",
+									Username:       "erikrios",
+									Email:          "erikriosetiawan@gmail.com",
+									Name:           "Erik Rio Setiawan",
+									Role:           "user",
+									IsActive:       true,
+									TotalThread:    15,
+									TotalFollower:  200,
+									TotalFollowing: 2,
+									IsFollowed:     false,
+									CreatedAt:      now,
+									UpdatedAt:      now,
+								},
+							},
+							PageInfo: entity.PageInfo{
+								Limit:     35,
+								Page:      1,
+								PageTotal: 1,
+								Total:     29,
+							},
 						}
 					},
 					func(
 						ctx context.Context,
 						accessorUserID string,
-						username string,
+						orderBy entity.UserOrderBy,
+						userStatus entity.UserStatus,
+						pageInfo entity.PageInfo,
+						keyword string,
 					) error {
 						return nil
 					},
 				).Once()
-
-				mockUserRepository.On(
-					"UnfollowUser",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, accessorUserID, userID string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
 			},
 		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotPagination, gotError := userService.GetAll(
+				context.Background(),
+				testCase.inputAccessorUserID,
+				testCase.inputOrderBy,
+				testCase.inputStatus,
+				testCase.inputPage,
+				testCase.inputLimit,
+				"",
+				testCase.inputKeyword,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, testCase.expectedError)
+				assert.ElementsMatch(t, testCase.expectedResponse.List, gotPagination.List)
+				assert.Equal(t, testCase.expectedResponse.PageInfo, gotPagination.PageInfo)
+			}
+		})
+	}
+}
+
+func TestGetOwn(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	now := time.Now()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	testCases := []struct {
+		name                  string
+		inputAccessorUserID   string
+		inputAccessorUsername string
+		expectedResponse      response.User
+		expectedError         error
+		mockBehaviours        func()
+	}{
 		{
-			name:                  "it should return service.ErrRepository, when generate id return an error",
+			name:                  "it should return service.ErrRepository, when user repository return an repository.ErrDatabase error",
 			inputAccessorUserID:   "u-ZrxmQS",
-			inputUsernameToFollow: "erikrios",
+			inputAccessorUsername: "erikrios",
+			expectedResponse:      response.User{},
 			expectedError:         service.ErrRepository,
 			mockBehaviours: func() {
 				mockUserRepository.On(
@@ -1321,47 +1647,36 @@ func TestChangeFollowingState(t *testing.T) {
 						accessorUserID string,
 						username string,
 					) entity.User {
-						return entity.User{
-							ID:             "u-ZrxmQS",
-							Username:       "erikrios",
-							Email:          "erikriosetiawan@gmail.com",
-							Name:           "Erik Rio Setiawan",
-							Role:           "user",
-							IsActive:       false,
-							TotalThread:    15,
-							TotalFollower:  200,
-							TotalFollowing: 2,
-							IsFollowed:     false,
-							CreatedAt:      now,
-							UpdatedAt:      now,
-						}
+						return entity.User{}
 					},
 					func(
 						ctx context.Context,
 						accessorUserID string,
 						username string,
 					) error {
-						return nil
-					},
-				).Once()
-
-				mockIDGen.On(
-					"GenerateUserFollowID",
-				).Return(
-					func() string {
-						return ""
-					},
-					func() error {
-						return errors.New("Something went wrong.")
+						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                  "it should return service.ErrRepository, when follow user return a repository.ErrDatabase error",
+			name:                  "it should return valid user, when no error is returned",
 			inputAccessorUserID:   "u-ZrxmQS",
-			inputUsernameToFollow: "erikrios",
-			expectedError:         service.ErrRepository,
+			inputAccessorUsername: "erikrios",
+			expectedResponse: response.User{
+				UserID:         "u-ZrxmQS",
+				Username:       "erikrios",
+				Email:          "erikriosetiawan@gmail.com",
+				Name:           "Erik Rio Setiawan",
+				Role:           "user",
+				IsActive:       true,
+				RegisteredOn:   now.Format(time.RFC822),
+				TotalThread:    15,
+				TotalFollower:  200,
+				TotalFollowing: 2,
+				IsFollowed:     false,
+			},
+			expectedError: nil,
 			mockBehaviours: func() {
 				mockUserRepository.On(
 					"FindByUsernameWithAccessor",
@@ -1380,7 +1695,7 @@ func TestChangeFollowingState(t *testing.T) {
 							Email:          "erikriosetiawan@gmail.com",
 							Name:           "Erik Rio Setiawan",
 							Role:           "user",
-							IsActive:       false,
+							IsActive:       true,
 							TotalThread:    15,
 							TotalFollower:  200,
 							TotalFollowing: 2,
@@ -1397,36 +1712,120 @@ func TestChangeFollowingState(t *testing.T) {
 						return nil
 					},
 				).Once()
+			},
+		},
+	}
 
-				mockIDGen.On(
-					"GenerateUserFollowID",
-				).Return(
-					func() string {
-						return "f-abcdefg"
-					},
-					func() error {
-						return nil
-					},
-				).Once()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotUser, gotError := userService.GetOwn(
+				context.Background(),
+				testCase.inputAccessorUserID,
+				testCase.inputAccessorUsername,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, testCase.expectedError)
+				assert.Equal(t, testCase.expectedResponse, gotUser)
+			}
+		})
+	}
+}
+
+func TestGetByUsername(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	now := time.Now()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
 
+	testCases := []struct {
+		name                  string
+		inputAccessorUserID   string
+		inputAccessorUsername string
+		expectedResponse      response.User
+		expectedError         error
+		mockBehaviours        func()
+	}{
+		{
+			name:                  "it should return service.ErrRepository, when user repository return an repository.ErrDatabase error",
+			inputAccessorUserID:   "u-ZrxmQS",
+			inputAccessorUsername: "erikrios",
+			expectedResponse:      response.User{},
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
 				mockUserRepository.On(
-					"FollowUser",
+					"FindByUsernameWithAccessor",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
-					func(ctx context.Context, id, accessorUserID, userID string) error {
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) entity.User {
+						return entity.User{}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:                  "it should return nil error, when no error is returned",
+			name:                  "it should return valid user, when no error is returned",
 			inputAccessorUserID:   "u-ZrxmQS",
-			inputUsernameToFollow: "erikrios",
-			expectedError:         nil,
+			inputAccessorUsername: "erikrios",
+			expectedResponse: response.User{
+				UserID:         "u-ZrxmQS",
+				Username:       "erikrios",
+				Email:          "erikriosetiawan@gmail.com",
+				Name:           "Erik Rio Setiawan",
+				Role:           "user",
+				IsActive:       true,
+				RegisteredOn:   now.Format(time.RFC822),
+				TotalThread:    15,
+				TotalFollower:  200,
+				TotalFollowing: 2,
+				IsFollowed:     false,
+			},
+			expectedError: nil,
 			mockBehaviours: func() {
 				mockUserRepository.On(
 					"FindByUsernameWithAccessor",
@@ -1445,7 +1844,7 @@ func TestChangeFollowingState(t *testing.T) {
 							Email:          "erikriosetiawan@gmail.com",
 							Name:           "Erik Rio Setiawan",
 							Role:           "user",
-							IsActive:       false,
+							IsActive:       true,
 							TotalThread:    15,
 							TotalFollower:  200,
 							TotalFollowing: 2,
@@ -1462,29 +1861,6 @@ func TestChangeFollowingState(t *testing.T) {
 						return nil
 					},
 				).Once()
-
-				mockIDGen.On(
-					"GenerateUserFollowID",
-				).Return(
-					func() string {
-						return "f-abcdefg"
-					},
-					func() error {
-						return nil
-					},
-				).Once()
-
-				mockUserRepository.On(
-					"FollowUser",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id, accessorUserID, userID string) error {
-						return nil
-					},
-				).Once()
 			},
 		},
 	}
@@ -1493,56 +1869,86 @@ func TestChangeFollowingState(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotError := userService.ChangeFollowingState(
+			gotUser, gotError := userService.GetByUsername(
 				context.Background(),
 				testCase.inputAccessorUserID,
-				testCase.inputUsernameToFollow,
+				testCase.inputAccessorUsername,
 			)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotError, testCase.expectedError)
 			} else {
 				assert.NoError(t, testCase.expectedError)
+				assert.Equal(t, testCase.expectedResponse, gotUser)
 			}
 		})
 	}
 }
 
-func TestGetAllThreadByUsername(t *testing.T) {
+func TestBan(t *testing.T) {
 	mockUserRepository := &mur.UserRepository{}
 	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockPwdGen := &mpg.PasswordGenerator{}
 	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
 
 	var userService UserService = NewUserServiceImpl(
 		mockUserRepository,
 		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
 		mockIDGen,
 		mockPwdGen,
 		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
 	)
 
-	now := time.Now()
+	dummyUser := entity.User{ID: "u-ZrxmQS", Username: "naruto", Role: "user", IsActive: true}
 
 	testCases := []struct {
-		name                string
-		inputAccessorUserID string
-		inputUsername       string
-		inputPage           uint
-		inputLimit          uint
-		expectedResponse    response.Pagination[response.ManyThread]
-		expectedError       error
-		mockBehaviours      func()
+		name              string
+		inputAccessorRole string
+		inputUsername     string
+		inputPayload      payload.BanUser
+		expectedError     error
+		mockBehaviours    func()
 	}{
 		{
-			name:                "it should return service.ErrRepository, when user repository return repository.ErrDatabase error",
-			inputAccessorUserID: "u-ZrxmQS",
-			inputUsername:       "erikrios",
-			inputPage:           0,
-			inputLimit:          0,
-			expectedResponse:    response.Pagination[response.ManyThread]{},
-			expectedError:       service.ErrRepository,
+			name:              "it should return service.ErrAccessForbidden, when accessor role can't ban the users",
+			inputAccessorRole: "user",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: "Spamming"},
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload, when the reason is too long",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: strings.Repeat("a", 256)},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrUsernameNotFound, when the user doesn't exist",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: "Spamming"},
+			expectedError:     service.ErrUsernameNotFound,
 			mockBehaviours: func() {
 				mockUserRepository.On(
 					"FindByUsername",
@@ -1553,19 +1959,17 @@ func TestGetAllThreadByUsername(t *testing.T) {
 						return entity.User{}
 					},
 					func(ctx context.Context, username string) error {
-						return repository.ErrDatabase
+						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return service.ErrRepository, when thread repository return repository.ErrDatabase error",
-			inputAccessorUserID: "u-ZrxmQS",
-			inputUsername:       "erikrios",
-			inputPage:           0,
-			inputLimit:          0,
-			expectedResponse:    response.Pagination[response.ManyThread]{},
-			expectedError:       service.ErrRepository,
+			name:              "it should return service.ErrAccessForbidden, when a global moderator bans an admin",
+			inputAccessorRole: "global_moderator",
+			inputUsername:     "sarifaturr",
+			inputPayload:      payload.BanUser{Reason: "Spamming"},
+			expectedError:     service.ErrAccessForbidden,
 			mockBehaviours: func() {
 				mockUserRepository.On(
 					"FindByUsername",
@@ -1573,77 +1977,41 @@ func TestGetAllThreadByUsername(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, username string) entity.User {
-						return entity.User{
-							ID:       "u-ZrxmQS",
-							Username: "erikrios",
-							Name:     "Erik Rio Setiawan",
-						}
+						return entity.User{ID: "a-abcd", Username: "sarifaturr", Role: "admin", IsActive: true}
 					},
 					func(ctx context.Context, username string) error {
 						return nil
 					},
 				).Once()
-
-				mockThreadRepository.On(
-					"FindAllByUserIDWithPagination",
+			},
+		},
+		{
+			name:              "it should return service.ErrDataAlreadyExists, when the user is already banned",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: "Spamming"},
+			expectedError:     service.ErrDataAlreadyExists,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
 				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						UserID string,
-						pageInfo entity.PageInfo,
-					) entity.Pagination[entity.Thread] {
-						return entity.Pagination[entity.Thread]{}
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-ZrxmQS", Username: "naruto", Role: "user", IsActive: false}
 					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						UserID string,
-						pageInfo entity.PageInfo,
-					) error {
-						return repository.ErrDatabase
+					func(ctx context.Context, username string) error {
+						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:                "it should return nil error, when no error is returned",
-			inputAccessorUserID: "u-ZrxmQS",
-			inputUsername:       "erikrios",
-			inputPage:           1,
-			inputLimit:          20,
-			expectedResponse: response.Pagination[response.ManyThread]{
-				List: []response.ManyThread{
-					{
-						ID:              "t-Hz5Rzhi",
-						Title:           "Go Programming Going Hype",
-						CategoryID:      "c-GzH",
-						CategoryName:    "Tech",
-						PublishedOn:     now.Format(time.RFC822),
-						IsLiked:         true,
-						IsFollowed:      false,
-						Description:     "Recently, Go Programming going hype because it have huge community.",
-						TotalViewer:     350,
-						TotalLike:       124,
-						TotalFollower:   5,
-						TotalComment:    38,
-						CreatorID:       "u-RxUaN4",
-						CreatorUsername: "erikrios",
-						CreatorName:     "Erik Rio Setiawan",
-					},
-				},
-				PageInfo: response.PageInfo{
-					Limit:     20,
-					Page:      1,
-					PageTotal: 1,
-					Total:     15,
-				},
-			},
-			expectedError: nil,
+			name:              "it should return service.ErrRepository, when banned user return a repository.ErrDatabase error",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: "Spamming"},
+			expectedError:     service.ErrRepository,
 			mockBehaviours: func() {
 				mockUserRepository.On(
 					"FindByUsername",
@@ -1651,68 +2019,160 @@ func TestGetAllThreadByUsername(t *testing.T) {
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
 				).Return(
 					func(ctx context.Context, username string) entity.User {
-						return entity.User{
-							ID:       "u-ZrxmQS",
-							Username: "erikrios",
-							Name:     "Erik Rio Setiawan",
-						}
+						return dummyUser
 					},
 					func(ctx context.Context, username string) error {
 						return nil
 					},
 				).Once()
 
-				mockThreadRepository.On(
-					"FindAllByUserIDWithPagination",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
-				).Return(
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						UserID string,
-						pageInfo entity.PageInfo,
-					) entity.Pagination[entity.Thread] {
-						return entity.Pagination[entity.Thread]{
-							List: []entity.Thread{
-								{
-									ID:            "t-Hz5Rzhi",
-									Title:         "Go Programming Going Hype",
-									Description:   "Recently, Go Programming going hype because it have huge community.",
-									TotalViewer:   350,
-									TotalLike:     124,
-									TotalFollower: 5,
-									TotalComment:  38,
-									Creator: entity.User{
-										ID:       "u-RxUaN4",
-										Username: "erikrios",
-										Name:     "Erik Rio Setiawan",
-									},
-									Category: entity.Category{
-										ID:   "c-GzH",
-										Name: "Tech",
-									},
-									IsLiked:    true,
-									IsFollowed: false,
-									CreatedAt:  now,
-								},
-							},
-							PageInfo: entity.PageInfo{
-								Limit:     20,
-								Page:      1,
-								PageTotal: 1,
-								Total:     15,
-							},
-						}
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return "b-abcdefg"
 					},
-					func(
-						ctx context.Context,
-						accessorUserID string,
-						UserID string,
-						pageInfo entity.PageInfo,
-					) error {
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Ban{})),
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the user is banned permanently",
+			inputAccessorRole: "global_moderator",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: "Spamming"},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return "b-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.Ban{
+						ID:     "b-abcdefg",
+						User:   dummyUser,
+						Issuer: entity.User{ID: "a-abcd"},
+						Reason: "Spamming",
+					},
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the user is banned permanently without a reason",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return "b-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.Ban{
+						ID:     "b-abcdefg",
+						User:   dummyUser,
+						Issuer: entity.User{ID: "a-abcd"},
+					},
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the user is banned for the given days",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			inputPayload:      payload.BanUser{Reason: "Spamming", Days: 7},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateBanID").Return(
+					func() string {
+						return "b-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"BannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(ban entity.Ban) bool {
+						expiresIn := time.Until(ban.ExpiresAt)
+						return ban.Reason == "Spamming" && expiresIn > 6*24*time.Hour && expiresIn <= 7*24*time.Hour
+					}),
+				).Return(
+					func(ctx context.Context, ban entity.Ban) error {
 						return nil
 					},
 				).Once()
@@ -1724,21 +2184,2690 @@ func TestGetAllThreadByUsername(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotPagination, gotError := userService.GetAllThreadByUsername(
+			gotError := userService.Ban(
 				context.Background(),
-				testCase.inputAccessorUserID,
+				"a-abcd",
+				testCase.inputAccessorRole,
 				testCase.inputUsername,
-				testCase.inputPage,
-				testCase.inputLimit,
+				testCase.inputPayload,
 			)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotError, testCase.expectedError)
 			} else {
-				assert.NoError(t, testCase.expectedError)
-				assert.ElementsMatch(t, testCase.expectedResponse.List, gotPagination.List)
-				assert.Equal(t, testCase.expectedResponse.PageInfo, gotPagination.PageInfo)
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestUnban(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	testCases := []struct {
+		name              string
+		inputAccessorRole string
+		inputUsername     string
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrAccessForbidden, when accessor role can't ban the users",
+			inputAccessorRole: "user",
+			inputUsername:     "naruto",
+			expectedError:     service.ErrAccessForbidden,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrDataAlreadyExists, when the user is not banned",
+			inputAccessorRole: "admin",
+			inputUsername:     "naruto",
+			expectedError:     service.ErrDataAlreadyExists,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-ZrxmQS", Username: "naruto", Role: "user", IsActive: true}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UnbannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					"a-abcd",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, userID string, lifterID string, liftedAt time.Time) error {
+						return repository.ErrRecordAlreadyExists
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when the ban is lifted",
+			inputAccessorRole: "global_moderator",
+			inputUsername:     "naruto",
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-ZrxmQS", Username: "naruto", Role: "user", IsActive: false}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UnbannedUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					"a-abcd",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, userID string, lifterID string, liftedAt time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotError := userService.Unban(
+				context.Background(),
+				"a-abcd",
+				testCase.inputAccessorRole,
+				testCase.inputUsername,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotError)
 			}
 		})
 	}
 }
+
+func TestGetAllBan(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	bannedAt := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	liftedAt := bannedAt.AddDate(0, 0, 3)
+
+	dummyBans := []entity.Ban{
+		{
+			ID:        "b-hijklmn",
+			Reason:    "Spamming",
+			Issuer:    entity.User{ID: "a-abcd", Username: "sarifaturr"},
+			CreatedAt: liftedAt,
+		},
+		{
+			ID:        "b-abcdefg",
+			Reason:    "Harrashment",
+			Issuer:    entity.User{ID: "a-abcd", Username: "sarifaturr"},
+			ExpiresAt: bannedAt.AddDate(0, 0, 7),
+			LiftedAt:  liftedAt,
+			Lifter:    entity.User{ID: "a-abcd", Username: "sarifaturr"},
+			CreatedAt: bannedAt,
+		},
+	}
+
+	expectedBans := []response.Ban{
+		{
+			ID:             "b-hijklmn",
+			Reason:         "Spamming",
+			IssuerUsername: "sarifaturr",
+			IsPermanent:    true,
+			IsActive:       true,
+			BannedOn:       liftedAt.Format(time.RFC822),
+		},
+		{
+			ID:             "b-abcdefg",
+			Reason:         "Harrashment",
+			IssuerUsername: "sarifaturr",
+			ExpiresOn:      bannedAt.AddDate(0, 0, 7).Format(time.RFC822),
+			LiftedOn:       liftedAt.Format(time.RFC822),
+			LifterUsername: "sarifaturr",
+			BannedOn:       bannedAt.Format(time.RFC822),
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		inputAccessorUsername string
+		inputAccessorRole     string
+		inputUsername         string
+		expectedBans          []response.Ban
+		expectedError         error
+		mockBehaviours        func()
+	}{
+		{
+			name:                  "it should return service.ErrAccessForbidden, when a user sees the bans of another user",
+			inputAccessorUsername: "erikrios",
+			inputAccessorRole:     "user",
+			inputUsername:         "naruto",
+			expectedError:         service.ErrAccessForbidden,
+			mockBehaviours:        func() {},
+		},
+		{
+			name:                  "it should return the ban history, when a user sees their own bans",
+			inputAccessorUsername: "naruto",
+			inputAccessorRole:     "user",
+			inputUsername:         "naruto",
+			expectedBans:          expectedBans,
+			expectedError:         nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-ZrxmQS", Username: "naruto", Role: "user", IsActive: false}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"FindAllBanByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+				).Return(
+					func(ctx context.Context, userID string) []entity.Ban {
+						return dummyBans
+					},
+					func(ctx context.Context, userID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return service.ErrRepository, when find all ban return a repository.ErrDatabase error",
+			inputAccessorUsername: "sarifaturr",
+			inputAccessorRole:     "admin",
+			inputUsername:         "naruto",
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{ID: "u-ZrxmQS", Username: "naruto", Role: "user", IsActive: false}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"FindAllBanByUserID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+				).Return(
+					func(ctx context.Context, userID string) []entity.Ban {
+						return nil
+					},
+					func(ctx context.Context, userID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotBans, gotError := userService.GetAllBan(
+				context.Background(),
+				testCase.inputAccessorUsername,
+				testCase.inputAccessorRole,
+				testCase.inputUsername,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedBans, gotBans)
+			}
+		})
+	}
+}
+
+func TestLiftExpiredBans(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	t.Run("it should return nil error, when the expired bans are lifted", func(t *testing.T) {
+		mockUserRepository.On(
+			"UnbannedExpiredUsers",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+		).Return(
+			func(ctx context.Context, now time.Time) []string {
+				return []string{"u-ZrxmQS"}
+			},
+			func(ctx context.Context, now time.Time) error {
+				return nil
+			},
+		).Once()
+
+		assert.NoError(t, userService.LiftExpiredBans(context.Background()))
+	})
+
+	t.Run("it should return service.ErrRepository, when the repository return a repository.ErrDatabase error", func(t *testing.T) {
+		mockUserRepository.On(
+			"UnbannedExpiredUsers",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+		).Return(
+			func(ctx context.Context, now time.Time) []string {
+				return nil
+			},
+			func(ctx context.Context, now time.Time) error {
+				return repository.ErrDatabase
+			},
+		).Once()
+
+		assert.ErrorIs(t, userService.LiftExpiredBans(context.Background()), service.ErrRepository)
+	})
+}
+
+func TestChangeFollowingState(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	now := time.Now()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	testCases := []struct {
+		name                  string
+		inputAccessorUserID   string
+		inputUsernameToFollow string
+		expectedError         error
+		mockBehaviours        func()
+	}{
+		{
+			name:                  "it should return service.ErrRepository, when user repository return a repository.ErrDatabase error",
+			inputAccessorUserID:   "u-ZrxmQS",
+			inputUsernameToFollow: "naruto",
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsernameWithAccessor",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) entity.User {
+						return entity.User{}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return service.ErrRepository, when unfollow user return a repository.ErrDatabase error",
+			inputAccessorUserID:   "u-ZrxmQS",
+			inputUsernameToFollow: "erikrios",
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsernameWithAccessor",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) entity.User {
+						return entity.User{
+							ID:             "u-ZrxmQS",
+							Username:       "erikrios",
+							Email:          "erikriosetiawan@gmail.com",
+							Name:           "Erik Rio Setiawan",
+							Role:           "user",
+							IsActive:       false,
+							TotalThread:    15,
+							TotalFollower:  200,
+							TotalFollowing: 2,
+							IsFollowed:     true,
+							CreatedAt:      now,
+							UpdatedAt:      now,
+						}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UnfollowUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, accessorUserID, userID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return service.ErrRepository, when generate id return an error",
+			inputAccessorUserID:   "u-ZrxmQS",
+			inputUsernameToFollow: "erikrios",
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsernameWithAccessor",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) entity.User {
+						return entity.User{
+							ID:             "u-ZrxmQS",
+							Username:       "erikrios",
+							Email:          "erikriosetiawan@gmail.com",
+							Name:           "Erik Rio Setiawan",
+							Role:           "user",
+							IsActive:       false,
+							TotalThread:    15,
+							TotalFollower:  200,
+							TotalFollowing: 2,
+							IsFollowed:     false,
+							CreatedAt:      now,
+							UpdatedAt:      now,
+						}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserFollowID",
+				).Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("Something went wrong.")
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return service.ErrRepository, when follow user return a repository.ErrDatabase error",
+			inputAccessorUserID:   "u-ZrxmQS",
+			inputUsernameToFollow: "erikrios",
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsernameWithAccessor",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) entity.User {
+						return entity.User{
+							ID:             "u-ZrxmQS",
+							Username:       "erikrios",
+							Email:          "erikriosetiawan@gmail.com",
+							Name:           "Erik Rio Setiawan",
+							Role:           "user",
+							IsActive:       false,
+							TotalThread:    15,
+							TotalFollower:  200,
+							TotalFollowing: 2,
+							IsFollowed:     false,
+							CreatedAt:      now,
+							UpdatedAt:      now,
+						}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserFollowID",
+				).Return(
+					func() string {
+						return "f-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"FollowUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id, accessorUserID, userID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return nil error, when no error is returned",
+			inputAccessorUserID:   "u-ZrxmQS",
+			inputUsernameToFollow: "erikrios",
+			expectedError:         nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsernameWithAccessor",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) entity.User {
+						return entity.User{
+							ID:             "u-ZrxmQS",
+							Username:       "erikrios",
+							Email:          "erikriosetiawan@gmail.com",
+							Name:           "Erik Rio Setiawan",
+							Role:           "user",
+							IsActive:       false,
+							TotalThread:    15,
+							TotalFollower:  200,
+							TotalFollowing: 2,
+							IsFollowed:     false,
+							CreatedAt:      now,
+							UpdatedAt:      now,
+						}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						username string,
+					) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserFollowID",
+				).Return(
+					func() string {
+						return "f-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"FollowUser",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id, accessorUserID, userID string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateNotificationID",
+				).Return(
+					func() string {
+						return "n-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockNotificationRepository.On(
+					"InsertAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(notifications []entity.Notification) bool {
+						return len(notifications) == 1 &&
+							notifications[0].Type == entity.FollowNotification &&
+							notifications[0].User.ID == "u-ZrxmQS"
+					}),
+				).Return(
+					func(ctx context.Context, notifications []entity.Notification) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotError := userService.ChangeFollowingState(
+				context.Background(),
+				testCase.inputAccessorUserID,
+				testCase.inputUsernameToFollow,
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGetAllThreadByUsername(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	now := time.Now()
+
+	testCases := []struct {
+		name                string
+		inputAccessorUserID string
+		inputUsername       string
+		inputPage           uint
+		inputLimit          uint
+		inputCursor         string
+		inputSort           string
+		expectedResponse    response.Pagination[response.ManyThread]
+		expectedError       error
+		mockBehaviours      func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload, when the cursor is malformed",
+			inputUsername:  "erikrios",
+			inputCursor:    "not a cursor",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload, when a cursor is given with the trending sort",
+			inputUsername:  "erikrios",
+			inputCursor:    service.EncodeCursor(&entity.Cursor{CreatedAt: now, ID: "t-abcdefg"}),
+			inputSort:      "trending",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:                "it should return service.ErrRepository, when user repository return repository.ErrDatabase error",
+			inputAccessorUserID: "u-ZrxmQS",
+			inputUsername:       "erikrios",
+			inputPage:           0,
+			inputLimit:          0,
+			expectedResponse:    response.Pagination[response.ManyThread]{},
+			expectedError:       service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, username string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return service.ErrRepository, when thread repository return repository.ErrDatabase error",
+			inputAccessorUserID: "u-ZrxmQS",
+			inputUsername:       "erikrios",
+			inputPage:           0,
+			inputLimit:          0,
+			expectedResponse:    response.Pagination[response.ManyThread]{},
+			expectedError:       service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{
+							ID:       "u-ZrxmQS",
+							Username: "erikrios",
+							Name:     "Erik Rio Setiawan",
+						}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepository.On(
+					"FindAllByUserIDWithPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						UserID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) entity.Pagination[entity.Thread] {
+						return entity.Pagination[entity.Thread]{}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						UserID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:                "it should return nil error, when no error is returned",
+			inputAccessorUserID: "u-ZrxmQS",
+			inputUsername:       "erikrios",
+			inputPage:           1,
+			inputLimit:          20,
+			expectedResponse: response.Pagination[response.ManyThread]{
+				List: []response.ManyThread{
+					{
+						ID:              "t-Hz5Rzhi",
+						Title:           "Go Programming Going Hype",
+						CategoryID:      "c-GzH",
+						CategoryName:    "Tech",
+						PublishedOn:     now.Format(time.RFC822),
+						IsLiked:         true,
+						IsFollowed:      false,
+						Description:     "Recently, Go Programming going hype because it have huge community.",
+						TotalViewer:     350,
+						TotalLike:       124,
+						TotalFollower:   5,
+						TotalComment:    38,
+						CreatorID:       "u-RxUaN4",
+						CreatorUsername: "erikrios",
+						CreatorName:     "Erik Rio Setiawan",
+					},
+				},
+				PageInfo: response.PageInfo{
+					Limit:     20,
+					Page:      1,
+					PageTotal: 1,
+					Total:     15,
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return entity.User{
+							ID:       "u-ZrxmQS",
+							Username: "erikrios",
+							Name:     "Erik Rio Setiawan",
+						}
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockThreadRepository.On(
+					"FindAllByUserIDWithPagination",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.NewestSort)),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ThreadFilter{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PageInfo{})),
+				).Return(
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						UserID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) entity.Pagination[entity.Thread] {
+						return entity.Pagination[entity.Thread]{
+							List: []entity.Thread{
+								{
+									ID:            "t-Hz5Rzhi",
+									Title:         "Go Programming Going Hype",
+									Description:   "Recently, Go Programming going hype because it have huge community.",
+									TotalViewer:   350,
+									TotalLike:     124,
+									TotalFollower: 5,
+									TotalComment:  38,
+									Creator: entity.User{
+										ID:       "u-RxUaN4",
+										Username: "erikrios",
+										Name:     "Erik Rio Setiawan",
+									},
+									Category: entity.Category{
+										ID:   "c-GzH",
+										Name: "Tech",
+									},
+									IsLiked:    true,
+									IsFollowed: false,
+									CreatedAt:  now,
+								},
+							},
+							PageInfo: entity.PageInfo{
+								Limit:     20,
+								Page:      1,
+								PageTotal: 1,
+								Total:     15,
+							},
+						}
+					},
+					func(
+						ctx context.Context,
+						accessorUserID string,
+						UserID string,
+						sort entity.ThreadSort,
+						filter entity.ThreadFilter,
+						pageInfo entity.PageInfo,
+					) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotPagination, gotError := userService.GetAllThreadByUsername(
+				context.Background(),
+				testCase.inputAccessorUserID,
+				testCase.inputUsername,
+				testCase.inputPage,
+				testCase.inputLimit,
+				testCase.inputCursor,
+				testCase.inputSort,
+				payload.ThreadFilter{},
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+			} else {
+				assert.NoError(t, testCase.expectedError)
+				assert.ElementsMatch(t, testCase.expectedResponse.List, gotPagination.List)
+				assert.Equal(t, testCase.expectedResponse.PageInfo, gotPagination.PageInfo)
+			}
+		})
+	}
+}
+
+func TestUpdateOwn(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	now := time.Now()
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	validPayload := payload.UpdateProfile{
+		Email:     "erikriosetiawan@gmail.com",
+		Name:      "Erik Rio",
+		Bio:       "Backend engineer",
+		AvatarURL: "https://example.com/erikrios.png",
+		Location:  "Indonesia",
+	}
+
+	testCases := []struct {
+		name             string
+		inputPayload     payload.UpdateProfile
+		expectedResponse response.User
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload, when the email is invalid",
+			inputPayload:   payload.UpdateProfile{Email: "erikrios", Name: "Erik Rio"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload, when the avatar URL is not an http URL",
+			inputPayload:   payload.UpdateProfile{Email: "erikriosetiawan@gmail.com", Name: "Erik Rio", AvatarURL: "javascript:alert(1)"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataAlreadyExists, when the email is used by another user",
+			inputPayload:  validPayload,
+			expectedError: service.ErrDataAlreadyExists,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.User{})),
+				).Return(
+					func(ctx context.Context, ID string, user entity.User) error {
+						return repository.ErrRecordAlreadyExists
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the updated user, when no error is returned",
+			inputPayload: validPayload,
+			expectedResponse: response.User{
+				UserID:       "u-ZrxmQS",
+				Username:     "erikrios",
+				Email:        "erikriosetiawan@gmail.com",
+				Name:         "Erik Rio",
+				Role:         "user",
+				IsActive:     true,
+				RegisteredOn: now.Format(time.RFC822),
+				Bio:          "Backend engineer",
+				AvatarURL:    "https://example.com/erikrios.png",
+				Location:     "Indonesia",
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					entity.User{
+						Email:     "erikriosetiawan@gmail.com",
+						Name:      "Erik Rio",
+						Bio:       "Backend engineer",
+						AvatarURL: "https://example.com/erikrios.png",
+						Location:  "Indonesia",
+					},
+				).Return(
+					func(ctx context.Context, ID string, user entity.User) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"FindByUsernameWithAccessor",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					"erikrios",
+				).Return(
+					func(ctx context.Context, accessorUserID string, username string) entity.User {
+						return entity.User{
+							ID:        "u-ZrxmQS",
+							Username:  "erikrios",
+							Email:     "erikriosetiawan@gmail.com",
+							Name:      "Erik Rio",
+							Bio:       "Backend engineer",
+							AvatarURL: "https://example.com/erikrios.png",
+							Location:  "Indonesia",
+							Role:      "user",
+							IsActive:  true,
+							CreatedAt: now,
+							UpdatedAt: now,
+						}
+					},
+					func(ctx context.Context, accessorUserID string, username string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotResponse, gotErr := userService.UpdateOwn(context.Background(), "u-ZrxmQS", "erikrios", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	validPayload := payload.ChangePassword{
+		OldPassword: "erikriosetiawan",
+		NewPassword: "erikrios123",
+		Device:      "Firefox",
+	}
+
+	dummyUser := entity.User{
+		ID:           "u-ZrxmQS",
+		Username:     "erikrios",
+		Password:     "hashedpassword",
+		Role:         "user",
+		IsActive:     true,
+		TokenVersion: 3,
+	}
+
+	testCases := []struct {
+		name             string
+		inputPayload     payload.ChangePassword
+		expectedResponse response.Login
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload, when the new password is too short",
+			inputPayload:   payload.ChangePassword{OldPassword: "erikriosetiawan", NewPassword: "erik"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrCredentialNotMatch, when the old password doesn't match",
+			inputPayload:  validPayload,
+			expectedError: service.ErrCredentialNotMatch,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"CompareHashAndPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+				).Return(
+					func(hashedPassword, password []byte) error {
+						return errors.New("password not match")
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository, when update password return a repository.ErrDatabase error",
+			inputPayload:  validPayload,
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"CompareHashAndPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+				).Return(
+					func(hashedPassword, password []byte) error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"GenerateFromPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(p []byte, cost int) []byte {
+						return []byte("newhashedpassword")
+					},
+					func(p []byte, cost int) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UpdatePassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string, password string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the tokens of a new session with the bumped token version, when no error is returned",
+			inputPayload: validPayload,
+			expectedResponse: response.Login{
+				Token:        "generatedtoken",
+				RefreshToken: "generatedrefreshtoken",
+				Role:         "user",
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByUsername",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"erikrios",
+				).Return(
+					func(ctx context.Context, username string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, username string) error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"CompareHashAndPassword",
+					[]byte("hashedpassword"),
+					[]byte("erikriosetiawan"),
+				).Return(
+					func(hashedPassword, password []byte) error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"GenerateFromPassword",
+					[]byte("erikrios123"),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(p []byte, cost int) []byte {
+						return []byte("newhashedpassword")
+					},
+					func(p []byte, cost int) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UpdatePassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					"newhashedpassword",
+				).Return(
+					func(ctx context.Context, ID string, password string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateSessionID",
+				).Return(
+					func() string {
+						return "s-abcdefghij"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateToken",
+					mock.MatchedBy(func(tp generator.TokenPayload) bool {
+						return tp.TokenVersion == 4 && tp.SessionID == "s-abcdefghij"
+					}),
+				).Return(
+					func(tp generator.TokenPayload) string {
+						return "generatedtoken"
+					},
+					func(tp generator.TokenPayload) error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateRefreshToken",
+				).Return(
+					func() string {
+						return "generatedrefreshtoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockSessionRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(session entity.Session) bool {
+						return session.TokenVersion == 4 && session.Device == "Firefox"
+					}),
+				).Return(
+					func(ctx context.Context, session entity.Session) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotResponse, gotErr := userService.ChangePassword(context.Background(), "u-ZrxmQS", "erikrios", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
+
+func TestExportOwn(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	createdAt := time.Date(2022, time.June, 27, 8, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		expectedResponse response.PersonalData
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound, when the user repository return repository.ErrRecordNotFound",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindPersonalData",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+				).Return(
+					func(ctx context.Context, userID string) entity.PersonalData {
+						return entity.PersonalData{}
+					},
+					func(ctx context.Context, userID string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return the personal data, when no error is returned",
+			expectedResponse: response.PersonalData{
+				Profile: response.PersonalProfile{
+					UserID:       "u-ZrxmQS",
+					Username:     "erikrios",
+					Email:        "erikriosetiawan15@gmail.com",
+					Name:         "Erik Rio Setiawan",
+					Role:         "user",
+					IsActive:     true,
+					RegisteredAt: "2022-06-27T08:30:00Z",
+					UpdatedAt:    "2022-06-27T08:30:00Z",
+				},
+				Threads: []response.PersonalThread{
+					{
+						ID:           "t-abcdefg",
+						Title:        "Goroutines",
+						Description:  "How do they work?",
+						CategoryID:   "c-abc",
+						CategoryName: "Golang",
+						PublishedAt:  "2022-06-27T08:30:00Z",
+						UpdatedAt:    "2022-06-27T08:30:00Z",
+					},
+				},
+				Comments: []response.PersonalComment{
+					{
+						ID:          "c-abcdefg",
+						ThreadID:    "t-hijklmn",
+						ThreadTitle: "Channels",
+						Comment:     "Nice!",
+						PublishedAt: "2022-06-27T08:30:00Z",
+						DeletedAt:   "2022-06-27T08:30:00Z",
+					},
+				},
+				Likes: []response.PersonalThreadEntry{
+					{ThreadID: "t-hijklmn", ThreadTitle: "Channels", CreatedAt: "2022-06-27T08:30:00Z"},
+				},
+				Following: []response.PersonalUserEntry{
+					{UserID: "u-abcdef", Username: "sarifaturr", CreatedAt: "2022-06-27T08:30:00Z"},
+				},
+				Followers:       []response.PersonalUserEntry{},
+				FollowedThreads: []response.PersonalThreadEntry{},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindPersonalData",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+				).Return(
+					func(ctx context.Context, userID string) entity.PersonalData {
+						return entity.PersonalData{
+							User: entity.User{
+								ID:        "u-ZrxmQS",
+								Username:  "erikrios",
+								Email:     "erikriosetiawan15@gmail.com",
+								Name:      "Erik Rio Setiawan",
+								Role:      "user",
+								IsActive:  true,
+								CreatedAt: createdAt,
+								UpdatedAt: createdAt,
+							},
+							Threads: []entity.Thread{
+								{
+									ID:          "t-abcdefg",
+									Title:       "Goroutines",
+									Description: "How do they work?",
+									Category:    entity.Category{ID: "c-abc", Name: "Golang"},
+									CreatedAt:   createdAt,
+									UpdatedAt:   createdAt,
+								},
+							},
+							Comments: []entity.Comment{
+								{
+									ID:        "c-abcdefg",
+									Thread:    entity.Thread{ID: "t-hijklmn", Title: "Channels"},
+									Comment:   "Nice!",
+									CreatedAt: createdAt,
+									DeletedAt: createdAt,
+								},
+							},
+							Likes: []entity.Like{
+								{ID: "l-abcdefg", Thread: entity.Thread{ID: "t-hijklmn", Title: "Channels"}, CreatedAt: createdAt},
+							},
+							Following: []entity.UserFollow{
+								{ID: "f-abcdefg", Following: entity.User{ID: "u-abcdef", Username: "sarifaturr"}, CreatedAt: createdAt},
+							},
+							Followers:       []entity.UserFollow{},
+							FollowedThreads: []entity.ThreadFollow{},
+						}
+					},
+					func(ctx context.Context, userID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotResponse, gotErr := userService.ExportOwn(context.Background(), "u-ZrxmQS")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.NotEmpty(t, gotResponse.ExportedAt)
+				gotResponse.ExportedAt = ""
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
+
+func TestDeleteOwn(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	validPayload := payload.DeleteAccount{Password: "erikriosetiawan"}
+
+	dummyUser := entity.User{
+		ID:       "u-ZrxmQS",
+		Username: "erikrios",
+		Password: "hashedpassword",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	mockFindByUsername := func(user entity.User) {
+		mockUserRepository.On(
+			"FindByUsername",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"erikrios",
+		).Return(
+			func(ctx context.Context, username string) entity.User {
+				return user
+			},
+			func(ctx context.Context, username string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	mockCompareHashAndPassword := func(err error) {
+		mockPwdGen.On(
+			"CompareHashAndPassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+		).Return(
+			func(hashedPassword, password []byte) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.DeleteAccount
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload, when the password is empty",
+			inputPayload:   payload.DeleteAccount{},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrAccessForbidden, when the accessor is an admin",
+			inputPayload:  validPayload,
+			expectedError: service.ErrAccessForbidden,
+			mockBehaviours: func() {
+				admin := dummyUser
+				admin.Role = "admin"
+				mockFindByUsername(admin)
+			},
+		},
+		{
+			name:          "it should return service.ErrCredentialNotMatch, when the password doesn't match",
+			inputPayload:  validPayload,
+			expectedError: service.ErrCredentialNotMatch,
+			mockBehaviours: func() {
+				mockFindByUsername(dummyUser)
+				mockCompareHashAndPassword(errors.New("password not match"))
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository, when anonymize return a repository.ErrDatabase error",
+			inputPayload:  validPayload,
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockFindByUsername(dummyUser)
+				mockCompareHashAndPassword(nil)
+
+				mockUserRepository.On(
+					"Anonymize",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Now())),
+				).Return(
+					func(ctx context.Context, userID string, deletedAt time.Time) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil, when the account is anonymized",
+			inputPayload:  validPayload,
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockFindByUsername(dummyUser)
+				mockCompareHashAndPassword(nil)
+
+				mockUserRepository.On(
+					"Anonymize",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-ZrxmQS",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Now())),
+				).Return(
+					func(ctx context.Context, userID string, deletedAt time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := userService.DeleteOwn(context.Background(), "u-ZrxmQS", "erikrios", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestRequestEmailVerification(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	dummyUser := entity.User{
+		ID:       "u-abcdef",
+		Username: "erikrios",
+		Email:    "erikriosetiawan15@gmail.com",
+		Name:     "Erik Rio Setiawan",
+		Role:     "user",
+		IsActive: true,
+	}
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.EmailRequest
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when payload is invalid",
+			inputPayload:   payload.EmailRequest{Email: ""},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return nil error, when the email is not registered",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, email string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error without sending an email, when the email is already verified",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						user := dummyUser
+						user.IsVerified = true
+						return user
+					},
+					func(ctx context.Context, email string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when repository return an error",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, email string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when the verification email is sent",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"erikriosetiawan15@gmail.com",
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						return dummyUser
+					},
+					func(ctx context.Context, email string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserTokenID",
+				).Return(
+					func() string {
+						return "k-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateOneTimeToken",
+				).Return(
+					func() string {
+						return "generatedonetimetoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(userToken entity.UserToken) bool {
+						return userToken.ID == "k-abcdefg" &&
+							userToken.User.ID == "u-abcdef" &&
+							userToken.Purpose == entity.EmailVerificationToken &&
+							userToken.TokenHash == generator.HashOneTimeToken("generatedonetimetoken") &&
+							userToken.ExpiresAt.After(time.Now())
+					}),
+				).Return(
+					func(ctx context.Context, userToken entity.UserToken) error {
+						return nil
+					},
+				).Once()
+
+				mockMailer.On(
+					"Send",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(message mailer.Message) bool {
+						return message.To == "erikriosetiawan15@gmail.com" &&
+							strings.Contains(message.Body, "/verify-email?token=generatedonetimetoken")
+					}),
+				).Return(
+					func(ctx context.Context, message mailer.Message) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := userService.RequestEmailVerification(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.VerifyEmail
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when payload is invalid",
+			inputPayload:   payload.VerifyEmail{Token: ""},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrInvalidToken error, when the token is used, expired or unknown",
+			inputPayload:  payload.VerifyEmail{Token: "generatedonetimetoken"},
+			expectedError: service.ErrInvalidToken,
+			mockBehaviours: func() {
+				mockUserTokenRepository.On(
+					"Use",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.EmailVerificationToken)),
+				).Return(
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) string {
+						return ""
+					},
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when mark verified return an error",
+			inputPayload:  payload.VerifyEmail{Token: "generatedonetimetoken"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserTokenRepository.On(
+					"Use",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.EmailVerificationToken)),
+				).Return(
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) string {
+						return "u-abcdef"
+					},
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"MarkVerified",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, ID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when the email is verified",
+			inputPayload:  payload.VerifyEmail{Token: "generatedonetimetoken"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserTokenRepository.On(
+					"Use",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					generator.HashOneTimeToken("generatedonetimetoken"),
+					entity.EmailVerificationToken,
+				).Return(
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) string {
+						return "u-abcdef"
+					},
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"MarkVerified",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+				).Return(
+					func(ctx context.Context, ID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := userService.VerifyEmail(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.EmailRequest
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when payload is invalid",
+			inputPayload:   payload.EmailRequest{Email: ""},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return nil error, when the email is not registered",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						return entity.User{}
+					},
+					func(ctx context.Context, email string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when insert token return an error",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						return entity.User{ID: "u-abcdef", Email: "erikriosetiawan15@gmail.com", IsActive: true}
+					},
+					func(ctx context.Context, email string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserTokenID",
+				).Return(
+					func() string {
+						return "k-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateOneTimeToken",
+				).Return(
+					func() string {
+						return "generatedonetimetoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.UserToken{})),
+				).Return(
+					func(ctx context.Context, userToken entity.UserToken) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when the password reset email is sent",
+			inputPayload:  payload.EmailRequest{Email: "erikriosetiawan15@gmail.com"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserRepository.On(
+					"FindByEmail",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, email string) entity.User {
+						return entity.User{ID: "u-abcdef", Email: "erikriosetiawan15@gmail.com", IsActive: true}
+					},
+					func(ctx context.Context, email string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On(
+					"GenerateUserTokenID",
+				).Return(
+					func() string {
+						return "k-abcdefg"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockTokenGen.On(
+					"GenerateOneTimeToken",
+				).Return(
+					func() string {
+						return "generatedonetimetoken"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(userToken entity.UserToken) bool {
+						return userToken.Purpose == entity.PasswordResetToken &&
+							userToken.ExpiresAt.Before(time.Now().Add(passwordResetTokenDuration+time.Minute))
+					}),
+				).Return(
+					func(ctx context.Context, userToken entity.UserToken) error {
+						return nil
+					},
+				).Once()
+
+				mockMailer.On(
+					"Send",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(message mailer.Message) bool {
+						return strings.Contains(message.Body, "/reset-password?token=generatedonetimetoken")
+					}),
+				).Return(
+					func(ctx context.Context, message mailer.Message) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := userService.RequestPasswordReset(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	mockUserRepository := &mur.UserRepository{}
+	mockThreadRepository := &mtr.ThreadRepository{}
+	mockSessionRepository := &msr.SessionRepository{}
+	mockNotificationRepository := &mnr.NotificationRepository{}
+	mockUserTokenRepository := &mutr.UserTokenRepository{}
+	mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockPwdGen := &mpg.PasswordGenerator{}
+	mockTokenGen := &mtg.TokenGenerator{}
+	hub := realtime.NewMemoryHub()
+	mockMailer := &mml.Mailer{}
+
+	mockAuditService := &mas.AuditService{}
+	mockAuditService.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	var userService UserService = NewUserServiceImpl(
+		mockUserRepository,
+		mockThreadRepository,
+		mockSessionRepository,
+		mockNotificationRepository,
+		mockUserTokenRepository,
+		mockLoginAttemptRepository,
+		mockIDGen,
+		mockPwdGen,
+		mockTokenGen,
+		hub,
+		mockMailer,
+		mockAuditService,
+		mrp.NewInlineTxManager(),
+	)
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.ResetPassword
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the new password is too short",
+			inputPayload:   payload.ResetPassword{Token: "generatedonetimetoken", NewPassword: "erik"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrInvalidToken error, when the token is used, expired or unknown",
+			inputPayload:  payload.ResetPassword{Token: "generatedonetimetoken", NewPassword: "erikriosetiawan"},
+			expectedError: service.ErrInvalidToken,
+			mockBehaviours: func() {
+				mockPwdGen.On(
+					"GenerateFromPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(p []byte, cost int) []byte {
+						return []byte("generatedpassword")
+					},
+					func(p []byte, cost int) error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Use",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.PasswordResetToken)),
+				).Return(
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) string {
+						return ""
+					},
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when the password update fails",
+			inputPayload:  payload.ResetPassword{Token: "generatedonetimetoken", NewPassword: "erikriosetiawan"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockPwdGen.On(
+					"GenerateFromPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(p []byte, cost int) []byte {
+						return []byte("generatedpassword")
+					},
+					func(p []byte, cost int) error {
+						return nil
+					},
+				).Once()
+
+				mockUserTokenRepository.On(
+					"Use",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					generator.HashOneTimeToken("generatedonetimetoken"),
+					entity.PasswordResetToken,
+				).Return(
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) string {
+						return "u-abcdef"
+					},
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UpdatePassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+					"generatedpassword",
+				).Return(
+					func(ctx context.Context, ID string, password string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when the password is reset",
+			inputPayload:  payload.ResetPassword{Token: "generatedonetimetoken", NewPassword: "erikriosetiawan"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockUserTokenRepository.On(
+					"Use",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					generator.HashOneTimeToken("generatedonetimetoken"),
+					entity.PasswordResetToken,
+				).Return(
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) string {
+						return "u-abcdef"
+					},
+					func(ctx context.Context, tokenHash string, purpose entity.UserTokenPurpose) error {
+						return nil
+					},
+				).Once()
+
+				mockPwdGen.On(
+					"GenerateFromPassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(p []byte, cost int) []byte {
+						return []byte("generatedpassword")
+					},
+					func(p []byte, cost int) error {
+						return nil
+					},
+				).Once()
+
+				mockUserRepository.On(
+					"UpdatePassword",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"u-abcdef",
+					"generatedpassword",
+				).Return(
+					func(ctx context.Context, ID string, password string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := userService.ResetPassword(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	newUserService := func(
+		mockUserRepository *mur.UserRepository,
+		mockLoginAttemptRepository *mlr.LoginAttemptRepository,
+		mockPwdGen *mpg.PasswordGenerator,
+	) UserService {
+		return NewUserServiceImpl(
+			mockUserRepository,
+			&mtr.ThreadRepository{},
+			&msr.SessionRepository{},
+			&mnr.NotificationRepository{},
+			&mutr.UserTokenRepository{},
+			mockLoginAttemptRepository,
+			&mig.IDGenerator{},
+			mockPwdGen,
+			&mtg.TokenGenerator{},
+			realtime.NewMemoryHub(),
+			&mml.Mailer{},
+			&mas.AuditService{},
+			mrp.NewInlineTxManager(),
+		)
+	}
+
+	t.Run("it should return service.TooManyAttemptsError error with the remaining lockout, when the login is locked out", func(t *testing.T) {
+		mockUserRepository := &mur.UserRepository{}
+		mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+		userService := newUserService(mockUserRepository, mockLoginAttemptRepository, &mpg.PasswordGenerator{})
+
+		lockedUntil := time.Now().Add(time.Minute * 2)
+
+		mockLoginAttemptRepository.On(
+			"FindLockedUntil",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"erikrios",
+			"10.0.0.1",
+		).Return(
+			func(ctx context.Context, username string, ipAddress string) time.Time {
+				return lockedUntil
+			},
+			func(ctx context.Context, username string, ipAddress string) error {
+				return nil
+			},
+		).Once()
+
+		_, gotErr := userService.Login(context.Background(), payload.Login{
+			Username:  "erikrios",
+			Password:  "erikriosetiawan",
+			IPAddress: "10.0.0.1",
+		})
+
+		assert.ErrorIs(t, gotErr, service.ErrTooManyAttempts)
+
+		var tooManyAttemptsErr *service.TooManyAttemptsError
+		if assert.ErrorAs(t, gotErr, &tooManyAttemptsErr) {
+			assert.Greater(t, tooManyAttemptsErr.RetryAfter, time.Minute)
+			assert.LessOrEqual(t, tooManyAttemptsErr.RetryAfter, time.Minute*2)
+		}
+
+		mockUserRepository.AssertNotCalled(t, "FindByUsername", mock.Anything, mock.Anything)
+	})
+
+	t.Run("it should lock the username out, when the failed attempts reach the limit", func(t *testing.T) {
+		mockUserRepository := &mur.UserRepository{}
+		mockLoginAttemptRepository := &mlr.LoginAttemptRepository{}
+		mockPwdGen := &mpg.PasswordGenerator{}
+		userService := newUserService(mockUserRepository, mockLoginAttemptRepository, mockPwdGen)
+
+		mockLoginAttemptRepository.On(
+			"FindLockedUntil",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, username string, ipAddress string) time.Time {
+				return time.Now().Add(-time.Minute)
+			},
+			func(ctx context.Context, username string, ipAddress string) error {
+				return nil
+			},
+		).Once()
+
+		mockUserRepository.On(
+			"FindByUsername",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"erikrios",
+		).Return(
+			func(ctx context.Context, username string) entity.User {
+				return entity.User{ID: "u-abcdef", Username: "erikrios", Password: "hashedpassword", IsActive: true}
+			},
+			func(ctx context.Context, username string) error {
+				return nil
+			},
+		).Once()
+
+		mockPwdGen.On(
+			"CompareHashAndPassword",
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+		).Return(
+			func(hashedPassword []byte, password []byte) error {
+				return errors.New("password not match")
+			},
+		).Once()
+
+		mockLoginAttemptRepository.On(
+			"IncrementFailure",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			entity.UsernameLoginAttempt,
+			"erikrios",
+			mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+		).Return(
+			func(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) uint {
+				return maxUsernameLoginAttempts + 1
+			},
+			func(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) error {
+				return nil
+			},
+		).Once()
+
+		mockLoginAttemptRepository.On(
+			"IncrementFailure",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			entity.IPLoginAttempt,
+			"10.0.0.1",
+			mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+		).Return(
+			func(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) uint {
+				return 3
+			},
+			func(ctx context.Context, kind entity.LoginAttemptKind, value string, since time.Time) error {
+				return nil
+			},
+		).Once()
+
+		mockLoginAttemptRepository.On(
+			"Lock",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			entity.UsernameLoginAttempt,
+			"erikrios",
+			mock.MatchedBy(func(until time.Time) bool {
+				// The second failure past the limit doubles the base lockout.
+				remaining := time.Until(until)
+				return remaining > lockoutBaseDuration && remaining <= lockoutBaseDuration*2
+			}),
+		).Return(
+			func(ctx context.Context, kind entity.LoginAttemptKind, value string, until time.Time) error {
+				return nil
+			},
+		).Once()
+
+		_, gotErr := userService.Login(context.Background(), payload.Login{
+			Username:  "erikrios",
+			Password:  "wrongpassword",
+			IPAddress: "10.0.0.1",
+		})
+
+		assert.ErrorIs(t, gotErr, service.ErrCredentialNotMatch)
+		mockLoginAttemptRepository.AssertExpectations(t)
+	})
+}
+
+func TestLockoutDuration(t *testing.T) {
+	testCases := []struct {
+		failuresPastLimit uint
+		expectedDuration  time.Duration
+	}{
+		{failuresPastLimit: 0, expectedDuration: time.Minute},
+		{failuresPastLimit: 1, expectedDuration: time.Minute * 2},
+		{failuresPastLimit: 3, expectedDuration: time.Minute * 8},
+		{failuresPastLimit: 6, expectedDuration: time.Hour},
+		{failuresPastLimit: 100, expectedDuration: time.Hour},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("it should return %s, when the failures past the limit is %d", testCase.expectedDuration, testCase.failuresPastLimit), func(t *testing.T) {
+			assert.Equal(t, testCase.expectedDuration, lockoutDuration(testCase.failuresPastLimit))
+		})
+	}
+}
@@ -16,6 +16,12 @@ type IDGenerator interface {
 	GenerateLikeID() (id string, err error)
 	GenerateCommentID() (id string, err error)
 	GenerateUserFollowID() (id string, err error)
+	GenerateSessionID() (id string, err error)
+	GenerateNotificationID() (id string, err error)
+	GenerateUserTokenID() (id string, err error)
+	GenerateBanID() (id string, err error)
+	GenerateModerationLogID() (id string, err error)
+	GenerateAuditLogID() (id string, err error)
 }
 
 type nanoidIDGenerator struct{}
@@ -78,6 +84,42 @@ func (n *nanoidIDGenerator) GenerateUserFollowID() (id string, err error) {
 	return
 }
 
+func (n *nanoidIDGenerator) GenerateSessionID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("s-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateNotificationID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("n-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateUserTokenID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("k-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateBanID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("b-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateModerationLogID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("g-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateAuditLogID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("a-%s", id)
+	return
+}
+
 func (n *nanoidIDGenerator) generate(size int) (id string, err error) {
 	id, err = nanoid.GenerateString(nanoid.DefaultAlphabet, size)
 	return
@@ -9,6 +9,48 @@ type IDGenerator struct {
 	mock.Mock
 }
 
+// GenerateAuditLogID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateAuditLogID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateBanID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateBanID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateCategoryID provides a mock function with given fields:
 func (_m *IDGenerator) GenerateCategoryID() (string, error) {
 	ret := _m.Called()
@@ -72,6 +114,27 @@ func (_m *IDGenerator) GenerateLikeID() (string, error) {
 	return r0, r1
 }
 
+// GenerateModerationLogID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateModerationLogID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateModeratorID provides a mock function with given fields:
 func (_m *IDGenerator) GenerateModeratorID() (string, error) {
 	ret := _m.Called()
@@ -93,6 +156,27 @@ func (_m *IDGenerator) GenerateModeratorID() (string, error) {
 	return r0, r1
 }
 
+// GenerateNotificationID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateNotificationID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateReportID provides a mock function with given fields:
 func (_m *IDGenerator) GenerateReportID() (string, error) {
 	ret := _m.Called()
@@ -114,6 +198,27 @@ func (_m *IDGenerator) GenerateReportID() (string, error) {
 	return r0, r1
 }
 
+// GenerateSessionID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateSessionID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateThreadFollowID provides a mock function with given fields:
 func (_m *IDGenerator) GenerateThreadFollowID() (string, error) {
 	ret := _m.Called()
@@ -198,6 +303,27 @@ func (_m *IDGenerator) GenerateUserID() (string, error) {
 	return r0, r1
 }
 
+// GenerateUserTokenID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateUserTokenID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewIDGenerator interface {
 	mock.TestingT
 	Cleanup(func())
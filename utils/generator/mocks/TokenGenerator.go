@@ -27,6 +27,48 @@ func (_m *TokenGenerator) ExtractToken(c echo.Context) generator.TokenPayload {
 	return r0
 }
 
+// GenerateOneTimeToken provides a mock function with given fields:
+func (_m *TokenGenerator) GenerateOneTimeToken() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateRefreshToken provides a mock function with given fields:
+func (_m *TokenGenerator) GenerateRefreshToken() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateToken provides a mock function with given fields: payload
 func (_m *TokenGenerator) GenerateToken(payload generator.TokenPayload) (string, error) {
 	ret := _m.Called(payload)
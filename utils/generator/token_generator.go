@@ -1,6 +1,10 @@
 package generator
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"os"
 	"time"
 
@@ -8,16 +12,25 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	AccessTokenDuration  = time.Hour
+	RefreshTokenDuration = time.Hour * 24 * 30
+)
+
 type TokenPayload struct {
-	ID       string
-	Username string
-	Role     string
-	IsActive bool
+	ID           string
+	Username     string
+	Role         string
+	IsActive     bool
+	SessionID    string
+	TokenVersion uint
 }
 
 type TokenGenerator interface {
 	GenerateToken(payload TokenPayload) (token string, err error)
 	ExtractToken(c echo.Context) (payload TokenPayload)
+	GenerateRefreshToken() (token string, err error)
+	GenerateOneTimeToken() (token string, err error)
 }
 
 type jwtTokenGenerator struct{}
@@ -32,7 +45,9 @@ func (j *jwtTokenGenerator) GenerateToken(payload TokenPayload) (token string, e
 		"username": payload.Username,
 		"role":     payload.Role,
 		"isActive": payload.IsActive,
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
+		"sid":      payload.SessionID,
+		"ver":      payload.TokenVersion,
+		"exp":      time.Now().Add(AccessTokenDuration).Unix(),
 		"iat":      time.Now().Unix(),
 	}
 
@@ -53,7 +68,51 @@ func (j *jwtTokenGenerator) ExtractToken(c echo.Context) (payload TokenPayload)
 		payload.Username = claims["username"].(string)
 		payload.Role = claims["role"].(string)
 		payload.IsActive = claims["isActive"].(bool)
+		payload.SessionID, _ = claims["sid"].(string)
+		// The numbers of jwt.MapClaims are decoded as float64.
+		if tokenVersion, ok := claims["ver"].(float64); ok {
+			payload.TokenVersion = uint(tokenVersion)
+		}
 	}
 
 	return
 }
+
+func (j *jwtTokenGenerator) GenerateRefreshToken() (token string, err error) {
+	token, err = generateRandomToken()
+	return
+}
+
+// GenerateOneTimeToken returns the random token sent by email to verify the address or to reset the password.
+func (j *jwtTokenGenerator) GenerateOneTimeToken() (token string, err error) {
+	token, err = generateRandomToken()
+	return
+}
+
+// HashRefreshToken returns the SHA-256 hex digest of the refresh token, only the digest is stored in the database.
+func HashRefreshToken(token string) (hash string) {
+	hash = hashToken(token)
+	return
+}
+
+// HashOneTimeToken returns the SHA-256 hex digest of the one-time token, only the digest is stored in the database.
+func HashOneTimeToken(token string) (hash string) {
+	hash = hashToken(token)
+	return
+}
+
+func generateRandomToken() (token string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return
+}
+
+func hashToken(token string) (hash string) {
+	sum := sha256.Sum256([]byte(token))
+	hash = hex.EncodeToString(sum[:])
+	return
+}
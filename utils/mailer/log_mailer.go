@@ -0,0 +1,51 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type logMailer struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewLogMailer returns a mailer for the development and the tests, the messages are appended to the file at the given path, or logged when the path is empty.
+func NewLogMailer(path string) *logMailer {
+	return &logMailer{path: path}
+}
+
+func (l *logMailer) Send(ctx context.Context, message Message) (err error) {
+	entry := fmt.Sprintf(
+		"Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC1123Z),
+		message.To,
+		message.Subject,
+		message.Body,
+	)
+
+	if l.path == "" {
+		log.Print("mail sent\n" + entry)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+
+	if _, err = file.WriteString(entry); err != nil {
+		_ = file.Close()
+		return
+	}
+
+	err = file.Close()
+	return
+}
@@ -0,0 +1,368 @@
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// lockKey identifies the advisory lock taken while migrating, so concurrent instances run one after another.
+const lockKey int64 = 7_246_891_204
+
+var (
+	ErrUnknownVersion = errors.New("migrator: unknown version")
+	ErrNoMigration    = errors.New("migrator: no migration to revert")
+)
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status tells whether a migration is applied, AppliedAt is zero while it's pending.
+type Status struct {
+	Migration Migration
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load reads the migrations in the root of fsys ordered by version, every version needs both an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("migrator: read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrator: parse version of %s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("migrator: read %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migrator: version %d is used by %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migrator: version %d needs both an up and a down file", migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies all the pending migrations in version order.
+func (m *Migrator) Up(ctx context.Context) (applied []Migration, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn, appliedAt map[uint64]time.Time) error {
+		for _, migration := range m.migrations {
+			if _, ok := appliedAt[migration.Version]; ok {
+				continue
+			}
+			if err := apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return
+}
+
+// Down reverts the latest applied migration.
+func (m *Migrator) Down(ctx context.Context) (reverted Migration, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn, appliedAt map[uint64]time.Time) error {
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			if _, ok := appliedAt[m.migrations[i].Version]; ok {
+				reverted = m.migrations[i]
+				return revert(ctx, conn, reverted)
+			}
+		}
+		return ErrNoMigration
+	})
+	return
+}
+
+// To reverts the applied migrations newer than the version, then applies the pending ones up to the version.
+// The version 0 reverts every migration.
+func (m *Migrator) To(ctx context.Context, version uint64) (applied, reverted []Migration, err error) {
+	if version != 0 && !m.has(version) {
+		err = ErrUnknownVersion
+		return
+	}
+
+	err = m.withLock(ctx, func(conn *sql.Conn, appliedAt map[uint64]time.Time) error {
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := appliedAt[migration.Version]; !ok || migration.Version <= version {
+				continue
+			}
+			if err := revert(ctx, conn, migration); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := appliedAt[migration.Version]; ok || migration.Version > version {
+				continue
+			}
+			if err := apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	return
+}
+
+// Status lists every known migration in version order.
+func (m *Migrator) Status(ctx context.Context) (statuses []Status, err error) {
+	err = m.withLock(ctx, func(conn *sql.Conn, appliedAt map[uint64]time.Time) error {
+		statuses = make([]Status, len(m.migrations))
+		for i, migration := range m.migrations {
+			statuses[i] = Status{Migration: migration, AppliedAt: appliedAt[migration.Version]}
+		}
+		return nil
+	})
+	return
+}
+
+func (m *Migrator) has(version uint64) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// withLock runs fn on a dedicated connection holding the advisory lock, with the applied versions read after the
+// lock is taken.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn, appliedAt map[uint64]time.Time) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("migrator: open connection: %w", err)
+	}
+
+	defer func(conn *sql.Conn) {
+		if err := conn.Close(); err != nil {
+			log.Println(err)
+		}
+	}(conn)
+
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1);", lockKey); err != nil {
+		return fmt.Errorf("migrator: take lock: %w", err)
+	}
+
+	// The lock belongs to the session, so it is released with a fresh context even if ctx is done.
+	defer func(conn *sql.Conn) {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1);", lockKey); err != nil {
+			log.Println(err)
+		}
+	}(conn)
+
+	if err = m.ensureSchemaTable(ctx, conn); err != nil {
+		return
+	}
+
+	appliedAt, err := findApplied(ctx, conn)
+	if err != nil {
+		return
+	}
+
+	err = fn(conn, appliedAt)
+	return
+}
+
+// ensureSchemaTable creates the schema_versions table. A database migrated by the golang-migrate CLI is adopted, its
+// versions up to the one recorded in schema_migrations are marked as applied.
+func (m *Migrator) ensureSchemaTable(ctx context.Context, conn *sql.Conn) (err error) {
+	var exists bool
+	if err = conn.QueryRowContext(ctx, "SELECT to_regclass('schema_versions') IS NOT NULL;").Scan(&exists); err != nil {
+		return fmt.Errorf("migrator: check schema table: %w", err)
+	}
+
+	if exists {
+		return
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migrator: begin: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	statement := `CREATE TABLE schema_versions
+(
+    version    bigint       NOT NULL,
+    name       varchar(255) NOT NULL,
+    applied_at timestamp    NOT NULL DEFAULT current_timestamp,
+    primary key (version)
+);`
+
+	if _, err = tx.ExecContext(ctx, statement); err != nil {
+		return fmt.Errorf("migrator: create schema table: %w", err)
+	}
+
+	var legacyExists bool
+	if err = tx.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL;").Scan(&legacyExists); err != nil {
+		return fmt.Errorf("migrator: check golang-migrate table: %w", err)
+	}
+
+	var legacyVersion sql.NullInt64
+	if legacyExists {
+		if err = tx.QueryRowContext(ctx, "SELECT max(version) FROM schema_migrations WHERE NOT dirty;").Scan(&legacyVersion); err != nil {
+			return fmt.Errorf("migrator: read golang-migrate version: %w", err)
+		}
+	}
+
+	if legacyVersion.Valid {
+		for _, migration := range m.migrations {
+			if migration.Version > uint64(legacyVersion.Int64) {
+				break
+			}
+			if _, err = tx.ExecContext(ctx, "INSERT INTO schema_versions (version, name) VALUES ($1, $2);", int64(migration.Version), migration.Name); err != nil {
+				return fmt.Errorf("migrator: adopt version %d: %w", migration.Version, err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("migrator: commit schema table: %w", err)
+	}
+
+	return
+}
+
+func findApplied(ctx context.Context, conn *sql.Conn) (appliedAt map[uint64]time.Time, err error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied_at FROM schema_versions;")
+	if err != nil {
+		return nil, fmt.Errorf("migrator: read applied versions: %w", err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	appliedAt = make(map[uint64]time.Time)
+	for rows.Next() {
+		var version int64
+		var at time.Time
+		if err = rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("migrator: read applied versions: %w", err)
+		}
+		appliedAt[uint64(version)] = at
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("migrator: read applied versions: %w", err)
+	}
+
+	return
+}
+
+// apply runs the up file and records the version in one transaction, so a failed migration leaves nothing behind.
+func apply(ctx context.Context, conn *sql.Conn, migration Migration) (err error) {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migrator: begin %d: %w", migration.Version, err)
+	}
+
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, migration.Up); err != nil {
+		return fmt.Errorf("migrator: apply %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "INSERT INTO schema_versions (version, name) VALUES ($1, $2);", int64(migration.Version), migration.Name); err != nil {
+		return fmt.Errorf("migrator: record %d: %w", migration.Version, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("migrator: commit %d: %w", migration.Version, err)
+	}
+
+	return
+}
+
+func revert(ctx context.Context, conn *sql.Conn, migration Migration) (err error) {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migrator: begin %d: %w", migration.Version, err)
+	}
+
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, migration.Down); err != nil {
+		return fmt.Errorf("migrator: revert %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM schema_versions WHERE version = $1;", int64(migration.Version)); err != nil {
+		return fmt.Errorf("migrator: unrecord %d: %w", migration.Version, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("migrator: commit %d: %w", migration.Version, err)
+	}
+
+	return
+}
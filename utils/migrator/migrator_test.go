@@ -0,0 +1,154 @@
+package migrator
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kelompok-22-capstone-project/forum-group-discussion-backend/migrations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("it should return the migrations ordered by version, when every version has both files", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"2_create_table_threads.up.sql":   {Data: []byte("CREATE TABLE threads ();")},
+			"2_create_table_threads.down.sql": {Data: []byte("DROP TABLE threads;")},
+			"1_create_table_users.up.sql":     {Data: []byte("CREATE TABLE users ();")},
+			"1_create_table_users.down.sql":   {Data: []byte("DROP TABLE users;")},
+			"migrate.up.sh":                   {Data: []byte("go run . migrate up")},
+		}
+
+		gotMigrations, gotError := Load(fsys)
+
+		assert.NoError(t, gotError)
+		assert.Equal(t, []Migration{
+			{Version: 1, Name: "create_table_users", Up: "CREATE TABLE users ();", Down: "DROP TABLE users;"},
+			{Version: 2, Name: "create_table_threads", Up: "CREATE TABLE threads ();", Down: "DROP TABLE threads;"},
+		}, gotMigrations)
+	})
+
+	t.Run("it should return an error, when a version has no down file", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"1_create_table_users.up.sql": {Data: []byte("CREATE TABLE users ();")},
+		}
+
+		_, gotError := Load(fsys)
+
+		assert.Error(t, gotError)
+	})
+
+	t.Run("it should return an error, when a version is used by two migrations", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"1_create_table_users.up.sql":     {Data: []byte("CREATE TABLE users ();")},
+			"1_create_table_users.down.sql":   {Data: []byte("DROP TABLE users;")},
+			"1_create_table_threads.up.sql":   {Data: []byte("CREATE TABLE threads ();")},
+			"1_create_table_threads.down.sql": {Data: []byte("DROP TABLE threads;")},
+		}
+
+		_, gotError := Load(fsys)
+
+		assert.Error(t, gotError)
+	})
+
+	t.Run("it should load the embedded migrations", func(t *testing.T) {
+		gotMigrations, gotError := Load(migrations.FS)
+
+		assert.NoError(t, gotError)
+		assert.NotEmpty(t, gotMigrations)
+	})
+}
+
+func TestUp(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	fsys := fstest.MapFS{
+		"1_create_table_users.up.sql":     {Data: []byte("CREATE TABLE users ();")},
+		"1_create_table_users.down.sql":   {Data: []byte("DROP TABLE users;")},
+		"2_create_table_threads.up.sql":   {Data: []byte("CREATE TABLE threads ();")},
+		"2_create_table_threads.down.sql": {Data: []byte("DROP TABLE threads;")},
+	}
+
+	m, err := New(db, fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("it should apply the pending migrations only, while holding the advisory lock", func(t *testing.T) {
+		mock.ExpectExec("SELECT pg_advisory_lock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT to_regclass").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery("SELECT version, applied_at FROM schema_versions").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()))
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE threads").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO schema_versions").WithArgs(int64(2), "create_table_threads").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		gotApplied, gotError := m.Up(context.Background())
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		if assert.Len(t, gotApplied, 1) {
+			assert.Equal(t, uint64(2), gotApplied[0].Version)
+		}
+	})
+
+	t.Run("it should adopt the versions of golang-migrate, when the schema table doesn't exist yet", func(t *testing.T) {
+		mock.ExpectExec("SELECT pg_advisory_lock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT to_regclass").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectBegin()
+		mock.ExpectExec("CREATE TABLE schema_versions").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT to_regclass").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery("SELECT max\\(version\\) FROM schema_migrations").WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1))
+		mock.ExpectExec("INSERT INTO schema_versions").WithArgs(int64(1), "create_table_users").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT version, applied_at FROM schema_versions").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()).AddRow(2, time.Now()))
+		mock.ExpectExec("SELECT pg_advisory_unlock").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		gotApplied, gotError := m.Up(context.Background())
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NoError(t, gotError)
+		assert.Empty(t, gotApplied)
+	})
+}
+
+func TestTo(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	fsys := fstest.MapFS{
+		"1_create_table_users.up.sql":   {Data: []byte("CREATE TABLE users ();")},
+		"1_create_table_users.down.sql": {Data: []byte("DROP TABLE users;")},
+	}
+
+	m, err := New(db, fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("it should return ErrUnknownVersion, when the version doesn't exist", func(t *testing.T) {
+		_, _, gotError := m.To(context.Background(), 3)
+
+		assert.ErrorIs(t, gotError, ErrUnknownVersion)
+	})
+}
@@ -0,0 +1,39 @@
+package realtime
+
+import "context"
+
+const (
+	CommentCreated       = "comment.created"
+	ThreadCounterChanged = "thread.counter"
+	UserCounterChanged   = "user.counter"
+	NotificationCreated  = "notification.created"
+)
+
+// Event is a change pushed to the subscribers of its topic.
+type Event struct {
+	Topic string `json:"topic"`
+	Type  string `json:"type"`
+	Data  any    `json:"data"`
+}
+
+// Hub delivers the published events to the subscribers of the event topic.
+// The delivery is best-effort, a subscriber that can't keep up misses the events.
+type Hub interface {
+	Publish(ctx context.Context, event Event) (err error)
+	// Subscribe receives the events of the given topics until unsubscribe is called, which closes the events channel.
+	Subscribe(topics ...string) (events <-chan Event, unsubscribe func())
+}
+
+func ThreadTopic(threadID string) string {
+	return "thread:" + threadID
+}
+
+// UserTopic carries the public events of a user, anyone can subscribe to it.
+func UserTopic(userID string) string {
+	return "user:" + userID
+}
+
+// NotificationTopic carries the notifications of a user, only the user itself may subscribe to it.
+func NotificationTopic(userID string) string {
+	return "notifications:" + userID
+}
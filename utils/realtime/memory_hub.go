@@ -0,0 +1,68 @@
+package realtime
+
+import (
+	"context"
+	"sync"
+)
+
+const subscriberBufferSize = 64
+
+type memoryHub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan Event]struct{}
+}
+
+// NewMemoryHub returns a hub that only delivers the events published in this process.
+func NewMemoryHub() *memoryHub {
+	return &memoryHub{subscribers: make(map[string]map[chan Event]struct{})}
+}
+
+func (m *memoryHub) Publish(ctx context.Context, event Event) (err error) {
+	m.dispatch(event)
+	return
+}
+
+func (m *memoryHub) Subscribe(topics ...string) (events <-chan Event, unsubscribe func()) {
+	ch := make(chan Event, subscriberBufferSize)
+
+	m.mu.Lock()
+	for _, topic := range topics {
+		if m.subscribers[topic] == nil {
+			m.subscribers[topic] = make(map[chan Event]struct{})
+		}
+		m.subscribers[topic][ch] = struct{}{}
+	}
+	m.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe = func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+
+			for _, topic := range topics {
+				delete(m.subscribers[topic], ch)
+				if len(m.subscribers[topic]) == 0 {
+					delete(m.subscribers, topic)
+				}
+			}
+			close(ch)
+		})
+	}
+
+	events = ch
+	return
+}
+
+func (m *memoryHub) dispatch(event Event) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for ch := range m.subscribers[event.Topic] {
+		select {
+		case ch <- event:
+		default:
+			// The subscriber buffer is full, drop the event rather than block the publisher.
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realtime "github.com/kelompok-22-capstone-project/forum-group-discussion-backend/utils/realtime"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Hub is an autogenerated mock type for the Hub type
+type Hub struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: ctx, event
+func (_m *Hub) Publish(ctx context.Context, event realtime.Event) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, realtime.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: topics
+func (_m *Hub) Subscribe(topics ...string) (<-chan realtime.Event, func()) {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 <-chan realtime.Event
+	if rf, ok := ret.Get(0).(func(...string) <-chan realtime.Event); ok {
+		r0 = rf(topics...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan realtime.Event)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(...string) func()); ok {
+		r1 = rf(topics...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewHub interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHub creates a new instance of Hub. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHub(t mockConstructorTestingTNewHub) *Hub {
+	mock := &Hub{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
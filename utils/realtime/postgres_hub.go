@@ -0,0 +1,82 @@
+package realtime
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+const (
+	postgresChannel = "realtime_events"
+	// maxPayloadSize is the NOTIFY payload limit of PostgreSQL.
+	maxPayloadSize = 8000
+)
+
+var ErrEventTooLarge = errors.New("event too large")
+
+type postgresHub struct {
+	db       *sql.DB
+	listener *pq.Listener
+	local    *memoryHub
+}
+
+// NewPostgresHub returns a hub that delivers the events through PostgreSQL LISTEN/NOTIFY,
+// so the subscribers of every instance connected to the same database receive them.
+// An event must encode to less than 8000 bytes, which is the NOTIFY payload limit,
+// a larger event is rejected with ErrEventTooLarge.
+func NewPostgresHub(db *sql.DB, listener *pq.Listener) (*postgresHub, error) {
+	if err := listener.Listen(postgresChannel); err != nil {
+		return nil, err
+	}
+
+	p := &postgresHub{
+		db:       db,
+		listener: listener,
+		local:    NewMemoryHub(),
+	}
+
+	go p.listen()
+
+	return p, nil
+}
+
+func (p *postgresHub) Publish(ctx context.Context, event Event) (err error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	if len(payload) >= maxPayloadSize {
+		err = ErrEventTooLarge
+		return
+	}
+
+	_, err = p.db.ExecContext(ctx, "SELECT pg_notify($1, $2);", postgresChannel, string(payload))
+	return
+}
+
+func (p *postgresHub) Subscribe(topics ...string) (events <-chan Event, unsubscribe func()) {
+	return p.local.Subscribe(topics...)
+}
+
+func (p *postgresHub) listen() {
+	for notification := range p.listener.Notify {
+		// A nil notification is sent after the connection has been re-established,
+		// the events published in the meantime are lost.
+		if notification == nil {
+			continue
+		}
+
+		var event Event
+		if err := json.Unmarshal([]byte(notification.Extra), &event); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		p.local.dispatch(event)
+	}
+}
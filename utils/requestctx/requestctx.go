@@ -0,0 +1,16 @@
+package requestctx
+
+import "context"
+
+type ipAddressKey struct{}
+
+// WithIPAddress returns a copy of ctx carrying the IP address of the request.
+func WithIPAddress(ctx context.Context, ipAddress string) context.Context {
+	return context.WithValue(ctx, ipAddressKey{}, ipAddress)
+}
+
+// IPAddressFrom returns the IP address carried by ctx, or an empty string if there is none.
+func IPAddressFrom(ctx context.Context) string {
+	ipAddress, _ := ctx.Value(ipAddressKey{}).(string)
+	return ipAddress
+}
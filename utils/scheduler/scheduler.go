@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job is run periodically by Every, its error is logged and the next run goes on.
+type Job func(ctx context.Context) (err error)
+
+// Every runs the job on every interval in the background, the first run starts right away. It stops when the context is done,
+// the returned channel is closed once it has stopped, so a run that was still going on is over.
+func Every(ctx context.Context, name string, interval time.Duration, job Job) (done <-chan struct{}) {
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := job(ctx); err != nil {
+				log.Printf("scheduled job %s failed: %s\n", name, err.Error())
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return stopped
+}
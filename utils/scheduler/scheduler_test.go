@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvery(t *testing.T) {
+	t.Run("it should close the done channel only after the running job is over", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		finished := false
+		done := Every(ctx, "test", time.Hour, func(ctx context.Context) (err error) {
+			close(started)
+			<-release
+			finished = true
+			return
+		})
+
+		<-started
+		cancel()
+
+		select {
+		case <-done:
+			t.Fatal("done was closed while the job was running")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		close(release)
+		<-done
+		assert.True(t, finished)
+	})
+}
@@ -0,0 +1,30 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ViewCounter is an autogenerated mock type for the ViewCounter type
+type ViewCounter struct {
+	mock.Mock
+}
+
+// View provides a mock function with given fields: threadID, viewer
+func (_m *ViewCounter) View(threadID string, viewer string) {
+	_m.Called(threadID, viewer)
+}
+
+type mockConstructorTestingTNewViewCounter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewViewCounter creates a new instance of ViewCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewViewCounter(t mockConstructorTestingTNewViewCounter) *ViewCounter {
+	mock := &ViewCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,112 @@
+package viewcounter
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ViewCounter counts the views of the threads.
+type ViewCounter interface {
+	// View records a view of the thread by the viewer. The repeated views of the same viewer within the dedupe window
+	// are counted once, an empty viewer is always counted.
+	View(threadID, viewer string)
+}
+
+// FlushFunc writes the buffered views, views maps the thread ID to its number of new views.
+type FlushFunc func(ctx context.Context, views map[string]uint64) (err error)
+
+// maxSeenViewers bounds the viewers remembered for the dedupe, so a flood of distinct viewers can't grow it without limit.
+const maxSeenViewers = 100000
+
+type viewKey struct {
+	threadID string
+	viewer   string
+}
+
+type batchViewCounter struct {
+	mu      sync.Mutex
+	flush   FlushFunc
+	window  time.Duration
+	now     func() time.Time
+	pending map[string]uint64
+	seen    map[viewKey]time.Time
+	maxSeen int
+	// pruneAt is when the oldest remembered viewer expires, there is nothing to prune before it.
+	pruneAt time.Time
+}
+
+// NewBatchViewCounter returns a view counter that buffers the views in memory until Flush is called,
+// Flush is meant to be run periodically and once more on shutdown.
+func NewBatchViewCounter(flush FlushFunc, window time.Duration) *batchViewCounter {
+	return &batchViewCounter{
+		flush:   flush,
+		window:  window,
+		now:     time.Now,
+		pending: make(map[string]uint64),
+		seen:    make(map[viewKey]time.Time),
+		maxSeen: maxSeenViewers,
+	}
+}
+
+func (b *batchViewCounter) View(threadID, viewer string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if viewer != "" {
+		key := viewKey{threadID: threadID, viewer: viewer}
+		now := b.now()
+		seenAt, ok := b.seen[key]
+		if ok && now.Sub(seenAt) < b.window {
+			return
+		}
+
+		if !ok && len(b.seen) >= b.maxSeen && !now.Before(b.pruneAt) {
+			b.prune(now)
+		}
+
+		// When every remembered viewer is still within its window, the view is counted without being remembered.
+		if ok || len(b.seen) < b.maxSeen {
+			b.seen[key] = now
+		}
+	}
+
+	b.pending[threadID]++
+}
+
+// Flush writes the buffered views in one batch. If the write fails, the views are buffered again for the next flush.
+// The expired viewers are forgotten as well.
+func (b *batchViewCounter) Flush(ctx context.Context) (err error) {
+	b.mu.Lock()
+	views := b.pending
+	b.pending = make(map[string]uint64)
+
+	b.prune(b.now())
+	b.mu.Unlock()
+
+	if len(views) == 0 {
+		return
+	}
+
+	if err = b.flush(ctx, views); err != nil {
+		b.mu.Lock()
+		for threadID, count := range views {
+			b.pending[threadID] += count
+		}
+		b.mu.Unlock()
+	}
+
+	return
+}
+
+// prune forgets the viewers whose window is over, b.mu must be held.
+func (b *batchViewCounter) prune(now time.Time) {
+	b.pruneAt = time.Time{}
+	for key, seenAt := range b.seen {
+		if now.Sub(seenAt) >= b.window {
+			delete(b.seen, key)
+		} else if expireAt := seenAt.Add(b.window); b.pruneAt.IsZero() || expireAt.Before(b.pruneAt) {
+			b.pruneAt = expireAt
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package viewcounter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchViewCounter(t *testing.T) {
+	now := time.Date(2022, 7, 10, 0, 0, 0, 0, time.UTC)
+
+	var flushed map[string]uint64
+	var flushErr error
+	counter := NewBatchViewCounter(func(ctx context.Context, views map[string]uint64) (err error) {
+		flushed = views
+		return flushErr
+	}, time.Hour)
+	counter.now = func() time.Time { return now }
+
+	t.Run("it should dedupe the views of the same viewer within the window", func(t *testing.T) {
+		counter.View("t1", "ip:127.0.0.1")
+		counter.View("t1", "ip:127.0.0.1")
+		counter.View("t1", "user:u1")
+		counter.View("t2", "ip:127.0.0.1")
+		counter.View("t2", "")
+		counter.View("t2", "")
+
+		flushed = nil
+		assert.NoError(t, counter.Flush(context.Background()))
+		assert.Equal(t, map[string]uint64{"t1": 2, "t2": 3}, flushed)
+	})
+
+	t.Run("it should not flush when there are no views", func(t *testing.T) {
+		flushed = nil
+		assert.NoError(t, counter.Flush(context.Background()))
+		assert.Nil(t, flushed)
+	})
+
+	t.Run("it should count the viewer again after the window", func(t *testing.T) {
+		counter.View("t1", "ip:127.0.0.1")
+
+		now = now.Add(time.Hour)
+		counter.View("t1", "ip:127.0.0.1")
+
+		flushed = nil
+		assert.NoError(t, counter.Flush(context.Background()))
+		assert.Equal(t, map[string]uint64{"t1": 1}, flushed)
+	})
+
+	t.Run("it should buffer the views again when the flush fails", func(t *testing.T) {
+		counter.View("t3", "")
+
+		flushErr = errors.New("database error")
+		assert.Error(t, counter.Flush(context.Background()))
+
+		counter.View("t3", "")
+
+		flushErr = nil
+		assert.NoError(t, counter.Flush(context.Background()))
+		assert.Equal(t, map[string]uint64{"t3": 2}, flushed)
+	})
+
+	t.Run("it should forget the expired viewers on flush", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+		assert.NoError(t, counter.Flush(context.Background()))
+		assert.Empty(t, counter.seen)
+	})
+	t.Run("it should forget the expired viewers on view, when the remembered viewers reach the limit", func(t *testing.T) {
+		counter.maxSeen = 2
+
+		counter.View("t1", "ip:10.0.0.1")
+		counter.View("t1", "ip:10.0.0.2")
+
+		now = now.Add(time.Hour)
+		counter.View("t1", "ip:10.0.0.3")
+
+		assert.Len(t, counter.seen, 1)
+		assert.Contains(t, counter.seen, viewKey{threadID: "t1", viewer: "ip:10.0.0.3"})
+	})
+
+	t.Run("it should count the view without remembering the viewer, when no remembered viewer is expired", func(t *testing.T) {
+		counter.View("t1", "ip:10.0.0.4")
+		counter.View("t1", "ip:10.0.0.5")
+		counter.View("t1", "ip:10.0.0.5")
+
+		assert.Len(t, counter.seen, 2)
+		assert.NotContains(t, counter.seen, viewKey{threadID: "t1", viewer: "ip:10.0.0.5"})
+
+		flushed = nil
+		assert.NoError(t, counter.Flush(context.Background()))
+		assert.Equal(t, map[string]uint64{"t1": 6}, flushed)
+	})
+}